@@ -0,0 +1,173 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrUnsupportedByServer is returned when calling an API that is not
+// supported by the API version of the server.
+var ErrUnsupportedByServer = errors.New("unsupported by server")
+
+// APIVersion represents a parsed API version, like 2.3.0.
+type APIVersion struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// ParseAPIVersion parses a dotted-decimal API version like "2.3" or "2.3.0".
+func ParseAPIVersion(v string) (APIVersion, error) {
+	var version APIVersion
+
+	parts := strings.Split(strings.TrimPrefix(v, "v"), ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return version, fmt.Errorf("invalid API version %q", v)
+	}
+
+	numbers := make([]int, 3)
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return version, fmt.Errorf("invalid API version %q", v)
+		}
+		numbers[i] = n
+	}
+
+	version.Major, version.Minor, version.Patch = numbers[0], numbers[1], numbers[2]
+
+	return version, nil
+}
+
+// String returns the version in the form "2.3.0".
+func (v APIVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Compare returns -1, 0 or 1 if the version is lower than, equal to or
+// higher than the other version.
+func (v APIVersion) Compare(other APIVersion) int {
+	switch {
+	case v.Major != other.Major:
+		return compareInts(v.Major, other.Major)
+	case v.Minor != other.Minor:
+		return compareInts(v.Minor, other.Minor)
+	default:
+		return compareInts(v.Patch, other.Patch)
+	}
+}
+
+// AtLeast reports whether the version is equal to or higher than the
+// minimum version.
+func (v APIVersion) AtLeast(minimum APIVersion) bool {
+	return v.Compare(minimum) >= 0
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Feature represents an API feature that is not supported by all API
+// versions. No features are checked by default: the minimum API version of a
+// feature is only checked when it is set in Config.MinimumAPIVersions.
+type Feature string
+
+// List of features that can be checked against a minimum API version.
+const (
+	FeatureCostEstimation Feature = "cost-estimation"
+	FeaturePlanExports    Feature = "plan-exports"
+	FeatureRunTriggers    Feature = "run-triggers"
+)
+
+// MinimumAPIVersion returns the minimum API version the client checks the
+// feature against, and false if the feature is not checked.
+func (c *Client) MinimumAPIVersion(feature Feature) (APIVersion, bool) {
+	v, ok := c.featureVersions[feature]
+	if v == (APIVersion{}) {
+		return v, false
+	}
+	return v, ok
+}
+
+// UnsupportedError is returned when a feature is not supported by the API
+// version of the server. It matches ErrUnsupportedByServer using errors.Is.
+type UnsupportedError struct {
+	Feature Feature
+
+	// The minimum API version supporting the feature.
+	Required APIVersion
+
+	// The API version of the server.
+	Remote APIVersion
+}
+
+// Error implements the error interface.
+func (e *UnsupportedError) Error() string {
+	return fmt.Sprintf(
+		"%s requires API version %s or later, but the server supports %s",
+		e.Feature, e.Required, e.Remote,
+	)
+}
+
+// Is reports whether target is ErrUnsupportedByServer.
+func (e *UnsupportedError) Is(target error) bool {
+	return target == ErrUnsupportedByServer
+}
+
+// ParsedRemoteAPIVersion returns the parsed API version of the server, and
+// false if the server did not report a valid version.
+func (c *Client) ParsedRemoteAPIVersion() (APIVersion, bool) {
+	v, err := ParseAPIVersion(c.RemoteAPIVersion())
+	return v, err == nil
+}
+
+// Supports reports whether the API version of the server supports the
+// feature. Servers that do not report their API version are assumed to
+// support all features, as are all servers while the API version is not
+// retrieved yet when using LazyPing; call Ping first to retrieve it.
+func (c *Client) Supports(feature Feature) bool {
+	return c.checkFeature(feature) == nil
+}
+
+// requireFeature returns an *UnsupportedError if the API version of the
+// server does not support the feature, retrieving the version first if it
+// was deferred and the feature is checked.
+func (c *Client) requireFeature(ctx context.Context, feature Feature) error {
+	if _, ok := c.MinimumAPIVersion(feature); !ok {
+		return nil
+	}
+	if err := c.loadMetadata(ctx); err != nil {
+		return err
+	}
+	return c.checkFeature(feature)
+}
+
+// checkFeature returns an *UnsupportedError if the API version of the server
+// does not support the feature.
+func (c *Client) checkFeature(feature Feature) error {
+	required, ok := c.MinimumAPIVersion(feature)
+	if !ok {
+		return nil
+	}
+
+	remote, ok := c.ParsedRemoteAPIVersion()
+	if !ok || remote.AtLeast(required) {
+		return nil
+	}
+
+	return &UnsupportedError{
+		Feature:  feature,
+		Required: required,
+		Remote:   remote,
+	}
+}
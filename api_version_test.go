@@ -0,0 +1,175 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAPIVersion(t *testing.T) {
+	cases := map[string]struct {
+		version APIVersion
+		err     bool
+	}{
+		"2.3":    {version: APIVersion{2, 3, 0}},
+		"2.3.1":  {version: APIVersion{2, 3, 1}},
+		"v34.21": {version: APIVersion{34, 21, 0}},
+		"":       {err: true},
+		"2":      {err: true},
+		"2.x":    {err: true},
+		"2.3.1.": {err: true},
+		"2.-1":   {err: true},
+	}
+
+	for raw, tc := range cases {
+		v, err := ParseAPIVersion(raw)
+		if tc.err {
+			assert.Error(t, err, raw)
+			continue
+		}
+		require.NoError(t, err, raw)
+		assert.Equal(t, tc.version, v, raw)
+	}
+}
+
+func TestAPIVersion_Compare(t *testing.T) {
+	v := APIVersion{2, 3, 1}
+
+	assert.Equal(t, 0, v.Compare(APIVersion{2, 3, 1}))
+	assert.Equal(t, 1, v.Compare(APIVersion{2, 3, 0}))
+	assert.Equal(t, 1, v.Compare(APIVersion{1, 9, 9}))
+	assert.Equal(t, -1, v.Compare(APIVersion{2, 4, 0}))
+	assert.Equal(t, -1, v.Compare(APIVersion{3, 0, 0}))
+
+	assert.True(t, v.AtLeast(APIVersion{2, 3, 0}))
+	assert.False(t, v.AtLeast(APIVersion{2, 4, 0}))
+	assert.Equal(t, "2.3.1", v.String())
+}
+
+func TestClient_Supports(t *testing.T) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == DefaultBasePath+PingEndpoint {
+			w.Header().Set("TFP-API-Version", "2.2")
+			w.WriteHeader(204)
+			return
+		}
+		requests++
+		w.WriteHeader(404)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		MinimumAPIVersions: map[Feature]APIVersion{
+			FeatureCostEstimation: {Major: 2, Minor: 1},
+			FeaturePlanExports:    {Major: 2, Minor: 2},
+			FeatureRunTriggers:    {Major: 2, Minor: 3},
+		},
+	})
+	require.NoError(t, err)
+
+	v, ok := client.ParsedRemoteAPIVersion()
+	require.True(t, ok)
+	assert.Equal(t, APIVersion{2, 2, 0}, v)
+
+	assert.True(t, client.Supports(FeatureCostEstimation))
+	assert.True(t, client.Supports(FeaturePlanExports))
+	assert.False(t, client.Supports(FeatureRunTriggers))
+
+	t.Run("with an unsupported feature", func(t *testing.T) {
+		_, err := client.RunTriggers.Read(context.Background(), "rt-123")
+		assert.True(t, errors.Is(err, ErrUnsupportedByServer))
+		assert.EqualError(t, err, "run-triggers requires API version 2.3.0 or later, but the server supports 2.2.0")
+
+		unsupported, ok := err.(*UnsupportedError)
+		require.True(t, ok)
+		assert.Equal(t, FeatureRunTriggers, unsupported.Feature)
+		assert.Equal(t, 0, requests)
+	})
+
+	t.Run("with a supported feature", func(t *testing.T) {
+		_, err := client.PlanExports.Read(context.Background(), "pe-123")
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
+		assert.Equal(t, 1, requests)
+	})
+
+	t.Run("without a remote API version", func(t *testing.T) {
+		client.SetFakeRemoteAPIVersion("")
+		assert.True(t, client.Supports(FeatureRunTriggers))
+	})
+}
+
+func TestClient_MinimumAPIVersions(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("TFP-API-Version", "2.2")
+		w.WriteHeader(204)
+	}))
+	defer ts.Close()
+
+	const featureTeamTokens Feature = "team-tokens"
+
+	versions := map[Feature]APIVersion{
+		FeatureRunTriggers:    {Major: 2, Minor: 2},
+		FeaturePlanExports:    {},
+		FeatureCostEstimation: {Major: 2, Minor: 5},
+		featureTeamTokens:     {Major: 2, Minor: 4},
+	}
+	client, err := NewClient(&Config{
+		Address:            ts.URL,
+		Token:              "dummy-token",
+		HTTPClient:         ts.Client(),
+		MinimumAPIVersions: versions,
+	})
+	require.NoError(t, err)
+
+	// The config is copied by the client.
+	delete(versions, featureTeamTokens)
+
+	assert.True(t, client.Supports(FeatureRunTriggers))
+	assert.True(t, client.Supports(FeaturePlanExports))
+	assert.False(t, client.Supports(FeatureCostEstimation))
+	assert.False(t, client.Supports(featureTeamTokens))
+
+	_, ok := client.MinimumAPIVersion(FeaturePlanExports)
+	assert.False(t, ok)
+	v, ok := client.MinimumAPIVersion(featureTeamTokens)
+	assert.True(t, ok)
+	assert.Equal(t, APIVersion{2, 4, 0}, v)
+}
+
+func TestClient_featuresUncheckedByDefault(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == DefaultBasePath+PingEndpoint {
+			w.Header().Set("TFP-API-Version", "2.0")
+			w.WriteHeader(204)
+			return
+		}
+		w.WriteHeader(404)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	for _, feature := range []Feature{FeatureCostEstimation, FeaturePlanExports, FeatureRunTriggers} {
+		_, ok := client.MinimumAPIVersion(feature)
+		assert.False(t, ok, feature)
+	}
+
+	// The request is made, even though the server reports an old version.
+	assert.True(t, client.Supports(FeatureRunTriggers))
+	_, err = client.RunTriggers.Read(context.Background(), "rt-123")
+	assert.True(t, errors.Is(err, ErrResourceNotFound))
+}
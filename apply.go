@@ -73,7 +73,7 @@ func (s *applies) Read(ctx context.Context, applyID string) (*Apply, error) {
 	}
 
 	u := fmt.Sprintf("applies/%s", url.QueryEscape(applyID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("Applies.Read", "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
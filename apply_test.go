@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"context"
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -27,7 +28,7 @@ func TestAppliesRead(t *testing.T) {
 	t.Run("when the apply does not exist", func(t *testing.T) {
 		a, err := client.Applies.Read(ctx, "nonexisting")
 		assert.Nil(t, a)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("with invalid apply ID", func(t *testing.T) {
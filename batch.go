@@ -0,0 +1,223 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// DefaultBatchConcurrency is the number of operations of a batch that run
+// at the same time when no concurrency is configured.
+const DefaultBatchConcurrency = 10
+
+// BatchFunc performs a single operation of a batch. The returned value is
+// stored in the result of the operation.
+type BatchFunc func(ctx context.Context) (interface{}, error)
+
+// BatchOperation is a single operation of a batch.
+type BatchOperation struct {
+	// A name identifying the operation in its result, like the ID of the
+	// workspace it is made for.
+	Name string
+
+	// The function performing the operation.
+	Fn BatchFunc
+}
+
+// BatchOptions represents the options for running a batch.
+type BatchOptions struct {
+	// The maximum number of operations that run at the same time. The
+	// DefaultBatchConcurrency is used when left empty.
+	Concurrency int
+
+	// Stop starting new operations after the first operation failed, and
+	// cancel the context of the operations that are still running.
+	StopOnError bool
+
+	// Progress is called after every completed operation. Calls are never
+	// made concurrently.
+	Progress func(BatchProgress)
+}
+
+func (o BatchOptions) valid() error {
+	if o.Concurrency < 0 {
+		return errors.New("concurrency must not be negative")
+	}
+	return nil
+}
+
+// BatchProgress reports the progress of a batch.
+type BatchProgress struct {
+	// The number of operations in the batch.
+	Total int
+
+	// The number of completed operations, including the failed ones.
+	Completed int
+
+	// The number of failed operations.
+	Failed int
+
+	// The result of the operation that completed last.
+	Result *BatchResult
+}
+
+// BatchResult is the result of a single operation of a batch.
+type BatchResult struct {
+	// The position and name of the operation in the batch.
+	Index int
+	Name  string
+
+	// The value and error returned by the operation.
+	Value interface{}
+	Err   error
+
+	// Skipped is true when the operation was not started, because the batch
+	// was stopped after an error or its context was done.
+	Skipped bool
+}
+
+// BatchError is returned by RunBatch when operations failed or were
+// skipped.
+type BatchError struct {
+	// The number of operations in the batch.
+	Total int
+
+	// The results of the failed operations, in the order of the batch.
+	Failed []*BatchResult
+
+	// The number of operations that were not started.
+	Skipped int
+}
+
+// Error implements the error interface.
+func (e *BatchError) Error() string {
+	if len(e.Failed) == 0 {
+		return fmt.Sprintf("%d of %d batch operations skipped", e.Skipped, e.Total)
+	}
+
+	msg := fmt.Sprintf("%d of %d batch operations failed", len(e.Failed), e.Total)
+	if e.Skipped > 0 {
+		msg += fmt.Sprintf(" and %d skipped", e.Skipped)
+	}
+
+	first := e.Failed[0]
+	if first.Name != "" {
+		return fmt.Sprintf("%s, first error (%s): %v", msg, first.Name, first.Err)
+	}
+	return fmt.Sprintf("%s, first error: %v", msg, first.Err)
+}
+
+// RunBatch runs the operations with bounded concurrency and returns their
+// results in the order of the operations. When any operation fails or is
+// skipped, a *BatchError describing them is returned along with all
+// results. A typical use looks like:
+//
+//	var ops []tfe.BatchOperation
+//	for _, ws := range workspaces {
+//		ws := ws
+//		ops = append(ops, tfe.BatchOperation{
+//			Name: ws.ID,
+//			Fn: func(ctx context.Context) (interface{}, error) {
+//				return client.Variables.Create(ctx, ws.ID, options)
+//			},
+//		})
+//	}
+//
+//	results, err := tfe.RunBatch(ctx, ops, tfe.BatchOptions{Concurrency: 5})
+//
+// Calls made by the operations wait for the rate limiter of their client,
+// so a batch never exceeds the rate limit, however many operations run at
+// the same time.
+func RunBatch(ctx context.Context, operations []BatchOperation, options BatchOptions) ([]*BatchResult, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+	for i, op := range operations {
+		if op.Fn == nil {
+			return nil, fmt.Errorf("missing function for batch operation %d", i)
+		}
+	}
+
+	concurrency := options.Concurrency
+	if concurrency == 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+	if concurrency > len(operations) {
+		concurrency = len(operations)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]*BatchResult, len(operations))
+	for i, op := range operations {
+		results[i] = &BatchResult{Index: i, Name: op.Name, Skipped: true}
+	}
+
+	pending := make(chan int)
+	completed := make(chan *BatchResult)
+
+	go func() {
+		defer close(pending)
+		for i := range operations {
+			select {
+			case pending <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range pending {
+				if ctx.Err() != nil {
+					continue
+				}
+				r := results[i]
+				r.Skipped = false
+				r.Value, r.Err = operations[i].Fn(ctx)
+				completed <- r
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(completed)
+	}()
+
+	progress := BatchProgress{Total: len(operations)}
+	for r := range completed {
+		progress.Completed++
+		if r.Err != nil {
+			progress.Failed++
+			if options.StopOnError {
+				cancel()
+			}
+		}
+		if options.Progress != nil {
+			progress.Result = r
+			options.Progress(progress)
+		}
+	}
+
+	batchErr := &BatchError{Total: len(operations)}
+	for _, r := range results {
+		switch {
+		case r.Skipped:
+			batchErr.Skipped++
+		case r.Err != nil:
+			batchErr.Failed = append(batchErr.Failed, r)
+		}
+	}
+	if len(batchErr.Failed) > 0 || batchErr.Skipped > 0 {
+		return results, batchErr
+	}
+
+	return results, nil
+}
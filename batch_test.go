@@ -0,0 +1,142 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunBatch(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("with bounded concurrency", func(t *testing.T) {
+		var running, maxRunning int32
+		var ops []BatchOperation
+		for i := 0; i < 20; i++ {
+			i := i
+			ops = append(ops, BatchOperation{
+				Name: fmt.Sprintf("op-%d", i),
+				Fn: func(ctx context.Context) (interface{}, error) {
+					n := atomic.AddInt32(&running, 1)
+					defer atomic.AddInt32(&running, -1)
+					for {
+						m := atomic.LoadInt32(&maxRunning)
+						if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+							break
+						}
+					}
+					time.Sleep(5 * time.Millisecond)
+					return i * 2, nil
+				},
+			})
+		}
+
+		var progress []BatchProgress
+		results, err := RunBatch(ctx, ops, BatchOptions{
+			Concurrency: 3,
+			Progress: func(p BatchProgress) {
+				progress = append(progress, p)
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 20)
+
+		for i, r := range results {
+			assert.Equal(t, i, r.Index)
+			assert.Equal(t, fmt.Sprintf("op-%d", i), r.Name)
+			assert.Equal(t, i*2, r.Value)
+			assert.False(t, r.Skipped)
+		}
+		assert.True(t, maxRunning <= 3, "ran %d operations at the same time", maxRunning)
+
+		require.Len(t, progress, 20)
+		assert.Equal(t, 20, progress[19].Completed)
+		assert.Equal(t, 20, progress[19].Total)
+		assert.Equal(t, 0, progress[19].Failed)
+	})
+
+	t.Run("continuing after errors", func(t *testing.T) {
+		ops := []BatchOperation{
+			{Name: "a", Fn: func(ctx context.Context) (interface{}, error) { return "a", nil }},
+			{Name: "b", Fn: func(ctx context.Context) (interface{}, error) { return nil, errors.New("b failed") }},
+			{Name: "c", Fn: func(ctx context.Context) (interface{}, error) { return "c", nil }},
+		}
+
+		results, err := RunBatch(ctx, ops, BatchOptions{Concurrency: 1})
+		require.Len(t, results, 3)
+
+		batchErr, ok := err.(*BatchError)
+		require.True(t, ok)
+		assert.Equal(t, 3, batchErr.Total)
+		assert.Equal(t, 0, batchErr.Skipped)
+		require.Len(t, batchErr.Failed, 1)
+		assert.Equal(t, "b", batchErr.Failed[0].Name)
+		assert.EqualError(t, err, "1 of 3 batch operations failed, first error (b): b failed")
+		assert.Equal(t, "c", results[2].Value)
+	})
+
+	t.Run("stopping on the first error", func(t *testing.T) {
+		var started int32
+		var ops []BatchOperation
+		for i := 0; i < 10; i++ {
+			i := i
+			ops = append(ops, BatchOperation{Fn: func(ctx context.Context) (interface{}, error) {
+				atomic.AddInt32(&started, 1)
+				if i == 1 {
+					return nil, errors.New("failed")
+				}
+				return nil, nil
+			}})
+		}
+
+		results, err := RunBatch(ctx, ops, BatchOptions{Concurrency: 1, StopOnError: true})
+		require.Error(t, err)
+
+		batchErr := err.(*BatchError)
+		assert.Len(t, batchErr.Failed, 1)
+		assert.Equal(t, int(10-started), batchErr.Skipped)
+		assert.True(t, started < 10)
+		assert.True(t, results[9].Skipped)
+	})
+
+	t.Run("with invalid options", func(t *testing.T) {
+		_, err := RunBatch(ctx, nil, BatchOptions{Concurrency: -1})
+		assert.EqualError(t, err, "concurrency must not be negative")
+
+		_, err = RunBatch(ctx, []BatchOperation{{Name: "a"}}, BatchOptions{})
+		assert.EqualError(t, err, "missing function for batch operation 0")
+	})
+
+	t.Run("with client calls", func(t *testing.T) {
+		var mu sync.Mutex
+		updated := make(map[string]bool)
+		client, done := testMiddlewareClient(t, func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			updated[r.URL.Path] = true
+			mu.Unlock()
+			testWorkspaceHandler(w, r)
+		})
+		defer done()
+
+		var ops []BatchOperation
+		for _, id := range []string{"ws-1", "ws-2", "ws-3"} {
+			id := id
+			ops = append(ops, BatchOperation{Name: id, Fn: func(ctx context.Context) (interface{}, error) {
+				return client.Workspaces.UpdateByID(ctx, id, WorkspaceUpdateOptions{AutoApply: Bool(true)})
+			}})
+		}
+
+		results, err := RunBatch(ctx, ops, BatchOptions{})
+		require.NoError(t, err)
+		assert.IsType(t, &Workspace{}, results[0].Value)
+		assert.Len(t, updated, 3)
+	})
+}
@@ -0,0 +1,393 @@
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// DefaultCacheTTL is the time a cached response is used without asking the
+// server, when no TTL is configured for its resource type.
+const DefaultCacheTTL = 5 * time.Second
+
+// CacheBackend stores the entries of a ResponseCache. Implementations must
+// be safe for concurrent use.
+type CacheBackend interface {
+	// Get returns the entry stored for key.
+	Get(key string) (*CacheEntry, bool)
+
+	// Set stores the entry for key, replacing any existing entry.
+	Set(key string, entry *CacheEntry)
+
+	// Delete removes the entry stored for key.
+	Delete(key string)
+
+	// Range calls fn for every stored entry until fn returns false. Entries
+	// can be deleted from within fn.
+	Range(fn func(key string, entry *CacheEntry) bool)
+}
+
+// CacheEntry is a cached API response.
+type CacheEntry struct {
+	// The headers and body of the response.
+	Header http.Header
+	Body   []byte
+
+	// The entity tag of the response, used to revalidate it once expired.
+	ETag string
+
+	// The time after which the response must be revalidated.
+	Expires time.Time
+
+	// The resources the response contains, in the form "type/id", used to
+	// invalidate the entry when one of them is modified.
+	Resources []string
+}
+
+// ResponseCacheOptions represents the options for creating a ResponseCache.
+type ResponseCacheOptions struct {
+	// The backend storing the cached responses. A MemoryCache is used when
+	// left empty.
+	Backend CacheBackend
+
+	// The time responses are used without asking the server. The
+	// DefaultCacheTTL is used when left empty.
+	TTL time.Duration
+
+	// TTLs per resource type, overriding TTL. The resource type is the last
+	// collection in the request path, like "workspaces", "runs" or
+	// "capacity". A negative TTL disables caching for the resource type, and
+	// a TTL of zero revalidates every response with the server.
+	ResourceTTLs map[string]time.Duration
+}
+
+// ResponseCache caches the responses of API reads, so repeated reads of
+// the same resource do not use up the rate limit. Set it as the Cache field
+// of the client config to enable it.
+//
+// Responses are cached per URL and API token. Once the TTL of a response
+// expired, it is revalidated using its ETag if the server provided one,
+// and requested again otherwise. Requests that modify a resource remove
+// all cached responses containing it, or belonging to a resource addressed
+// by the request. A ResponseCache is safe for concurrent use and can be
+// shared by multiple clients.
+type ResponseCache struct {
+	backend      CacheBackend
+	ttl          time.Duration
+	resourceTTLs map[string]time.Duration
+	now          func() time.Time
+}
+
+// NewResponseCache creates a new ResponseCache.
+func NewResponseCache(options ResponseCacheOptions) *ResponseCache {
+	c := &ResponseCache{
+		backend:      options.Backend,
+		ttl:          options.TTL,
+		resourceTTLs: make(map[string]time.Duration, len(options.ResourceTTLs)),
+		now:          time.Now,
+	}
+	if c.backend == nil {
+		c.backend = NewMemoryCache()
+	}
+	if c.ttl == 0 {
+		c.ttl = DefaultCacheTTL
+	}
+	for resourceType, ttl := range options.ResourceTTLs {
+		c.resourceTTLs[resourceType] = ttl
+	}
+	return c
+}
+
+// resourceTTL returns the TTL for the given resource type and whether its
+// responses are cached at all.
+func (c *ResponseCache) resourceTTL(resourceType string) (time.Duration, bool) {
+	ttl, ok := c.resourceTTLs[resourceType]
+	if !ok {
+		ttl = c.ttl
+	}
+	return ttl, ttl >= 0
+}
+
+// key returns the cache key for a read of the URL using the token.
+func (c *ResponseCache) key(url, token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:]) + " " + url
+}
+
+// lookup returns the entry for key and whether it is still fresh.
+func (c *ResponseCache) lookup(key string) (*CacheEntry, bool) {
+	entry, ok := c.backend.Get(key)
+	if !ok {
+		return nil, false
+	}
+	return entry, c.now().Before(entry.Expires)
+}
+
+// store caches the body of a response to a read of op.
+func (c *ResponseCache) store(key string, op Operation, header http.Header, body []byte) {
+	ttl, ok := c.resourceTTL(op.ResourceType)
+	if !ok {
+		return
+	}
+
+	entry := &CacheEntry{
+		Header:    cloneCacheHeader(header),
+		Body:      body,
+		ETag:      header.Get("ETag"),
+		Expires:   c.now().Add(ttl),
+		Resources: cacheResources(op, body, false),
+	}
+
+	// Responses that expire immediately are only useful to revalidate.
+	if ttl == 0 && entry.ETag == "" {
+		c.backend.Delete(key)
+		return
+	}
+
+	c.backend.Set(key, entry)
+}
+
+// refresh extends the lifetime of an entry that was revalidated.
+func (c *ResponseCache) refresh(key string, op Operation, entry *CacheEntry) {
+	ttl, _ := c.resourceTTL(op.ResourceType)
+
+	refreshed := *entry
+	refreshed.Expires = c.now().Add(ttl)
+	c.backend.Set(key, &refreshed)
+}
+
+// invalidate removes all entries containing a resource modified by a
+// request for op, which returned body.
+func (c *ResponseCache) invalidate(op Operation, body []byte) {
+	modified := make(map[string]bool)
+	for _, r := range cacheResources(op, body, true) {
+		modified[r] = true
+	}
+
+	c.backend.Range(func(key string, entry *CacheEntry) bool {
+		for _, r := range entry.Resources {
+			if modified[r] {
+				c.backend.Delete(key)
+				break
+			}
+		}
+		return true
+	})
+}
+
+// cacheResources returns the resources a response to op contains, in the
+// form "type/id". These are the resources addressed by the request path and
+// the primary data of the response. With relationships set, the resources
+// related to the primary data are returned as well.
+func cacheResources(op Operation, body []byte, relationships bool) []string {
+	var resources []string
+	for collection, id := range op.IDs {
+		resources = append(resources, collection+"/"+id)
+	}
+
+	type identifier struct {
+		Type string `json:"type"`
+		ID   string `json:"id"`
+	}
+	type resource struct {
+		identifier
+		Relationships map[string]struct {
+			Data json.RawMessage `json:"data"`
+		} `json:"relationships"`
+	}
+	var doc struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if len(body) == 0 || json.Unmarshal(body, &doc) != nil {
+		return resources
+	}
+
+	var data []resource
+	if err := json.Unmarshal(doc.Data, &data); err != nil {
+		var single resource
+		if json.Unmarshal(doc.Data, &single) != nil {
+			return resources
+		}
+		data = []resource{single}
+	}
+
+	add := func(id identifier) {
+		if id.Type != "" && id.ID != "" {
+			resources = append(resources, id.Type+"/"+id.ID)
+		}
+	}
+	for _, r := range data {
+		add(r.identifier)
+		if !relationships {
+			continue
+		}
+		for _, rel := range r.Relationships {
+			var related []identifier
+			if err := json.Unmarshal(rel.Data, &related); err != nil {
+				var single identifier
+				if json.Unmarshal(rel.Data, &single) != nil {
+					continue
+				}
+				related = []identifier{single}
+			}
+			for _, id := range related {
+				add(id)
+			}
+		}
+	}
+
+	return resources
+}
+
+// cacheLookup returns the cache key for a read and the cached entry, if
+// any, and whether the entry is still fresh. The request is prepared to
+// revalidate an expired entry.
+func (c *Client) cacheLookup(ctx context.Context, req *retryablehttp.Request) (string, *CacheEntry, bool, error) {
+	token, err := c.tokenSource.Token(ctx)
+	if err != nil {
+		return "", nil, false, err
+	}
+
+	key := c.cache.key(req.URL.String(), token)
+	entry, fresh := c.cache.lookup(key)
+	if entry != nil && !fresh && entry.ETag != "" {
+		req.Header.Set("If-None-Match", entry.ETag)
+	}
+
+	return key, entry, fresh, nil
+}
+
+// updateCache caches the response to a read, serves the cached entry when
+// the server reports it is still valid, or invalidates the entries of the
+// resources modified by any other request. It returns the response to use.
+func (c *Client) updateCache(ctx context.Context, op Operation, req *retryablehttp.Request, key string, cached *CacheEntry, resp *http.Response) (*http.Response, error) {
+	if req.Method != "GET" {
+		body, err := readResponseBody(resp)
+		if err != nil {
+			return nil, err
+		}
+		c.cache.invalidate(op, body)
+		return resp, nil
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		resp.Body.Close()
+		c.cache.refresh(key, op, cached)
+		if info := callInfo(ctx); info != nil {
+			info.StatusCode = http.StatusOK
+			info.Cached = true
+		}
+		return cached.response(req.Request), nil
+	}
+
+	if cacheable(resp) {
+		body, err := readResponseBody(resp)
+		if err != nil {
+			return nil, err
+		}
+		c.cache.store(key, op, resp.Header, body)
+	}
+
+	return resp, nil
+}
+
+// readResponseBody reads the body of a response and replaces it with a
+// copy, so it can be decoded afterwards.
+func readResponseBody(resp *http.Response) ([]byte, error) {
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// response creates a response serving the cached body.
+func (e *CacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Header:        cloneCacheHeader(e.Header),
+		Body:          ioutil.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+// cacheable reports whether the response to a read can be cached. Only
+// successful JSON:API responses are cached.
+func cacheable(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusOK && strings.Contains(resp.Header.Get("Content-Type"), "json")
+}
+
+func cloneCacheHeader(h http.Header) http.Header {
+	clone := make(http.Header, len(h))
+	for k, v := range h {
+		clone[k] = append([]string(nil), v...)
+	}
+	return clone
+}
+
+// MemoryCache is a CacheBackend storing entries in memory. It is the
+// backend used by a ResponseCache when no backend is configured.
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]*CacheEntry
+}
+
+var _ CacheBackend = (*MemoryCache)(nil)
+
+// NewMemoryCache creates a new, empty MemoryCache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: make(map[string]*CacheEntry)}
+}
+
+// Get implements CacheBackend.
+func (m *MemoryCache) Get(key string) (*CacheEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.entries[key]
+	return entry, ok
+}
+
+// Set implements CacheBackend.
+func (m *MemoryCache) Set(key string, entry *CacheEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[key] = entry
+}
+
+// Delete implements CacheBackend.
+func (m *MemoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.entries, key)
+}
+
+// Range implements CacheBackend.
+func (m *MemoryCache) Range(fn func(key string, entry *CacheEntry) bool) {
+	m.mu.Lock()
+	keys := make([]string, 0, len(m.entries))
+	entries := make([]*CacheEntry, 0, len(m.entries))
+	for k, e := range m.entries {
+		keys = append(keys, k)
+		entries = append(entries, e)
+	}
+	m.mu.Unlock()
+
+	for i, k := range keys {
+		if !fn(k, entries[i]) {
+			return
+		}
+	}
+}
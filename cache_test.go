@@ -0,0 +1,157 @@
+package tfe
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCacheClient(t *testing.T, cache *ResponseCache, token string, h http.HandlerFunc) (*Client, func()) {
+	client, done := testMiddlewareClient(t, h)
+	client.cache = cache
+	client.tokenSource = StaticTokenSource(token)
+	return client, done
+}
+
+func TestResponseCache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("serving fresh responses", func(t *testing.T) {
+		var requests int32
+		cache := NewResponseCache(ResponseCacheOptions{})
+		client, done := testCacheClient(t, cache, "token", func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			testWorkspaceHandler(w, r)
+		})
+		defer done()
+
+		for i := 0; i < 3; i++ {
+			ws, err := client.Workspaces.ReadByID(ctx, "ws-123")
+			require.NoError(t, err)
+			assert.Equal(t, "my-workspace", ws.Name)
+		}
+		assert.Equal(t, int32(1), requests)
+
+		// Responses are cached per token.
+		other, done := testCacheClient(t, cache, "other-token", func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			testWorkspaceHandler(w, r)
+		})
+		defer done()
+
+		_, err := other.Workspaces.ReadByID(ctx, "ws-123")
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), requests)
+	})
+
+	t.Run("revalidating expired responses", func(t *testing.T) {
+		now := time.Now()
+		cache := NewResponseCache(ResponseCacheOptions{
+			ResourceTTLs: map[string]time.Duration{"workspaces": time.Minute},
+		})
+		cache.now = func() time.Time { return now }
+
+		var requests, notModified int32
+		client, done := testCacheClient(t, cache, "token", func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				atomic.AddInt32(&notModified, 1)
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", `"v1"`)
+			testWorkspaceHandler(w, r)
+		})
+		defer done()
+
+		_, err := client.Workspaces.ReadByID(ctx, "ws-123")
+		require.NoError(t, err)
+
+		now = now.Add(30 * time.Second)
+		_, err = client.Workspaces.ReadByID(ctx, "ws-123")
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), requests)
+
+		now = now.Add(time.Minute)
+		ws, err := client.Workspaces.ReadByID(ctx, "ws-123")
+		require.NoError(t, err)
+		assert.Equal(t, "my-workspace", ws.Name)
+		assert.Equal(t, int32(2), requests)
+		assert.Equal(t, int32(1), notModified)
+
+		// The revalidated response is fresh again.
+		_, err = client.Workspaces.ReadByID(ctx, "ws-123")
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), requests)
+	})
+
+	t.Run("invalidating modified resources", func(t *testing.T) {
+		var reads int32
+		cache := NewResponseCache(ResponseCacheOptions{TTL: time.Hour})
+		client, done := testCacheClient(t, cache, "token", func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == "GET" {
+				atomic.AddInt32(&reads, 1)
+			}
+			testWorkspaceHandler(w, r)
+		})
+		defer done()
+
+		_, err := client.Workspaces.Read(ctx, "my-org", "my-workspace")
+		require.NoError(t, err)
+		_, err = client.Workspaces.ReadByID(ctx, "ws-123")
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), reads)
+
+		// Both reads return ws-123, so both are invalidated.
+		_, err = client.Workspaces.UpdateByID(ctx, "ws-123", WorkspaceUpdateOptions{AutoApply: Bool(true)})
+		require.NoError(t, err)
+
+		_, err = client.Workspaces.Read(ctx, "my-org", "my-workspace")
+		require.NoError(t, err)
+		_, err = client.Workspaces.ReadByID(ctx, "ws-123")
+		require.NoError(t, err)
+		assert.Equal(t, int32(4), reads)
+	})
+
+	t.Run("with caching disabled for a resource type", func(t *testing.T) {
+		var requests int32
+		cache := NewResponseCache(ResponseCacheOptions{
+			ResourceTTLs: map[string]time.Duration{"workspaces": -1},
+		})
+		client, done := testCacheClient(t, cache, "token", func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			testWorkspaceHandler(w, r)
+		})
+		defer done()
+
+		for i := 0; i < 2; i++ {
+			_, err := client.Workspaces.ReadByID(ctx, "ws-123")
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int32(2), requests)
+	})
+}
+
+func TestCacheResources(t *testing.T) {
+	body := []byte(`{"data": {
+		"id": "run-1",
+		"type": "runs",
+		"relationships": {
+			"workspace": {"data": {"id": "ws-1", "type": "workspaces"}},
+			"policy-checks": {"data": [{"id": "polchk-1", "type": "policy-checks"}]},
+			"apply": {"data": null}
+		}
+	}}`)
+	op := Operation{ResourceType: "runs", IDs: map[string]string{}}
+
+	assert.Equal(t, []string{"runs/run-1"}, cacheResources(op, body, false))
+	assert.ElementsMatch(t, []string{"runs/run-1", "workspaces/ws-1", "policy-checks/polchk-1"}, cacheResources(op, body, true))
+
+	op = parseOperationPath("runs/run-1/actions/apply")
+	assert.Equal(t, []string{"runs/run-1"}, cacheResources(op, nil, true))
+}
@@ -0,0 +1,361 @@
+// Package cassette provides an HTTP transport that records real API
+// interactions into fixture files, called cassettes, and replays them
+// later without network access.
+//
+// In record mode every request is sent using the underlying transport and
+// the scrubbed request and response are appended to the cassette, which is
+// saved after every interaction. In replay mode the interactions are served
+// in the order they were recorded, and requests that do not match the next
+// recorded interaction fail:
+//
+//	rec, err := cassette.New("fixtures/workspaces.json", cassette.ModeReplay, nil)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	client, err := tfe.NewClient(&tfe.Config{
+//		Token:      token,
+//		HTTPClient: rec.Client(),
+//	})
+//
+// Values that differ between runs, like randomly generated resource names,
+// should be obtained using Recorder.Value, so the values of the recording
+// are used when replaying it.
+package cassette
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// ErrNotFound is returned by New when a cassette that should be replayed
+// does not exist.
+var ErrNotFound = errors.New("cassette not found")
+
+// Mode determines whether a Recorder records or replays interactions.
+type Mode int
+
+// List of available modes.
+const (
+	// ModeReplay serves the interactions of an existing cassette.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends all requests and records the interactions.
+	ModeRecord
+)
+
+// Cassette holds all recorded interactions and values.
+type Cassette struct {
+	// The values returned by Recorder.Value, in order.
+	Values []string `json:"values,omitempty"`
+
+	// The recorded interactions, in order.
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a single recorded request and its response.
+type Interaction struct {
+	Request  *Request  `json:"request"`
+	Response *Response `json:"response"`
+}
+
+// Request is a recorded request.
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+
+	// The body of the request, if it is valid UTF-8. Other bodies, like
+	// uploaded archives, are not recorded.
+	Body string `json:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+
+	// BodyEncoding is "base64" when the body is not valid UTF-8.
+	BodyEncoding string `json:"body_encoding,omitempty"`
+}
+
+// Scrubber removes sensitive data from an interaction before it is saved.
+type Scrubber func(*Interaction)
+
+// Recorder is an http.RoundTripper that records or replays a cassette. It
+// is safe for concurrent use, but interactions are replayed in the order
+// they were recorded, so requests should be made sequentially.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	scrubbers []Scrubber
+
+	mu       sync.Mutex
+	cassette *Cassette
+	next     int
+	values   int
+}
+
+var _ http.RoundTripper = (*Recorder)(nil)
+
+// New creates a new Recorder for the cassette stored at path. In replay
+// mode the cassette is loaded immediately and ErrNotFound is returned if it
+// does not exist. In record mode any existing cassette is replaced and
+// requests are sent using transport, or http.DefaultTransport if nil.
+//
+// The DefaultScrubbers are applied to all recorded interactions. Additional
+// scrubbers can be added using AddScrubber.
+func New(path string, mode Mode, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	r := &Recorder{
+		path:      path,
+		mode:      mode,
+		transport: transport,
+		scrubbers: DefaultScrubbers(),
+		cassette:  &Cassette{},
+	}
+
+	switch mode {
+	case ModeRecord:
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return nil, err
+		}
+		if err := r.save(); err != nil {
+			return nil, err
+		}
+	case ModeReplay:
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil, fmt.Errorf("%w: %s", ErrNotFound, path)
+			}
+			return nil, err
+		}
+		if err := json.Unmarshal(content, r.cassette); err != nil {
+			return nil, fmt.Errorf("error decoding cassette %s: %v", path, err)
+		}
+	default:
+		return nil, fmt.Errorf("invalid mode %d", mode)
+	}
+
+	return r, nil
+}
+
+// Mode returns the mode of the recorder.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// AddScrubber adds a scrubber that is applied to all interactions recorded
+// from now on.
+func (r *Recorder) AddScrubber(s Scrubber) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.scrubbers = append(r.scrubbers, s)
+}
+
+// Client returns an HTTP client that uses the recorder as its transport.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Value returns a value that differs between runs, like a random resource
+// name. In record mode the value returned by generate is recorded, and in
+// replay mode the recorded values are returned in the same order.
+func (r *Recorder) Value(generate func() (string, error)) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.mode == ModeReplay {
+		if r.values >= len(r.cassette.Values) {
+			return "", fmt.Errorf("cassette %s has no more recorded values", r.path)
+		}
+		v := r.cassette.Values[r.values]
+		r.values++
+		return v, nil
+	}
+
+	v, err := generate()
+	if err != nil {
+		return "", err
+	}
+	r.cassette.Values = append(r.cassette.Values, v)
+
+	return v, r.save()
+}
+
+// Remaining returns the number of recorded interactions that were not
+// replayed yet.
+func (r *Recorder) Remaining() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.mode != ModeReplay {
+		return 0
+	}
+	return len(r.cassette.Interactions) - r.next
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.mode == ModeReplay {
+		return r.replay(req)
+	}
+	return r.record(req)
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		if reqBody, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = ioutil.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	interaction := &Interaction{
+		Request: &Request{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Header: cloneHeader(req.Header),
+		},
+		Response: &Response{
+			StatusCode: resp.StatusCode,
+			Header:     cloneHeader(resp.Header),
+		},
+	}
+	if utf8.Valid(reqBody) {
+		interaction.Request.Body = string(reqBody)
+	}
+	if utf8.Valid(respBody) {
+		interaction.Response.Body = string(respBody)
+	} else {
+		interaction.Response.Body = base64.StdEncoding.EncodeToString(respBody)
+		interaction.Response.BodyEncoding = "base64"
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, scrub := range r.scrubbers {
+		scrub(interaction)
+	}
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+
+	if err := r.save(); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.next >= len(r.cassette.Interactions) {
+		return nil, fmt.Errorf("cassette %s has no more interactions for %s %s", r.path, req.Method, req.URL.RequestURI())
+	}
+
+	interaction := r.cassette.Interactions[r.next]
+	if !matches(interaction.Request, req) {
+		return nil, fmt.Errorf(
+			"cassette %s expected %s %s as interaction %d, got %s %s",
+			r.path, interaction.Request.Method, requestURI(interaction.Request.URL),
+			r.next, req.Method, req.URL.RequestURI(),
+		)
+	}
+	r.next++
+
+	body := []byte(interaction.Response.Body)
+	if interaction.Response.BodyEncoding == "base64" {
+		var err error
+		if body, err = base64.StdEncoding.DecodeString(interaction.Response.Body); err != nil {
+			return nil, err
+		}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        cloneHeader(interaction.Response.Header),
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// save writes the cassette to its path. It must be called with the lock
+// held.
+func (r *Recorder) save() error {
+	content, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, append(content, '\n'), 0644)
+}
+
+// matches reports whether req matches the recorded request. The scheme and
+// host are ignored, so a cassette can be replayed against any address.
+func matches(recorded *Request, req *http.Request) bool {
+	return recorded.Method == req.Method && requestURI(recorded.URL) == req.URL.RequestURI()
+}
+
+// requestURI returns the path and query of a recorded URL.
+func requestURI(rawURL string) string {
+	if i := strings.Index(rawURL, "://"); i >= 0 {
+		rawURL = rawURL[i+3:]
+		if j := strings.Index(rawURL, "/"); j >= 0 {
+			return rawURL[j:]
+		}
+		return "/"
+	}
+	return rawURL
+}
+
+func cloneHeader(h http.Header) http.Header {
+	if h == nil {
+		return nil
+	}
+	clone := make(http.Header, len(h))
+	for k, v := range h {
+		clone[k] = append([]string(nil), v...)
+	}
+	return clone
+}
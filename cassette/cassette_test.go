@@ -0,0 +1,174 @@
+package cassette
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassette")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "cassettes", "test.json")
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.Write([]byte(`{"data":{"id":"at-1","type":"authentication-tokens","attributes":{"token":"secret","description":"` + r.URL.Query().Get("q") + `"}}}`))
+	}))
+	defer ts.Close()
+
+	t.Run("record", func(t *testing.T) {
+		rec, err := New(path, ModeRecord, nil)
+		require.NoError(t, err)
+
+		name, err := rec.Value(func() (string, error) { return "random-name", nil })
+		require.NoError(t, err)
+		assert.Equal(t, "random-name", name)
+
+		req, err := http.NewRequest("POST", ts.URL+"/api/v2/tokens?q=one", strings.NewReader(
+			`{"data":{"type":"vars","attributes":{"key":"k","value":"hidden","sensitive":true}}}`,
+		))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer secret")
+
+		resp, err := rec.Client().Do(req)
+		require.NoError(t, err)
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		// The caller receives the response as it was sent.
+		assert.Contains(t, string(body), `"token":"secret"`)
+
+		content, err := ioutil.ReadFile(path)
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), "secret")
+		assert.NotContains(t, string(content), "hidden")
+		assert.Contains(t, string(content), `\"key\":\"k\"`)
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		rec, err := New(path, ModeReplay, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 1, rec.Remaining())
+
+		name, err := rec.Value(func() (string, error) { return "other-name", nil })
+		require.NoError(t, err)
+		assert.Equal(t, "random-name", name)
+
+		// The host is ignored when matching requests.
+		resp, err := rec.Client().Post("http://example.com/api/v2/tokens?q=one", "application/json", nil)
+		require.NoError(t, err)
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, "application/vnd.api+json", resp.Header.Get("Content-Type"))
+		assert.Equal(t, Redacted, resp.Header.Get("Set-Cookie"))
+		assert.Contains(t, string(body), `"token":"[REDACTED]"`)
+		assert.Contains(t, string(body), `"description":"one"`)
+		assert.Equal(t, 0, rec.Remaining())
+
+		_, err = rec.Client().Get("http://example.com/api/v2/tokens")
+		assert.Error(t, err)
+	})
+
+	t.Run("replay with a mismatched request", func(t *testing.T) {
+		rec, err := New(path, ModeReplay, nil)
+		require.NoError(t, err)
+
+		_, err = rec.Client().Post("http://example.com/api/v2/tokens?q=two", "application/json", nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "expected POST /api/v2/tokens?q=one")
+	})
+
+	t.Run("replay without a cassette", func(t *testing.T) {
+		_, err := New(filepath.Join(dir, "missing.json"), ModeReplay, nil)
+		assert.True(t, errors.Is(err, ErrNotFound))
+	})
+}
+
+func TestScrubAttributes(t *testing.T) {
+	i := &Interaction{
+		Request: &Request{},
+		Response: &Response{Body: `{
+			"data": [
+				{"type": "ssh-keys", "attributes": {"name": "key", "value": "private"}},
+				{"type": "vars", "attributes": {"key": "a", "value": "plain", "sensitive": false}},
+				{"type": "vars", "attributes": {"key": "b", "value": "hidden", "sensitive": true}}
+			],
+			"included": [
+				{"type": "oauth-tokens", "attributes": {"oauth-token-string": "secret"}}
+			]
+		}`},
+	}
+	ScrubAttributes(secretAttribute)(i)
+
+	assert.JSONEq(t, `{
+		"data": [
+			{"type": "ssh-keys", "attributes": {"name": "key", "value": "[REDACTED]"}},
+			{"type": "vars", "attributes": {"key": "a", "value": "plain", "sensitive": false}},
+			{"type": "vars", "attributes": {"key": "b", "value": "[REDACTED]", "sensitive": true}}
+		],
+		"included": [
+			{"type": "oauth-tokens", "attributes": {"oauth-token-string": "[REDACTED]"}}
+		]
+	}`, i.Response.Body)
+
+	// Bodies without secrets are left untouched.
+	i.Response.Body = "not json"
+	ScrubAttributes(secretAttribute)(i)
+	assert.Equal(t, "not json", i.Response.Body)
+}
+
+func TestScrubSignedURLs(t *testing.T) {
+	scrub := ScrubSignedURLs("upload-url", "log-read-url")
+
+	created := &Interaction{
+		Request: &Request{Method: "POST", URL: "https://app.terraform.io/api/v2/workspaces/ws-123/configuration-versions"},
+		Response: &Response{Body: `{
+			"data": {"type": "configuration-versions", "attributes": {
+				"status": "pending",
+				"upload-url": "https://archivist.terraform.io/v1/object/secret-signature"
+			}}
+		}`},
+	}
+	scrub(created)
+
+	var doc struct {
+		Data struct {
+			Attributes map[string]string `json:"attributes"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(created.Response.Body), &doc))
+	placeholder := doc.Data.Attributes["upload-url"]
+	assert.NotContains(t, created.Response.Body, "secret-signature")
+	assert.True(t, strings.HasPrefix(placeholder, "https://"+SignedURLHost+"/"))
+	assert.Equal(t, "pending", doc.Data.Attributes["status"])
+
+	// The upload to the signed URL is recorded with the placeholder.
+	uploaded := &Interaction{
+		Request:  &Request{Method: "PUT", URL: "https://archivist.terraform.io/v1/object/secret-signature"},
+		Response: &Response{},
+	}
+	scrub(uploaded)
+	assert.Equal(t, placeholder, uploaded.Request.URL)
+
+	// Placeholders are not replaced again.
+	body := created.Response.Body
+	scrub(created)
+	assert.Equal(t, body, created.Response.Body)
+}
@@ -0,0 +1,172 @@
+package cassette
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+)
+
+// Redacted replaces all scrubbed values.
+const Redacted = "[REDACTED]"
+
+// DefaultScrubbers returns the scrubbers applied to every recorder. They
+// remove credentials from headers and secret attributes, like tokens,
+// private keys and sensitive variable values, from JSON:API documents, and
+// replace the signed URLs used to upload configurations and to download
+// logs and states.
+func DefaultScrubbers() []Scrubber {
+	return []Scrubber{
+		ScrubHeaders("Authorization", "Cookie", "Set-Cookie"),
+		ScrubAttributes(secretAttribute),
+		ScrubSignedURLs("upload-url", "log-read-url", "hosted-state-download-url"),
+	}
+}
+
+// ScrubHeaders returns a Scrubber that redacts the given request and
+// response headers.
+func ScrubHeaders(names ...string) Scrubber {
+	return func(i *Interaction) {
+		for _, name := range names {
+			if i.Request.Header.Get(name) != "" {
+				i.Request.Header.Set(name, Redacted)
+			}
+			if i.Response.Header.Get(name) != "" {
+				i.Response.Header.Set(name, Redacted)
+			}
+		}
+	}
+}
+
+// ScrubAttributes returns a Scrubber that redacts attributes of the
+// resources in JSON request and response bodies. The secret function is
+// called with the resource type, the attribute name and all attributes of
+// the resource, and reports whether the attribute must be redacted. Bodies
+// that are not JSON are left untouched.
+func ScrubAttributes(secret func(resourceType, name string, attributes map[string]interface{}) bool) Scrubber {
+	rewrite := func(resourceType, name string, attributes map[string]interface{}) (interface{}, bool) {
+		value := attributes[name]
+		if value == nil || value == Redacted || !secret(resourceType, name, attributes) {
+			return nil, false
+		}
+		return Redacted, true
+	}
+
+	return func(i *Interaction) {
+		i.Request.Body = scrubBody(i.Request.Body, rewrite)
+		if i.Response.BodyEncoding == "" {
+			i.Response.Body = scrubBody(i.Response.Body, rewrite)
+		}
+	}
+}
+
+// SignedURLHost is the host of the placeholders replacing signed URLs.
+const SignedURLHost = "signed-url.invalid"
+
+// ScrubSignedURLs returns a Scrubber that replaces the signed URLs held by
+// the given attributes with placeholders. The signature grants access to
+// the object without further credentials, so it must not be recorded.
+// Requests later made to a signed URL, like uploading a configuration, are
+// recorded with its placeholder as well, so they still match the requests
+// made with the placeholder when the cassette is replayed.
+func ScrubSignedURLs(names ...string) Scrubber {
+	// The signed URLs seen in responses of the recorder, by placeholder.
+	placeholders := make(map[string]string)
+
+	rewrite := func(resourceType, name string, attributes map[string]interface{}) (interface{}, bool) {
+		signed, ok := attributes[name].(string)
+		if !ok || signed == "" || strings.Contains(signed, "://"+SignedURLHost+"/") {
+			return nil, false
+		}
+		for _, n := range names {
+			if n == name {
+				sum := sha256.Sum256([]byte(signed))
+				placeholder := "https://" + SignedURLHost + "/" + hex.EncodeToString(sum[:8])
+				placeholders[signed] = placeholder
+				return placeholder, true
+			}
+		}
+		return nil, false
+	}
+
+	return func(i *Interaction) {
+		if placeholder, ok := placeholders[i.Request.URL]; ok {
+			i.Request.URL = placeholder
+		}
+		if i.Response.BodyEncoding == "" {
+			i.Response.Body = scrubBody(i.Response.Body, rewrite)
+		}
+	}
+}
+
+// secretAttribute reports whether an attribute holds a secret.
+func secretAttribute(resourceType, name string, attributes map[string]interface{}) bool {
+	switch name {
+	case "token", "oauth-token-string", "private-key", "ssh-key":
+		return true
+	case "value":
+		if resourceType == "ssh-keys" {
+			return true
+		}
+		sensitive, _ := attributes["sensitive"].(bool)
+		return sensitive
+	}
+	return false
+}
+
+// rewriteFunc returns the new value of an attribute of a resource, and
+// whether it needs to be replaced.
+type rewriteFunc func(resourceType, name string, attributes map[string]interface{}) (interface{}, bool)
+
+func scrubBody(body string, rewrite rewriteFunc) string {
+	trimmed := strings.TrimSpace(body)
+	if !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") {
+		return body
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal([]byte(body), &doc); err != nil {
+		return body
+	}
+	if !scrubValue(doc, rewrite) {
+		return body
+	}
+
+	scrubbed, err := json.Marshal(doc)
+	if err != nil {
+		return body
+	}
+	return string(scrubbed)
+}
+
+// scrubValue walks a decoded JSON value and rewrites the attributes of all
+// resources it contains. It reports whether anything was rewritten.
+func scrubValue(v interface{}, rewrite rewriteFunc) bool {
+	changed := false
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if attributes, ok := v["attributes"].(map[string]interface{}); ok {
+			resourceType, _ := v["type"].(string)
+			for name := range attributes {
+				if value, ok := rewrite(resourceType, name, attributes); ok {
+					attributes[name] = value
+					changed = true
+				}
+			}
+		}
+		for _, child := range v {
+			if scrubValue(child, rewrite) {
+				changed = true
+			}
+		}
+	case []interface{}:
+		for _, child := range v {
+			if scrubValue(child, rewrite) {
+				changed = true
+			}
+		}
+	}
+
+	return changed
+}
@@ -0,0 +1,350 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/hcl"
+)
+
+// ErrMissingCredentials is returned by a ClientRegistry when no API token is
+// configured for a host or organization.
+var ErrMissingCredentials = errors.New("missing credentials")
+
+// tokenEnvPrefix is the prefix of the environment variables holding the API
+// token for a host, like TF_TOKEN_app_terraform_io.
+const tokenEnvPrefix = "TF_TOKEN_"
+
+// ClientRegistryConfig configures a ClientRegistry.
+type ClientRegistryConfig struct {
+	// Config is used as a template for all clients created by the registry.
+	// Its Address, Token and TokenSource are ignored, as the token is
+	// resolved per host and organization. When its HTTPClient or RateLimiter
+	// is set, it is shared by all hosts instead of creating one per host.
+	Config *Config
+
+	// The Terraform CLI configuration files to read credentials from, in
+	// order of increasing precedence. Both the credentials.tfrc.json format
+	// and the HCL format with credentials "host" {} blocks are supported.
+	// Files that do not exist are skipped. Defaults to the result of
+	// DefaultCredentialsFiles when left empty.
+	CredentialsFiles []string
+
+	// IgnoreEnvironment disables reading API tokens from TF_TOKEN_*
+	// environment variables.
+	IgnoreEnvironment bool
+}
+
+// ClientRegistry resolves clients by host and organization. It reads API
+// tokens from the Terraform CLI credentials files and TF_TOKEN_* environment
+// variables, and additional tokens, like organization or team tokens, can be
+// registered for a host or a single organization.
+//
+// All clients for the same host share an HTTP transport and a rate limiter,
+// so they reuse connections and cooperate on the rate limit of the host.
+// Clients are created on first use and cached per host and token. A
+// ClientRegistry is safe for concurrent use.
+type ClientRegistry struct {
+	config Config
+
+	mu        sync.Mutex
+	tokens    map[string]string
+	orgTokens map[registryOrg]string
+	hosts     map[string]*registryHost
+	clients   map[registryClient]*Client
+}
+
+// registryOrg identifies an organization on a host.
+type registryOrg struct {
+	host         string
+	organization string
+}
+
+// registryClient identifies a cached client.
+type registryClient struct {
+	host  string
+	token string
+}
+
+// registryHost holds the resources shared by all clients of a host.
+type registryHost struct {
+	httpClient *http.Client
+	limiter    RateLimiter
+}
+
+// NewClientRegistry creates a new ClientRegistry using the given config,
+// reading the credentials files and environment variables immediately.
+func NewClientRegistry(config *ClientRegistryConfig) (*ClientRegistry, error) {
+	if config == nil {
+		config = &ClientRegistryConfig{}
+	}
+
+	r := &ClientRegistry{
+		tokens:    make(map[string]string),
+		orgTokens: make(map[registryOrg]string),
+		hosts:     make(map[string]*registryHost),
+		clients:   make(map[registryClient]*Client),
+	}
+	if config.Config != nil {
+		r.config = *config.Config
+	}
+
+	files := config.CredentialsFiles
+	if len(files) == 0 {
+		files = DefaultCredentialsFiles()
+	}
+	for _, file := range files {
+		creds, err := readCredentialsFile(file)
+		if err != nil {
+			return nil, err
+		}
+		for host, token := range creds {
+			r.tokens[host] = token
+		}
+	}
+
+	// Tokens from the environment take precedence over the credentials
+	// files, just like in the Terraform CLI.
+	if !config.IgnoreEnvironment {
+		for host, token := range credentialsFromEnv(os.Environ()) {
+			r.tokens[host] = token
+		}
+	}
+
+	return r, nil
+}
+
+// SetToken sets the API token used for the host, overriding any token read
+// from the credentials files or environment.
+func (r *ClientRegistry) SetToken(host, token string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tokens[normalizeHost(host)] = token
+}
+
+// SetOrganizationToken sets the API token used for a single organization on
+// the host. It takes precedence over the token of the host.
+func (r *ClientRegistry) SetOrganizationToken(host, organization, token string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.orgTokens[registryOrg{host: normalizeHost(host), organization: organization}] = token
+}
+
+// Client returns the client for the host, using the token of the host. The
+// host is a hostname like "app.terraform.io", optionally with a port, or a
+// full address like "https://tfe.example.com".
+func (r *ClientRegistry) Client(ctx context.Context, host string) (*Client, error) {
+	return r.OrganizationClient(ctx, host, "")
+}
+
+// OrganizationClient returns the client for an organization on the host,
+// using the token of the organization if one is set and the token of the
+// host otherwise.
+func (r *ClientRegistry) OrganizationClient(ctx context.Context, host, organization string) (*Client, error) {
+	address, host, err := parseClientRegistryHost(host)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	token, ok := r.orgTokens[registryOrg{host: host, organization: organization}]
+	if !ok || organization == "" {
+		token, ok = r.tokens[host]
+	}
+	if !ok || token == "" {
+		r.mu.Unlock()
+		if organization != "" {
+			return nil, fmt.Errorf("%w for organization %s on host %s", ErrMissingCredentials, organization, host)
+		}
+		return nil, fmt.Errorf("%w for host %s", ErrMissingCredentials, host)
+	}
+
+	key := registryClient{host: host, token: token}
+	if client, ok := r.clients[key]; ok {
+		r.mu.Unlock()
+		return client, nil
+	}
+
+	shared := r.sharedHost(host)
+	r.mu.Unlock()
+
+	config := r.config
+	config.Address = address
+	config.Token = token
+	config.TokenSource = nil
+	config.HTTPClient = shared.httpClient
+	config.RateLimiter = shared.limiter
+
+	// Create the client without holding the lock, as it may need to contact
+	// the host. If another goroutine was faster, its client is used.
+	client, err := NewClientWithContext(ctx, &config)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existing, ok := r.clients[key]; ok {
+		return existing, nil
+	}
+	r.clients[key] = client
+
+	return client, nil
+}
+
+// sharedHost returns the resources shared by all clients of the host. It
+// must be called with the lock held.
+func (r *ClientRegistry) sharedHost(host string) *registryHost {
+	if shared, ok := r.hosts[host]; ok {
+		return shared
+	}
+
+	shared := &registryHost{
+		httpClient: r.config.HTTPClient,
+		limiter:    r.config.RateLimiter,
+	}
+	if shared.httpClient == nil {
+		shared.httpClient = cleanhttp.DefaultPooledClient()
+	}
+	if shared.limiter == nil {
+		shared.limiter = NewAdaptiveLimiter()
+	}
+	r.hosts[host] = shared
+
+	return shared
+}
+
+// parseClientRegistryHost returns the address and the normalized host of a
+// host as accepted by ClientRegistry.Client.
+func parseClientRegistryHost(host string) (string, string, error) {
+	if host == "" {
+		return "", "", errors.New("host is required")
+	}
+
+	if !strings.Contains(host, "://") {
+		host = normalizeHost(host)
+		return "https://" + host, host, nil
+	}
+
+	u, err := url.Parse(host)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid host: %v", err)
+	}
+	if u.Host == "" {
+		return "", "", fmt.Errorf("invalid host: %s", host)
+	}
+
+	return u.Scheme + "://" + u.Host, normalizeHost(u.Host), nil
+}
+
+// normalizeHost returns the hostname in the form used to look up
+// credentials.
+func normalizeHost(host string) string {
+	return strings.TrimSuffix(strings.ToLower(host), ".")
+}
+
+// DefaultCredentialsFiles returns the files the Terraform CLI reads
+// credentials from: the CLI configuration file, which can be overridden
+// using the TF_CLI_CONFIG_FILE environment variable, and the
+// credentials.tfrc.json file in the Terraform configuration directory.
+func DefaultCredentialsFiles() []string {
+	var files []string
+
+	if file := os.Getenv("TF_CLI_CONFIG_FILE"); file != "" {
+		files = append(files, file)
+	} else if runtime.GOOS == "windows" {
+		if dir := os.Getenv("APPDATA"); dir != "" {
+			files = append(files, filepath.Join(dir, "terraform.rc"))
+		}
+	} else if home, err := os.UserHomeDir(); err == nil {
+		files = append(files, filepath.Join(home, ".terraformrc"))
+	}
+
+	if runtime.GOOS == "windows" {
+		if dir := os.Getenv("APPDATA"); dir != "" {
+			files = append(files, filepath.Join(dir, "terraform.d", "credentials.tfrc.json"))
+		}
+	} else if home, err := os.UserHomeDir(); err == nil {
+		files = append(files, filepath.Join(home, ".terraform.d", "credentials.tfrc.json"))
+	}
+
+	return files
+}
+
+// readCredentialsFile reads the API tokens per host from a Terraform CLI
+// configuration file. A file that does not exist contains no credentials.
+func readCredentialsFile(file string) (map[string]string, error) {
+	src, err := ioutil.ReadFile(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	creds, err := parseCredentials(src)
+	if err != nil {
+		return nil, fmt.Errorf("error reading credentials from %s: %v", file, err)
+	}
+
+	return creds, nil
+}
+
+// parseCredentials parses the credentials blocks of a Terraform CLI
+// configuration file. HCL can decode both the HCL and the JSON format.
+func parseCredentials(src []byte) (map[string]string, error) {
+	var raw struct {
+		Credentials map[string]map[string]interface{} `hcl:"credentials"`
+	}
+	if err := hcl.Decode(&raw, string(src)); err != nil {
+		return nil, err
+	}
+
+	creds := make(map[string]string, len(raw.Credentials))
+	for host, attrs := range raw.Credentials {
+		token, ok := attrs["token"].(string)
+		if !ok || token == "" {
+			return nil, fmt.Errorf("missing token for host %s", host)
+		}
+		creds[normalizeHost(host)] = token
+	}
+
+	return creds, nil
+}
+
+// credentialsFromEnv returns the API tokens per host set in TF_TOKEN_*
+// environment variables. Like the Terraform CLI, periods in the hostname are
+// encoded as underscores and hyphens as double underscores, so the token for
+// tfe.my-company.com is read from TF_TOKEN_tfe_my__company_com.
+func credentialsFromEnv(environ []string) map[string]string {
+	creds := make(map[string]string)
+
+	for _, kv := range environ {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 || parts[1] == "" || !strings.HasPrefix(parts[0], tokenEnvPrefix) {
+			continue
+		}
+
+		encoded := strings.TrimPrefix(parts[0], tokenEnvPrefix)
+		if encoded == "" {
+			continue
+		}
+
+		host := strings.Replace(encoded, "__", "-", -1)
+		host = strings.Replace(host, "_", ".", -1)
+		creds[normalizeHost(host)] = parts[1]
+	}
+
+	return creds
+}
@@ -0,0 +1,232 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCredentials(t *testing.T) {
+	t.Run("with the JSON format", func(t *testing.T) {
+		creds, err := parseCredentials([]byte(`{
+			"credentials": {
+				"app.terraform.io": {"token": "tfc-token"},
+				"TFE.example.com": {"token": "tfe-token"}
+			}
+		}`))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"app.terraform.io": "tfc-token",
+			"tfe.example.com":  "tfe-token",
+		}, creds)
+	})
+
+	t.Run("with the HCL format", func(t *testing.T) {
+		creds, err := parseCredentials([]byte(`
+			plugin_cache_dir = "/tmp/plugins"
+
+			# Terraform Cloud
+			credentials "app.terraform.io" {
+				token = "tfc-token"
+			}
+
+			credentials "tfe.example.com:8443" {
+				token = "tfe-token"
+			}
+		`))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"app.terraform.io":     "tfc-token",
+			"tfe.example.com:8443": "tfe-token",
+		}, creds)
+	})
+
+	t.Run("without a token", func(t *testing.T) {
+		_, err := parseCredentials([]byte(`credentials "app.terraform.io" {}`))
+		assert.EqualError(t, err, "missing token for host app.terraform.io")
+	})
+
+	t.Run("with invalid syntax", func(t *testing.T) {
+		_, err := parseCredentials([]byte(`credentials "app.terraform.io" {`))
+		assert.Error(t, err)
+	})
+}
+
+func TestCredentialsFromEnv(t *testing.T) {
+	creds := credentialsFromEnv([]string{
+		"HOME=/root",
+		"TF_TOKEN_app_terraform_io=tfc-token",
+		"TF_TOKEN_tfe_my__company_com=tfe-token",
+		"TF_TOKEN_empty_example_com=",
+		"TF_TOKEN_=invalid",
+	})
+	assert.Equal(t, map[string]string{
+		"app.terraform.io":   "tfc-token",
+		"tfe.my-company.com": "tfe-token",
+	}, creds)
+}
+
+func TestClientRegistry(t *testing.T) {
+	var mu sync.Mutex
+	var tokens []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		tokens = append(tokens, strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+		mu.Unlock()
+
+		if r.URL.Path == DefaultBasePath+PingEndpoint {
+			w.WriteHeader(204)
+			return
+		}
+		testWorkspaceHandler(w, r)
+	}))
+	defer ts.Close()
+	host := strings.TrimPrefix(ts.URL, "http://")
+
+	dir, err := ioutil.TempDir("", "go-tfe")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	jsonFile := filepath.Join(dir, "credentials.tfrc.json")
+	hclFile := filepath.Join(dir, "terraformrc")
+	require.NoError(t, ioutil.WriteFile(jsonFile, []byte(`{
+		"credentials": {"`+host+`": {"token": "file-token"}}
+	}`), 0600))
+	require.NoError(t, ioutil.WriteFile(hclFile, []byte(`
+		credentials "other.example.com" {
+			token = "other-token"
+		}
+	`), 0600))
+
+	newRegistry := func(t *testing.T) *ClientRegistry {
+		r, err := NewClientRegistry(&ClientRegistryConfig{
+			Config:            &Config{LazyPing: true},
+			CredentialsFiles:  []string{hclFile, jsonFile, filepath.Join(dir, "nonexisting")},
+			IgnoreEnvironment: true,
+		})
+		require.NoError(t, err)
+		return r
+	}
+	ctx := context.Background()
+
+	t.Run("resolves the token of the host", func(t *testing.T) {
+		r := newRegistry(t)
+
+		client, err := r.Client(ctx, ts.URL)
+		require.NoError(t, err)
+		assert.Equal(t, "file-token", testClientToken(t, client))
+		assert.Equal(t, ts.URL+DefaultBasePath, client.baseURL.String())
+
+		_, err = client.Workspaces.ReadByID(ctx, "ws-123")
+		require.NoError(t, err)
+
+		mu.Lock()
+		assert.Equal(t, "file-token", tokens[len(tokens)-1])
+		mu.Unlock()
+
+		other, err := r.Client(ctx, "other.example.com")
+		require.NoError(t, err)
+		assert.Equal(t, "other-token", testClientToken(t, other))
+		assert.Equal(t, "https://other.example.com"+DefaultBasePath, other.baseURL.String())
+	})
+
+	t.Run("caches clients per host and token", func(t *testing.T) {
+		r := newRegistry(t)
+
+		c1, err := r.Client(ctx, ts.URL)
+		require.NoError(t, err)
+		c2, err := r.OrganizationClient(ctx, ts.URL, "my-org")
+		require.NoError(t, err)
+		assert.True(t, c1 == c2)
+	})
+
+	t.Run("prefers organization tokens", func(t *testing.T) {
+		r := newRegistry(t)
+		r.SetOrganizationToken(host, "my-org", "org-token")
+
+		hostClient, err := r.Client(ctx, ts.URL)
+		require.NoError(t, err)
+		orgClient, err := r.OrganizationClient(ctx, ts.URL, "my-org")
+		require.NoError(t, err)
+		otherClient, err := r.OrganizationClient(ctx, ts.URL, "other-org")
+		require.NoError(t, err)
+
+		assert.Equal(t, "file-token", testClientToken(t, hostClient))
+		assert.Equal(t, "org-token", testClientToken(t, orgClient))
+		assert.True(t, hostClient == otherClient)
+
+		t.Run("sharing the transport and limiter of the host", func(t *testing.T) {
+			assert.True(t, hostClient.http.HTTPClient == orgClient.http.HTTPClient)
+			assert.True(t, hostClient.limiter == orgClient.limiter)
+
+			other, err := r.Client(ctx, "other.example.com")
+			require.NoError(t, err)
+			assert.False(t, other.http.HTTPClient == hostClient.http.HTTPClient)
+			assert.False(t, other.limiter == hostClient.limiter)
+		})
+	})
+
+	t.Run("with a token set explicitly", func(t *testing.T) {
+		r := newRegistry(t)
+		r.SetToken(strings.ToUpper(host), "explicit-token")
+
+		client, err := r.Client(ctx, ts.URL)
+		require.NoError(t, err)
+		assert.Equal(t, "explicit-token", testClientToken(t, client))
+	})
+
+	t.Run("with tokens from the environment", func(t *testing.T) {
+		defer os.Unsetenv("TF_TOKEN_other_example_com")
+		os.Setenv("TF_TOKEN_other_example_com", "env-token")
+
+		r, err := NewClientRegistry(&ClientRegistryConfig{
+			Config:           &Config{LazyPing: true},
+			CredentialsFiles: []string{hclFile},
+		})
+		require.NoError(t, err)
+
+		client, err := r.Client(ctx, "other.example.com")
+		require.NoError(t, err)
+		assert.Equal(t, "env-token", testClientToken(t, client))
+	})
+
+	t.Run("without credentials", func(t *testing.T) {
+		r := newRegistry(t)
+
+		_, err := r.Client(ctx, "unknown.example.com")
+		assert.True(t, errors.Is(err, ErrMissingCredentials))
+		assert.EqualError(t, err, "missing credentials for host unknown.example.com")
+
+		_, err = r.OrganizationClient(ctx, "unknown.example.com", "my-org")
+		assert.EqualError(t, err, "missing credentials for organization my-org on host unknown.example.com")
+	})
+
+	t.Run("with an invalid credentials file", func(t *testing.T) {
+		invalid := filepath.Join(dir, "invalid.tfrc")
+		require.NoError(t, ioutil.WriteFile(invalid, []byte(`credentials "x" {`), 0600))
+
+		_, err := NewClientRegistry(&ClientRegistryConfig{CredentialsFiles: []string{invalid}})
+		assert.Error(t, err)
+	})
+
+	t.Run("without a host", func(t *testing.T) {
+		_, err := newRegistry(t).Client(ctx, "")
+		assert.EqualError(t, err, "host is required")
+	})
+}
+
+func testClientToken(t *testing.T, client *Client) string {
+	token, err := client.tokenSource.Token(context.Background())
+	require.NoError(t, err)
+	return token
+}
@@ -31,6 +31,10 @@ type ConfigurationVersions interface {
 	// Read a configuration version by its ID.
 	Read(ctx context.Context, cvID string) (*ConfigurationVersion, error)
 
+	// ReadWithOptions reads a configuration version by its ID with the given
+	// options.
+	ReadWithOptions(ctx context.Context, cvID string, options ConfigurationVersionReadOptions) (*ConfigurationVersion, error)
+
 	// Upload packages and uploads Terraform configuration files. It requires
 	// the upload URL from a configuration version and the full path to the
 	// configuration files on disk.
@@ -64,6 +68,15 @@ const (
 	ConfigurationSourceTerraform ConfigurationSource = "terraform"
 )
 
+// ConfigurationVersionIncludeOpt represents a related resource that can be
+// included when reading or listing configuration versions.
+type ConfigurationVersionIncludeOpt string
+
+// List all available configuration version includes.
+const (
+	ConfigurationVersionIncludeIngressAttributes ConfigurationVersionIncludeOpt = "ingress_attributes"
+)
+
 // ConfigurationVersionList represents a list of configuration versions.
 type ConfigurationVersionList struct {
 	*Pagination
@@ -83,6 +96,29 @@ type ConfigurationVersion struct {
 	Status           ConfigurationStatus `jsonapi:"attr,status"`
 	StatusTimestamps *CVStatusTimestamps `jsonapi:"attr,status-timestamps"`
 	UploadURL        string              `jsonapi:"attr,upload-url"`
+
+	// Relations
+	IngressAttributes *IngressAttributes `jsonapi:"relation,ingress-attributes"`
+}
+
+// IngressAttributes holds the details of the VCS commit a configuration
+// version was ingressed from.
+type IngressAttributes struct {
+	ID                string `jsonapi:"primary,ingress-attributes"`
+	Branch            string `jsonapi:"attr,branch"`
+	CloneURL          string `jsonapi:"attr,clone-url"`
+	CommitMessage     string `jsonapi:"attr,commit-message"`
+	CommitSHA         string `jsonapi:"attr,commit-sha"`
+	CommitURL         string `jsonapi:"attr,commit-url"`
+	CompareURL        string `jsonapi:"attr,compare-url"`
+	Identifier        string `jsonapi:"attr,identifier"`
+	IsPullRequest     bool   `jsonapi:"attr,is-pull-request"`
+	OnDefaultBranch   bool   `jsonapi:"attr,on-default-branch"`
+	PullRequestNumber int    `jsonapi:"attr,pull-request-number"`
+	PullRequestTitle  string `jsonapi:"attr,pull-request-title"`
+	PullRequestURL    string `jsonapi:"attr,pull-request-url"`
+	SenderUsername    string `jsonapi:"attr,sender-username"`
+	Tag               string `jsonapi:"attr,tag"`
 }
 
 // CVStatusTimestamps holds the timestamps for individual configuration version
@@ -97,6 +133,12 @@ type CVStatusTimestamps struct {
 // configuration versions.
 type ConfigurationVersionListOptions struct {
 	ListOptions
+
+	// The attributes to return per resource type.
+	Fields Fields `url:"fields,omitempty"`
+
+	// The related resources to include in the response.
+	Include []ConfigurationVersionIncludeOpt `url:"include,omitempty,comma"`
 }
 
 // List returns all configuration versions of a workspace.
@@ -106,7 +148,7 @@ func (s *configurationVersions) List(ctx context.Context, workspaceID string, op
 	}
 
 	u := fmt.Sprintf("workspaces/%s/configuration-versions", url.QueryEscape(workspaceID))
-	req, err := s.client.newRequest("GET", u, &options)
+	req, err := s.client.newRequest("ConfigurationVersions.List", "GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -134,6 +176,12 @@ type ConfigurationVersionCreateOptions struct {
 	Speculative *bool `jsonapi:"attr,speculative,omitempty"`
 }
 
+// Validate accepts all configuration version create options, as all of
+// them are optional.
+func (o ConfigurationVersionCreateOptions) Validate() error {
+	return nil
+}
+
 // Create is used to create a new configuration version. The created
 // configuration version will be usable once data is uploaded to it.
 func (s *configurationVersions) Create(ctx context.Context, workspaceID string, options ConfigurationVersionCreateOptions) (*ConfigurationVersion, error) {
@@ -145,7 +193,7 @@ func (s *configurationVersions) Create(ctx context.Context, workspaceID string,
 	options.ID = ""
 
 	u := fmt.Sprintf("workspaces/%s/configuration-versions", url.QueryEscape(workspaceID))
-	req, err := s.client.newRequest("POST", u, &options)
+	req, err := s.client.newRequest("ConfigurationVersions.Create", "POST", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -161,12 +209,31 @@ func (s *configurationVersions) Create(ctx context.Context, workspaceID string,
 
 // Read a configuration version by its ID.
 func (s *configurationVersions) Read(ctx context.Context, cvID string) (*ConfigurationVersion, error) {
+	return s.readWithOptions(ctx, "ConfigurationVersions.Read", cvID, ConfigurationVersionReadOptions{})
+}
+
+// ConfigurationVersionReadOptions represents the options for reading a
+// configuration version.
+type ConfigurationVersionReadOptions struct {
+	// The related resources to include in the response.
+	Include []ConfigurationVersionIncludeOpt `url:"include,omitempty,comma"`
+}
+
+// ReadWithOptions reads a configuration version by its ID with the given
+// options.
+func (s *configurationVersions) ReadWithOptions(ctx context.Context, cvID string, options ConfigurationVersionReadOptions) (*ConfigurationVersion, error) {
+	return s.readWithOptions(ctx, "ConfigurationVersions.ReadWithOptions", cvID, options)
+}
+
+// readWithOptions implements ReadWithOptions, naming the request after the
+// given operation.
+func (s *configurationVersions) readWithOptions(ctx context.Context, operation, cvID string, options ConfigurationVersionReadOptions) (*ConfigurationVersion, error) {
 	if !validStringID(&cvID) {
 		return nil, errors.New("invalid value for configuration version ID")
 	}
 
 	u := fmt.Sprintf("configuration-versions/%s", url.QueryEscape(cvID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest(operation, "GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -199,7 +266,7 @@ func (s *configurationVersions) Upload(ctx context.Context, url, path string) er
 		return err
 	}
 
-	req, err := s.client.newRequest("PUT", url, body)
+	req, err := s.client.newRequest("ConfigurationVersions.Upload", "PUT", url, body)
 	if err != nil {
 		return err
 	}
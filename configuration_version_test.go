@@ -2,6 +2,9 @@ package tfe
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"net/url"
 	"testing"
 	"time"
 
@@ -132,7 +135,7 @@ func TestConfigurationVersionsRead(t *testing.T) {
 	t.Run("when the configuration version does not exist", func(t *testing.T) {
 		cv, err := client.ConfigurationVersions.Read(ctx, "nonexisting")
 		assert.Nil(t, cv)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("with invalid configuration version id", func(t *testing.T) {
@@ -142,6 +145,47 @@ func TestConfigurationVersionsRead(t *testing.T) {
 	})
 }
 
+func TestConfigurationVersionsReadWithOptions(t *testing.T) {
+	ctx := context.Background()
+
+	var query url.Values
+	client, done := testMiddlewareClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.Write([]byte(`{
+			"data": {
+				"id": "cv-123",
+				"type": "configuration-versions",
+				"attributes": {"source": "github", "status": "uploaded"},
+				"relationships": {
+					"ingress-attributes": {"data": {"id": "ia-123", "type": "ingress-attributes"}}
+				}
+			},
+			"included": [
+				{"id": "ia-123", "type": "ingress-attributes", "attributes": {"branch": "main", "commit-sha": "abc123", "is-pull-request": false}}
+			]
+		}`))
+	})
+	defer done()
+
+	t.Run("with includes", func(t *testing.T) {
+		cv, err := client.ConfigurationVersions.ReadWithOptions(ctx, "cv-123", ConfigurationVersionReadOptions{
+			Include: []ConfigurationVersionIncludeOpt{ConfigurationVersionIncludeIngressAttributes},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "ingress_attributes", query.Get("include"))
+		require.NotNil(t, cv.IngressAttributes)
+		assert.Equal(t, "main", cv.IngressAttributes.Branch)
+		assert.Equal(t, "abc123", cv.IngressAttributes.CommitSHA)
+	})
+
+	t.Run("with invalid configuration version ID", func(t *testing.T) {
+		cv, err := client.ConfigurationVersions.ReadWithOptions(ctx, badIdentifier, ConfigurationVersionReadOptions{})
+		assert.Nil(t, cv)
+		assert.EqualError(t, err, "invalid value for configuration version ID")
+	})
+}
+
 func TestConfigurationVersionsUpload(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -171,7 +215,7 @@ func TestConfigurationVersionsUpload(t *testing.T) {
 				t.Fatal("Timeout waiting for the configuration version to be uploaded")
 			}
 
-			time.Sleep(1 * time.Second)
+			testSleep(t, 1*time.Second)
 		}
 	})
 
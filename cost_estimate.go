@@ -73,8 +73,12 @@ func (s *costEstimates) Read(ctx context.Context, costEstimateID string) (*CostE
 		return nil, errors.New("invalid value for cost estimate ID")
 	}
 
+	if err := s.client.requireFeature(ctx, FeatureCostEstimation); err != nil {
+		return nil, err
+	}
+
 	u := fmt.Sprintf("cost-estimates/%s", url.QueryEscape(costEstimateID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("CostEstimates.Read", "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +119,7 @@ func (s *costEstimates) Logs(ctx context.Context, costEstimateID string) (io.Rea
 		}
 
 		u := fmt.Sprintf("cost-estimates/%s/output", url.QueryEscape(costEstimateID))
-		req, err := s.client.newRequest("GET", u, nil)
+		req, err := s.client.newRequest("CostEstimates.Logs", "GET", u, nil)
 		if err != nil {
 			return nil, err
 		}
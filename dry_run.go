@@ -0,0 +1,161 @@
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// DryRun records the mutating API calls of a client instead of sending them,
+// so they can be reviewed before they are made. Set it as the DryRun field
+// of the client config to enable it:
+//
+//	dryRun := tfe.NewDryRun()
+//	client, err := tfe.NewClient(&tfe.Config{
+//		Token:  token,
+//		DryRun: dryRun,
+//	})
+//
+// Requests using the GET method are still sent, so the client can read the
+// current state. All other requests are recorded and answered without
+// contacting the server: requests with a JSON:API document as their body
+// receive that same document as the response, so the returned resources
+// reflect the intended change, and all other requests receive an empty
+// response. A DryRun is safe for concurrent use and can be shared by
+// multiple clients.
+type DryRun struct {
+	mu       sync.Mutex
+	requests []*PlannedRequest
+}
+
+// PlannedRequest describes a request that was recorded by a DryRun.
+type PlannedRequest struct {
+	// The API call the request was made for, like "Workspaces.Update".
+	Operation string `json:"operation,omitempty"`
+
+	// The type of the resource addressed by the request and the resource
+	// names and IDs found in the request path.
+	ResourceType string            `json:"resource_type,omitempty"`
+	IDs          map[string]string `json:"ids,omitempty"`
+
+	Method string `json:"method"`
+	URL    string `json:"url"`
+
+	// The JSON body of the request. Other bodies, like configuration version
+	// uploads, are only described by their size.
+	Body     json.RawMessage `json:"body,omitempty"`
+	BodySize int             `json:"body_size,omitempty"`
+}
+
+// NewDryRun creates a new, empty DryRun.
+func NewDryRun() *DryRun {
+	return &DryRun{}
+}
+
+// Requests returns the recorded requests in the order they were made.
+func (d *DryRun) Requests() []*PlannedRequest {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	requests := make([]*PlannedRequest, len(d.requests))
+	copy(requests, d.requests)
+
+	return requests
+}
+
+// Reset removes all recorded requests.
+func (d *DryRun) Reset() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.requests = nil
+}
+
+// Export writes the recorded requests to w as an indented JSON document of
+// the form {"requests": [...]}.
+func (d *DryRun) Export(w io.Writer) error {
+	plan := struct {
+		Requests []*PlannedRequest `json:"requests"`
+	}{
+		Requests: d.Requests(),
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(plan)
+}
+
+// middleware returns the innermost middleware of a client, which records
+// mutating requests instead of sending them.
+func (d *DryRun) middleware(next RequestHandler) RequestHandler {
+	return func(ctx context.Context, op Operation, req *http.Request) (*http.Response, error) {
+		if req.Method == "GET" {
+			return next(ctx, op, req)
+		}
+
+		var body []byte
+		if req.Body != nil {
+			var err error
+			if body, err = ioutil.ReadAll(req.Body); err != nil {
+				return nil, err
+			}
+		}
+
+		planned := &PlannedRequest{
+			Operation:    op.Name,
+			ResourceType: op.ResourceType,
+			IDs:          op.IDs,
+			Method:       req.Method,
+			URL:          req.URL.String(),
+		}
+		if len(body) > 0 {
+			if strings.Contains(req.Header.Get("Content-Type"), "json") && json.Valid(body) {
+				planned.Body = json.RawMessage(bytes.TrimSpace(body))
+			} else {
+				planned.BodySize = len(body)
+			}
+		}
+
+		d.mu.Lock()
+		d.requests = append(d.requests, planned)
+		d.mu.Unlock()
+
+		return dryRunResponse(req, planned.Body), nil
+	}
+}
+
+// dryRunResponse returns the response for a recorded request. JSON:API
+// documents are echoed, so they decode into the resource the request was
+// made for.
+func dryRunResponse(req *http.Request, body json.RawMessage) *http.Response {
+	resp := &http.Response{
+		Status:     "204 No Content",
+		StatusCode: http.StatusNoContent,
+		Header:     make(http.Header),
+		Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		Request:    req,
+	}
+
+	var doc struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if body == nil || json.Unmarshal(body, &doc) != nil || doc.Data == nil || string(doc.Data) == "null" {
+		return resp
+	}
+
+	resp.StatusCode = http.StatusOK
+	if req.Method == "POST" {
+		resp.StatusCode = http.StatusCreated
+	}
+	resp.Status = fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+	resp.Header.Set("Content-Type", "application/vnd.api+json")
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	return resp
+}
@@ -0,0 +1,134 @@
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRun(t *testing.T) {
+	var sent []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == DefaultBasePath+PingEndpoint {
+			w.WriteHeader(204)
+			return
+		}
+		sent = append(sent, r.Method+" "+r.URL.Path)
+		testWorkspaceHandler(w, r)
+	}))
+	defer ts.Close()
+
+	var observed []string
+	observe := func(next RequestHandler) RequestHandler {
+		return func(ctx context.Context, op Operation, req *http.Request) (*http.Response, error) {
+			observed = append(observed, op.Name)
+			return next(ctx, op, req)
+		}
+	}
+
+	dryRun := NewDryRun()
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Middleware: []Middleware{observe},
+		DryRun:     dryRun,
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	w, err := client.Workspaces.ReadByID(ctx, "ws-123")
+	require.NoError(t, err)
+	assert.Equal(t, "my-workspace", w.Name)
+
+	t.Run("records updates and echoes the body", func(t *testing.T) {
+		w, err := client.Workspaces.UpdateByID(ctx, "ws-123", WorkspaceUpdateOptions{
+			Name:      String("renamed"),
+			AutoApply: Bool(true),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "renamed", w.Name)
+		assert.True(t, w.AutoApply)
+	})
+
+	t.Run("records creates", func(t *testing.T) {
+		w, err := client.Workspaces.Create(ctx, "my-org", WorkspaceCreateOptions{
+			Name: String("new-workspace"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "", w.ID)
+		assert.Equal(t, "new-workspace", w.Name)
+	})
+
+	t.Run("records actions without a JSON:API body", func(t *testing.T) {
+		w, err := client.Workspaces.Lock(ctx, "ws-123", WorkspaceLockOptions{Reason: String("maintenance")})
+		require.NoError(t, err)
+		assert.NotNil(t, w)
+	})
+
+	t.Run("records deletes", func(t *testing.T) {
+		err := client.Workspaces.DeleteByID(ctx, "ws-123")
+		require.NoError(t, err)
+	})
+
+	assert.Equal(t, []string{"GET /api/v2/workspaces/ws-123"}, sent)
+	assert.Equal(t, []string{
+		"Workspaces.ReadByID",
+		"Workspaces.UpdateByID",
+		"Workspaces.Create",
+		"Workspaces.Lock",
+		"Workspaces.DeleteByID",
+	}, observed)
+
+	requests := dryRun.Requests()
+	require.Len(t, requests, 4)
+
+	assert.Equal(t, "Workspaces.UpdateByID", requests[0].Operation)
+	assert.Equal(t, "PATCH", requests[0].Method)
+	assert.Equal(t, ts.URL+"/api/v2/workspaces/ws-123", requests[0].URL)
+	assert.Equal(t, map[string]string{"workspaces": "ws-123"}, requests[0].IDs)
+	assert.JSONEq(t,
+		`{"data":{"type":"workspaces","attributes":{"auto-apply":true,"name":"renamed"}}}`,
+		string(requests[0].Body),
+	)
+
+	assert.Equal(t, "POST", requests[1].Method)
+	assert.Equal(t, ts.URL+"/api/v2/organizations/my-org/workspaces", requests[1].URL)
+
+	assert.Equal(t, "Workspaces.Lock", requests[2].Operation)
+	assert.Equal(t, ts.URL+"/api/v2/workspaces/ws-123/actions/lock", requests[2].URL)
+
+	assert.Equal(t, "DELETE", requests[3].Method)
+	assert.Nil(t, requests[3].Body)
+
+	t.Run("exports the plan as JSON", func(t *testing.T) {
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, dryRun.Export(buf))
+
+		var plan struct {
+			Requests []*PlannedRequest `json:"requests"`
+		}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &plan))
+		require.Len(t, plan.Requests, len(requests))
+
+		for i, r := range plan.Requests {
+			assert.Equal(t, requests[i].Operation, r.Operation)
+			assert.Equal(t, requests[i].Method, r.Method)
+			assert.Equal(t, requests[i].URL, r.URL)
+			if requests[i].Body != nil {
+				assert.JSONEq(t, string(requests[i].Body), string(r.Body))
+			}
+		}
+	})
+
+	t.Run("resets the plan", func(t *testing.T) {
+		dryRun.Reset()
+		assert.Empty(t, dryRun.Requests())
+	})
+}
@@ -0,0 +1,152 @@
+package tfe
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const headerRequestID = "X-Request-Id"
+
+// APIError is returned when the API responds with a non-2xx status code. It
+// can be compared against the sentinel errors using errors.Is, for example:
+//
+//	if errors.Is(err, tfe.ErrResourceNotFound) {
+//		...
+//	}
+type APIError struct {
+	// The HTTP status code of the response.
+	StatusCode int
+
+	// The method and URL of the request.
+	Method string
+	URL    string
+
+	// The value of the X-Request-Id response header.
+	RequestID string
+
+	// The JSON:API error objects returned in the response body.
+	Errors []*ErrorObject
+
+	// The path of the request URL, used to detect lock conflicts.
+	path string
+}
+
+// ErrorObject represents a single JSON:API error object.
+type ErrorObject struct {
+	ID     string                 `json:"id,omitempty"`
+	Status string                 `json:"status,omitempty"`
+	Code   string                 `json:"code,omitempty"`
+	Title  string                 `json:"title,omitempty"`
+	Detail string                 `json:"detail,omitempty"`
+	Source *ErrorSource           `json:"source,omitempty"`
+	Meta   map[string]interface{} `json:"meta,omitempty"`
+}
+
+// ErrorSource identifies the part of the request that caused an error.
+type ErrorSource struct {
+	// A JSON pointer to the attribute in the request document.
+	Pointer string `json:"pointer,omitempty"`
+
+	// The query parameter that caused the error.
+	Parameter string `json:"parameter,omitempty"`
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	if sentinel := e.sentinel(); sentinel != nil {
+		return sentinel.Error()
+	}
+
+	if len(e.Errors) == 0 {
+		if text := http.StatusText(e.StatusCode); text != "" {
+			return fmt.Sprintf("%d %s", e.StatusCode, text)
+		}
+		return fmt.Sprintf("%d", e.StatusCode)
+	}
+
+	var errs []string
+	for _, obj := range e.Errors {
+		if obj.Detail == "" {
+			errs = append(errs, obj.Title)
+		} else {
+			errs = append(errs, fmt.Sprintf("%s\n\n%s", obj.Title, obj.Detail))
+		}
+	}
+
+	return strings.Join(errs, "\n")
+}
+
+// Is reports whether the error matches target. Besides matching the specific
+// sentinel for the request, like ErrWorkspaceLocked, it matches the generic
+// sentinel for the status code, like ErrConflict.
+func (e *APIError) Is(target error) bool {
+	if target == e.sentinel() {
+		return true
+	}
+
+	switch e.StatusCode {
+	case http.StatusUnauthorized:
+		return target == ErrUnauthorized
+	case http.StatusForbidden:
+		return target == ErrForbidden
+	case http.StatusNotFound:
+		return target == ErrResourceNotFound
+	case http.StatusConflict:
+		return target == ErrConflict
+	case http.StatusUnprocessableEntity:
+		return target == ErrUnprocessableEntity
+	case http.StatusTooManyRequests:
+		return target == ErrRateLimited
+	}
+
+	return false
+}
+
+// sentinel returns the sentinel error whose message is used for the error,
+// or nil if the message is built from the error objects.
+func (e *APIError) sentinel() error {
+	switch e.StatusCode {
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusNotFound:
+		return ErrResourceNotFound
+	case http.StatusConflict:
+		switch {
+		case strings.HasSuffix(e.path, "actions/lock"):
+			return ErrWorkspaceLocked
+		case strings.HasSuffix(e.path, "actions/unlock"):
+			return ErrWorkspaceNotLocked
+		case strings.HasSuffix(e.path, "actions/force-unlock"):
+			return ErrWorkspaceNotLocked
+		}
+	}
+	return nil
+}
+
+// newAPIError creates an APIError from the response, decoding any JSON:API
+// error objects from the response body.
+func newAPIError(r *http.Response) *APIError {
+	e := &APIError{
+		StatusCode: r.StatusCode,
+		RequestID:  r.Header.Get(headerRequestID),
+	}
+
+	if r.Request != nil {
+		e.Method = r.Request.Method
+		if r.Request.URL != nil {
+			e.URL = r.Request.URL.String()
+			e.path = r.Request.URL.Path
+		}
+	}
+
+	var payload struct {
+		Errors []*ErrorObject `json:"errors"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&payload); err == nil {
+		e.Errors = payload.Errors
+	}
+
+	return e
+}
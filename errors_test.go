@@ -0,0 +1,101 @@
+package tfe
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testErrorResponse(method, path string, status int, body string) *http.Response {
+	u, _ := url.Parse("https://app.terraform.io/api/v2/" + path)
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"X-Request-Id": []string{"req-123"}},
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+		Request:    &http.Request{Method: method, URL: u},
+	}
+}
+
+func TestAPIError_checkResponseCode(t *testing.T) {
+	t.Run("with a successful response", func(t *testing.T) {
+		err := checkResponseCode(testErrorResponse("GET", "ping", 204, ""))
+		assert.NoError(t, err)
+	})
+
+	t.Run("with error objects", func(t *testing.T) {
+		body := `{"errors":[{
+			"status":"422",
+			"code":"invalid-attribute",
+			"title":"invalid attribute",
+			"detail":"Name has already been taken",
+			"source":{"pointer":"/data/attributes/name"},
+			"meta":{"hint":"pick another name"}
+		}]}`
+		err := checkResponseCode(testErrorResponse("POST", "organizations/foo/workspaces", 422, body))
+		require.Error(t, err)
+		assert.Equal(t, "invalid attribute\n\nName has already been taken", err.Error())
+
+		apiErr, ok := err.(*APIError)
+		require.True(t, ok)
+		assert.Equal(t, 422, apiErr.StatusCode)
+		assert.Equal(t, "POST", apiErr.Method)
+		assert.Equal(t, "https://app.terraform.io/api/v2/organizations/foo/workspaces", apiErr.URL)
+		assert.Equal(t, "req-123", apiErr.RequestID)
+		require.Len(t, apiErr.Errors, 1)
+		assert.Equal(t, "invalid-attribute", apiErr.Errors[0].Code)
+		assert.Equal(t, "/data/attributes/name", apiErr.Errors[0].Source.Pointer)
+		assert.Equal(t, "pick another name", apiErr.Errors[0].Meta["hint"])
+
+		assert.True(t, errors.Is(err, ErrUnprocessableEntity))
+		assert.False(t, errors.Is(err, ErrConflict))
+	})
+
+	t.Run("without error objects", func(t *testing.T) {
+		err := checkResponseCode(testErrorResponse("GET", "workspaces/ws-123", 500, "oops"))
+		require.Error(t, err)
+		assert.Equal(t, "500 Internal Server Error", err.Error())
+	})
+}
+
+func TestAPIError_Is(t *testing.T) {
+	cases := []struct {
+		path     string
+		status   int
+		message  string
+		sentinel []error
+	}{
+		{"workspaces/ws-123", 401, "unauthorized", []error{ErrUnauthorized}},
+		{"workspaces/ws-123", 403, "403 Forbidden", []error{ErrForbidden}},
+		{"workspaces/ws-123", 404, "resource not found", []error{ErrResourceNotFound}},
+		{"workspaces/ws-123", 409, "409 Conflict", []error{ErrConflict}},
+		{"workspaces/ws-123/actions/lock", 409, "workspace already locked", []error{ErrWorkspaceLocked, ErrConflict}},
+		{"workspaces/ws-123/actions/unlock", 409, "workspace already unlocked", []error{ErrWorkspaceNotLocked, ErrConflict}},
+		{"workspaces/ws-123/actions/force-unlock", 409, "workspace already unlocked", []error{ErrWorkspaceNotLocked, ErrConflict}},
+		{"workspaces/ws-123", 422, "422 Unprocessable Entity", []error{ErrUnprocessableEntity}},
+		{"workspaces/ws-123", 429, "429 Too Many Requests", []error{ErrRateLimited}},
+	}
+
+	all := []error{
+		ErrUnauthorized, ErrForbidden, ErrResourceNotFound, ErrConflict,
+		ErrWorkspaceLocked, ErrWorkspaceNotLocked, ErrUnprocessableEntity, ErrRateLimited,
+	}
+
+	for _, tc := range cases {
+		err := checkResponseCode(testErrorResponse("POST", tc.path, tc.status, ""))
+		assert.Equal(t, tc.message, err.Error(), tc.path)
+
+		for _, target := range all {
+			want := false
+			for _, s := range tc.sentinel {
+				want = want || s == target
+			}
+			assert.Equal(t, want, errors.Is(err, target), "%d %s is %q", tc.status, tc.path, target)
+		}
+	}
+}
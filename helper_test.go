@@ -4,12 +4,18 @@ import (
 	"context"
 	"crypto/md5"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-tfe/cassette"
 	"github.com/hashicorp/go-uuid"
 )
 
@@ -18,8 +24,23 @@ const badIdentifier = "! / nope"
 // Memoize test account details
 var _testAccountDetails *TestAccountDetails
 
+// Recorders of the running tests, keyed by the name of the top-level test.
+var (
+	testRecordersMu sync.Mutex
+	testRecorders   = make(map[string]*cassette.Recorder)
+)
+
 func testClient(t *testing.T) *Client {
-	client, err := NewClient(nil)
+	config := DefaultConfig()
+
+	if rec := testRecorder(t); rec != nil {
+		config.HTTPClient = rec.Client()
+		if config.Token == "" {
+			config.Token = "cassette-token"
+		}
+	}
+
+	client, err := NewClient(config)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -27,7 +48,60 @@ func testClient(t *testing.T) *Client {
 	return client
 }
 
+// testRecorder returns the cassette recorder of the top-level test when
+// TFE_CASSETTE_MODE is set to "record" or "replay", and nil otherwise. The
+// test is skipped when its cassette should be replayed but does not exist.
+func testRecorder(t *testing.T) *cassette.Recorder {
+	var mode cassette.Mode
+	switch os.Getenv("TFE_CASSETTE_MODE") {
+	case "":
+		return nil
+	case "record":
+		mode = cassette.ModeRecord
+	case "replay":
+		mode = cassette.ModeReplay
+	default:
+		t.Fatalf("invalid TFE_CASSETTE_MODE %q", os.Getenv("TFE_CASSETTE_MODE"))
+	}
+
+	name := strings.SplitN(t.Name(), "/", 2)[0]
+
+	testRecordersMu.Lock()
+	defer testRecordersMu.Unlock()
+
+	if rec, ok := testRecorders[name]; ok {
+		return rec
+	}
+
+	path := filepath.Join("test-fixtures", "cassettes", name+".json")
+	rec, err := cassette.New(path, mode, cleanhttp.DefaultPooledTransport())
+	if errors.Is(err, cassette.ErrNotFound) {
+		t.Skipf("Skipping test without a recorded cassette: %v", err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	testRecorders[name] = rec
+
+	return rec
+}
+
+// testSleep waits between polls for a resource to change. It returns
+// immediately when a cassette is replayed.
+func testSleep(t *testing.T, d time.Duration) {
+	if rec := testRecorder(t); rec != nil && rec.Mode() == cassette.ModeReplay {
+		return
+	}
+	time.Sleep(d)
+}
+
 func fetchTestAccountDetails(t *testing.T, client *Client) *TestAccountDetails {
+	// The account details are requested by every test using a cassette, so
+	// the request is part of every recording.
+	if testRecorder(t) != nil {
+		return FetchTestAccountDetails(t, client)
+	}
+
 	if _testAccountDetails == nil {
 		_testAccountDetails = FetchTestAccountDetails(t, client)
 	}
@@ -84,7 +158,7 @@ func createUploadedConfigurationVersion(t *testing.T, client *Client, w *Workspa
 			t.Fatal("Timeout waiting for the configuration version to be uploaded")
 		}
 
-		time.Sleep(1 * time.Second)
+		testSleep(t, 1*time.Second)
 	}
 
 	return cv, cvCleanup
@@ -465,7 +539,7 @@ func createPlannedRun(t *testing.T, client *Client, w *Workspace) (*Run, func())
 			t.Fatal("Timeout waiting for run to be planned")
 		}
 
-		time.Sleep(1 * time.Second)
+		testSleep(t, 1*time.Second)
 	}
 }
 
@@ -490,7 +564,7 @@ func createCostEstimatedRun(t *testing.T, client *Client, w *Workspace) (*Run, f
 			t.Fatal("Timeout waiting for run to be cost estimated")
 		}
 
-		time.Sleep(2 * time.Second)
+		testSleep(t, 2*time.Second)
 	}
 }
 
@@ -518,7 +592,7 @@ func createAppliedRun(t *testing.T, client *Client, w *Workspace) (*Run, func())
 			t.Fatal("Timeout waiting for run to be applied")
 		}
 
-		time.Sleep(1 * time.Second)
+		testSleep(t, 1*time.Second)
 	}
 }
 
@@ -557,7 +631,7 @@ func createPlanExport(t *testing.T, client *Client, r *Run) (*PlanExport, func()
 			t.Fatal("Timeout waiting for plan export to finish")
 		}
 
-		time.Sleep(1 * time.Second)
+		testSleep(t, 1*time.Second)
 	}
 }
 
@@ -910,7 +984,14 @@ func createWorkspaceWithVCS(t *testing.T, client *Client, org *Organization) (*W
 }
 
 func randomString(t *testing.T) string {
-	v, err := uuid.GenerateUUID()
+	generate := uuid.GenerateUUID
+	if rec := testRecorder(t); rec != nil {
+		generate = func() (string, error) {
+			return rec.Value(uuid.GenerateUUID)
+		}
+	}
+
+	v, err := generate()
 	if err != nil {
 		t.Fatal(err)
 	}
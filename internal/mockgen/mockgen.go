@@ -0,0 +1,465 @@
+// Package mockgen generates the call-recording fakes in the mocks package
+// from the service interfaces exposed by tfe.Client.
+package mockgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	tfeImportPath = "github.com/hashicorp/go-tfe"
+	header        = "// Code generated by mockgen. DO NOT EDIT.\n\n"
+)
+
+// service describes a single service interface exposed by tfe.Client.
+type service struct {
+	field   string // The name of the tfe.Client field.
+	name    string // The name of the interface.
+	file    string // The base name of the file declaring the interface.
+	methods []*method
+	imports map[string]string
+}
+
+// method describes a single method of a service interface.
+type method struct {
+	name    string
+	params  []*param
+	results []string
+	doc     string
+}
+
+// param describes a single method parameter.
+type param struct {
+	name     string
+	typ      string
+	variadic bool
+}
+
+// Generate parses the tfe package in srcDir and returns the generated
+// source of all mock files, keyed by file name.
+func Generate(srcDir string) (map[string][]byte, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, srcDir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, ok := pkgs["tfe"]
+	if !ok {
+		return nil, fmt.Errorf("package tfe not found in %s", srcDir)
+	}
+
+	services, err := clientServices(pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect all interface declarations in the package.
+	for name, f := range pkg.Files {
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				it, ok := ts.Type.(*ast.InterfaceType)
+				if !ok {
+					continue
+				}
+				for _, svc := range services {
+					if svc.name == ts.Name.Name {
+						svc.file = filepath.Base(name)
+						svc.methods, svc.imports, err = interfaceMethods(f, it)
+						if err != nil {
+							return nil, fmt.Errorf("%s: %v", svc.name, err)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	// Group the services by the file declaring them.
+	files := make(map[string][]*service)
+	for _, svc := range services {
+		if svc.file == "" {
+			return nil, fmt.Errorf("interface %s not found", svc.name)
+		}
+		name := strings.TrimSuffix(svc.file, ".go") + "_mocks.go"
+		files[name] = append(files[name], svc)
+	}
+
+	result := make(map[string][]byte)
+	for name, svcs := range files {
+		src, err := generateFile(svcs)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		result[name] = src
+	}
+
+	src, err := generateClient(services)
+	if err != nil {
+		return nil, fmt.Errorf("client_mocks.go: %v", err)
+	}
+	result["client_mocks.go"] = src
+
+	return result, nil
+}
+
+// clientServices returns the services exposed as fields of tfe.Client, in
+// the order in which they are declared.
+func clientServices(pkg *ast.Package) ([]*service, error) {
+	for _, f := range pkg.Files {
+		obj := f.Scope.Lookup("Client")
+		if obj == nil || obj.Kind != ast.Typ {
+			continue
+		}
+		st, ok := obj.Decl.(*ast.TypeSpec).Type.(*ast.StructType)
+		if !ok {
+			return nil, fmt.Errorf("tfe.Client is not a struct")
+		}
+
+		var services []*service
+		for _, field := range st.Fields.List {
+			ident, ok := field.Type.(*ast.Ident)
+			if !ok || !ast.IsExported(ident.Name) {
+				continue
+			}
+			for _, name := range field.Names {
+				if ast.IsExported(name.Name) {
+					services = append(services, &service{field: name.Name, name: ident.Name})
+				}
+			}
+		}
+		return services, nil
+	}
+
+	return nil, fmt.Errorf("tfe.Client not found")
+}
+
+// interfaceMethods returns the methods of the given interface and the
+// imports needed to reference their parameter and result types.
+func interfaceMethods(f *ast.File, it *ast.InterfaceType) ([]*method, map[string]string, error) {
+	imports := make(map[string]string)
+	for _, imp := range f.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		name := filepath.Base(path)
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		imports[name] = path
+	}
+
+	used := map[string]string{"tfe": tfeImportPath}
+
+	var methods []*method
+	for _, field := range it.Methods.List {
+		ft, ok := field.Type.(*ast.FuncType)
+		if !ok || len(field.Names) == 0 {
+			return nil, nil, fmt.Errorf("embedded interfaces are not supported")
+		}
+
+		m := &method{name: field.Names[0].Name}
+		if field.Doc != nil {
+			m.doc = strings.TrimSpace(field.Doc.Text())
+		}
+
+		for i, p := range ft.Params.List {
+			typ, err := typeString(p.Type, imports, used)
+			if err != nil {
+				return nil, nil, err
+			}
+			_, variadic := p.Type.(*ast.Ellipsis)
+
+			if len(p.Names) == 0 {
+				m.params = append(m.params, &param{
+					name:     fmt.Sprintf("arg%d", i),
+					typ:      typ,
+					variadic: variadic,
+				})
+				continue
+			}
+			for _, n := range p.Names {
+				m.params = append(m.params, &param{name: n.Name, typ: typ, variadic: variadic})
+			}
+		}
+
+		if ft.Results != nil {
+			for _, r := range ft.Results.List {
+				typ, err := typeString(r.Type, imports, used)
+				if err != nil {
+					return nil, nil, err
+				}
+				n := len(r.Names)
+				if n == 0 {
+					n = 1
+				}
+				for i := 0; i < n; i++ {
+					m.results = append(m.results, typ)
+				}
+			}
+		}
+
+		methods = append(methods, m)
+	}
+
+	return methods, used, nil
+}
+
+// typeString returns the source representation of a type expression as
+// seen from outside the tfe package.
+func typeString(expr ast.Expr, imports, used map[string]string) (string, error) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			return "tfe." + t.Name, nil
+		}
+		return t.Name, nil
+	case *ast.SelectorExpr:
+		pkg := t.X.(*ast.Ident).Name
+		path, ok := imports[pkg]
+		if !ok {
+			return "", fmt.Errorf("unknown package %s", pkg)
+		}
+		used[pkg] = path
+		return pkg + "." + t.Sel.Name, nil
+	case *ast.StarExpr:
+		s, err := typeString(t.X, imports, used)
+		return "*" + s, err
+	case *ast.ArrayType:
+		s, err := typeString(t.Elt, imports, used)
+		return "[]" + s, err
+	case *ast.Ellipsis:
+		s, err := typeString(t.Elt, imports, used)
+		return "..." + s, err
+	case *ast.MapType:
+		k, err := typeString(t.Key, imports, used)
+		if err != nil {
+			return "", err
+		}
+		v, err := typeString(t.Value, imports, used)
+		return "map[" + k + "]" + v, err
+	case *ast.InterfaceType:
+		if len(t.Methods.List) == 0 {
+			return "interface{}", nil
+		}
+	}
+	return "", fmt.Errorf("unsupported type %T", expr)
+}
+
+// generateFile generates the fakes for all services declared in a single
+// source file.
+func generateFile(services []*service) ([]byte, error) {
+	imports := map[string]string{"sync": "sync"}
+	for _, svc := range services {
+		for name, path := range svc.imports {
+			imports[name] = path
+		}
+	}
+
+	buf := bytes.NewBuffer(nil)
+	buf.WriteString(header)
+	buf.WriteString("package mocks\n\n")
+	writeImports(buf, imports)
+
+	for _, svc := range services {
+		writeService(buf, svc)
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+func writeImports(buf *bytes.Buffer, imports map[string]string) {
+	var std, other []string
+	for name, path := range imports {
+		line := strconv.Quote(path)
+		if name != filepath.Base(path) {
+			line = name + " " + line
+		}
+		if strings.Contains(path, ".") {
+			other = append(other, line)
+		} else {
+			std = append(std, line)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+
+	buf.WriteString("import (\n")
+	for _, line := range std {
+		fmt.Fprintf(buf, "\t%s\n", line)
+	}
+	if len(std) > 0 && len(other) > 0 {
+		buf.WriteString("\n")
+	}
+	for _, line := range other {
+		fmt.Fprintf(buf, "\t%s\n", line)
+	}
+	buf.WriteString(")\n\n")
+}
+
+func writeService(buf *bytes.Buffer, svc *service) {
+	fmt.Fprintf(buf, "// Compile-time proof of interface implementation.\n")
+	fmt.Fprintf(buf, "var _ tfe.%s = (*%s)(nil)\n\n", svc.name, svc.name)
+
+	fmt.Fprintf(buf, "// %s is a call-recording fake of tfe.%s.\n", svc.name, svc.name)
+	fmt.Fprintf(buf, "//\n")
+	fmt.Fprintf(buf, "// Each method records its arguments in the matching Calls field and then\n")
+	fmt.Fprintf(buf, "// invokes the matching Func field. If the Func field is nil, the method\n")
+	fmt.Fprintf(buf, "// returns zero values.\n")
+	fmt.Fprintf(buf, "type %s struct {\n", svc.name)
+	fmt.Fprintf(buf, "\tmu sync.Mutex\n")
+	for _, m := range svc.methods {
+		fmt.Fprintf(buf, "\n\t// %sFunc is invoked by %s.\n", m.name, m.name)
+		fmt.Fprintf(buf, "\t%sFunc func(%s) %s\n", m.name, paramList(m), resultList(m))
+		fmt.Fprintf(buf, "\t// %sCalls records the arguments of every call to %s.\n", m.name, m.name)
+		fmt.Fprintf(buf, "\t%sCalls []%s%sCall\n", m.name, svc.name, m.name)
+	}
+	fmt.Fprintf(buf, "}\n\n")
+
+	for _, m := range svc.methods {
+		callType := svc.name + m.name + "Call"
+
+		fmt.Fprintf(buf, "// %s holds the arguments of a single call to %s.%s.\n", callType, svc.name, m.name)
+		fmt.Fprintf(buf, "type %s struct {\n", callType)
+		for _, p := range m.params {
+			fmt.Fprintf(buf, "\t%s %s\n", exported(p.name), fieldType(p))
+		}
+		fmt.Fprintf(buf, "}\n\n")
+
+		if m.doc != "" {
+			for _, line := range strings.Split(m.doc, "\n") {
+				fmt.Fprintf(buf, "// %s\n", line)
+			}
+		}
+		fmt.Fprintf(buf, "func (m *%s) %s(%s) %s {\n", svc.name, m.name, paramList(m), namedResultList(m))
+		fmt.Fprintf(buf, "\tm.mu.Lock()\n")
+		fmt.Fprintf(buf, "\tm.%sCalls = append(m.%sCalls, %s{", m.name, m.name, callType)
+		for i, p := range m.params {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			fmt.Fprintf(buf, "%s: %s", exported(p.name), p.name)
+		}
+		fmt.Fprintf(buf, "})\n")
+		fmt.Fprintf(buf, "\tfn := m.%sFunc\n", m.name)
+		fmt.Fprintf(buf, "\tm.mu.Unlock()\n\n")
+		fmt.Fprintf(buf, "\tif fn == nil {\n\t\treturn\n\t}\n")
+		if len(m.results) > 0 {
+			fmt.Fprintf(buf, "\treturn fn(%s)\n", argList(m))
+		} else {
+			fmt.Fprintf(buf, "\tfn(%s)\n\treturn\n", argList(m))
+		}
+		fmt.Fprintf(buf, "}\n\n")
+	}
+}
+
+// generateClient generates the constructor for a *tfe.Client wired with
+// fakes for all services.
+func generateClient(services []*service) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	buf.WriteString(header)
+	buf.WriteString("package mocks\n\n")
+	writeImports(buf, map[string]string{"tfe": tfeImportPath})
+
+	fmt.Fprintf(buf, "// Fakes holds the fakes of a client created by NewClient.\n")
+	fmt.Fprintf(buf, "type Fakes struct {\n")
+	for _, svc := range services {
+		fmt.Fprintf(buf, "\t%s *%s\n", svc.field, svc.name)
+	}
+	fmt.Fprintf(buf, "}\n\n")
+
+	fmt.Fprintf(buf, "// NewClient returns a *tfe.Client with every service replaced by a\n")
+	fmt.Fprintf(buf, "// call-recording fake, together with the fakes so they can be configured\n")
+	fmt.Fprintf(buf, "// and inspected.\n")
+	fmt.Fprintf(buf, "func NewClient() (*tfe.Client, *Fakes) {\n")
+	fmt.Fprintf(buf, "\tfakes := &Fakes{\n")
+	for _, svc := range services {
+		fmt.Fprintf(buf, "\t\t%s: &%s{},\n", svc.field, svc.name)
+	}
+	fmt.Fprintf(buf, "\t}\n\n")
+	fmt.Fprintf(buf, "\tclient := &tfe.Client{\n")
+	for _, svc := range services {
+		fmt.Fprintf(buf, "\t\t%s: fakes.%s,\n", svc.field, svc.field)
+	}
+	fmt.Fprintf(buf, "\t}\n\n")
+	fmt.Fprintf(buf, "\treturn client, fakes\n")
+	fmt.Fprintf(buf, "}\n")
+
+	return format.Source(buf.Bytes())
+}
+
+func paramList(m *method) string {
+	var params []string
+	for _, p := range m.params {
+		params = append(params, p.name+" "+p.typ)
+	}
+	return strings.Join(params, ", ")
+}
+
+func argList(m *method) string {
+	var args []string
+	for _, p := range m.params {
+		if p.variadic {
+			args = append(args, p.name+"...")
+		} else {
+			args = append(args, p.name)
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+func resultList(m *method) string {
+	switch len(m.results) {
+	case 0:
+		return ""
+	case 1:
+		return m.results[0]
+	default:
+		return "(" + strings.Join(m.results, ", ") + ")"
+	}
+}
+
+func namedResultList(m *method) string {
+	if len(m.results) == 0 {
+		return ""
+	}
+	var results []string
+	for i, r := range m.results {
+		results = append(results, fmt.Sprintf("r%d %s", i, r))
+	}
+	return "(" + strings.Join(results, ", ") + ")"
+}
+
+// fieldType returns the type used to record a parameter.
+func fieldType(p *param) string {
+	if p.variadic {
+		return "[]" + strings.TrimPrefix(p.typ, "...")
+	}
+	return p.typ
+}
+
+// exported returns the exported form of a parameter name.
+func exported(name string) string {
+	switch name {
+	case "ctx":
+		return "Ctx"
+	case "url":
+		return "URL"
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
@@ -0,0 +1,364 @@
+// This program generates the typed resource IDs in resource_id_gen.go from
+// the table below, and the services taking them in typed_services_gen.go
+// from the service interfaces of the package. It is invoked by running go
+// generate in the root of the repository.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// resource describes the IDs of a resource type.
+type resource struct {
+	Type    string // The name of the ID type.
+	Prefix  string // The prefix all IDs start with, ending with a dash.
+	Name    string // The name of the resource used in error messages.
+	Article string // The article used with the name in doc comments.
+
+	// The names of the service method parameters holding the ID.
+	Params []string
+}
+
+// resources lists the IDs of all resource types. No prefix may be the
+// beginning of another one.
+var resources = []resource{
+	{"WorkspaceID", "ws-", "workspace", "a", []string{"workspaceID"}},
+	{"RunID", "run-", "run", "a", []string{"runID"}},
+	{"ConfigurationVersionID", "cv-", "configuration version", "a", []string{"cvID"}},
+	{"StateVersionID", "sv-", "state version", "a", []string{"svID"}},
+	{"ApplyID", "apply-", "apply", "an", []string{"applyID"}},
+	{"PlanID", "plan-", "plan", "a", []string{"planID"}},
+	{"PlanExportID", "pe-", "plan export", "a", []string{"planExportID"}},
+	{"CostEstimateID", "ce-", "cost estimate", "a", []string{"costEstimateID"}},
+	{"PolicyID", "pol-", "policy", "a", []string{"policyID"}},
+	{"PolicySetID", "polset-", "policy set", "a", []string{"policySetID"}},
+	{"PolicyCheckID", "polchk-", "policy check", "a", []string{"policyCheckID"}},
+	{"VariableID", "var-", "variable", "a", []string{"variableID"}},
+	{"TeamID", "team-", "team", "a", []string{"teamID"}},
+	{"TeamAccessID", "tws-", "team access", "a", []string{"teamAccessID"}},
+	{"NotificationConfigurationID", "nc-", "notification configuration", "a", []string{"notificationConfigurationID"}},
+	{"OAuthClientID", "oc-", "OAuth client", "an", []string{"oAuthClientID"}},
+	{"OAuthTokenID", "ot-", "OAuth token", "an", []string{"oAuthTokenID"}},
+	{"SSHKeyID", "sshkey-", "SSH key", "an", []string{"sshKeyID"}},
+	{"RunTriggerID", "rt-", "run trigger", "a", []string{"RunTriggerID"}},
+	{"OrganizationMembershipID", "ou-", "organization membership", "an", []string{"organizationMembershipID"}},
+	{"UserID", "user-", "user", "a", nil},
+}
+
+var tmpl = template.Must(template.New("").Parse(`// Code generated by resourceidgen. DO NOT EDIT.
+
+package tfe
+
+// resourceIDKinds lists the IDs of all resource types.
+var resourceIDKinds = []resourceIDKind{
+{{- range .}}
+	{ {{printf "%q" .Prefix}}, {{printf "%q" .Name}}, func(s string) ResourceID { return {{.Type}}(s) } },
+{{- end}}
+}
+{{range .}}
+// {{.Type}} is the ID of {{.Article}} {{.Name}}, like "{{.Prefix}}abc123".
+type {{.Type}} string
+
+var _ ResourceID = {{.Type}}("")
+
+// Parse{{.Type}} parses and validates the ID of {{.Article}} {{.Name}}.
+func Parse{{.Type}}(s string) ({{.Type}}, error) {
+	id := {{.Type}}(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// String implements ResourceID.
+func (id {{.Type}}) String() string { return string(id) }
+
+// Prefix implements ResourceID.
+func (id {{.Type}}) Prefix() string { return {{printf "%q" .Prefix}} }
+
+// Validate implements ResourceID.
+func (id {{.Type}}) Validate() error {
+	return validateResourceID(string(id), id.Prefix(), {{printf "%q" .Name}})
+}
+{{end}}`))
+
+// service describes a service of the client and its methods taking IDs.
+type service struct {
+	Field     string // The name of the client field holding the service.
+	Interface string // The name of the service interface.
+	Methods   []method
+}
+
+// method describes a service method with at least one typed ID parameter.
+type method struct {
+	Name    string
+	Params  []param
+	Results string // The results of the method, as written in the interface.
+	Zero    string // The values returned with an error, including it.
+}
+
+// param describes a parameter of a service method.
+type param struct {
+	Name string
+	Type string
+	ID   bool // Whether the type is a typed ID, converted to a string.
+}
+
+// Signature returns the parameter list of the typed method.
+func (m method) Signature() string {
+	params := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		params[i] = p.Name + " " + p.Type
+	}
+	return strings.Join(params, ", ")
+}
+
+// Args returns the arguments passed to the wrapped method.
+func (m method) Args() string {
+	args := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		args[i] = p.Name
+		if p.ID {
+			args[i] += ".String()"
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+var typedTmpl = template.Must(template.New("").Parse(`// Code generated by resourceidgen. DO NOT EDIT.
+
+package tfe
+
+import (
+{{- range .Imports}}
+	{{printf "%q" .}}
+{{- end}}
+)
+
+// TypedServices holds the services of a client whose methods take resource
+// IDs, wrapped to take typed IDs instead of strings.
+type TypedServices struct {
+{{- range .Services}}
+	{{.Field}} Typed{{.Interface}}
+{{- end}}
+}
+
+// Typed returns the services of the client taking typed IDs, so passing
+// the ID of one resource where the ID of another one is expected does not
+// compile:
+//
+//	runID, err := tfe.ParseRunID(input)
+//	if err != nil {
+//		return err
+//	}
+//	r, err := client.Typed().Runs.Read(ctx, runID)
+//
+// The typed IDs are validated, including their prefix, before making a
+// request.
+func (c *Client) Typed() *TypedServices {
+	return &TypedServices{
+{{- range .Services}}
+		{{.Field}}: Typed{{.Interface}}{c.{{.Field}}},
+{{- end}}
+	}
+}
+{{range $s := .Services}}
+// Typed{{.Interface}} wraps {{.Interface}} to take typed IDs.
+// Methods without IDs are promoted from {{.Interface}}.
+type Typed{{.Interface}} struct {
+	{{.Interface}}
+}
+{{range $m := .Methods}}
+// {{.Name}} validates the IDs and calls {{$s.Interface}}.{{.Name}}.
+func (s Typed{{$s.Interface}}) {{.Name}}({{.Signature}}) {{.Results}} {
+{{- range .Params}}{{if .ID}}
+	if err := {{.Name}}.Validate(); err != nil {
+		return {{$m.Zero}}
+	}
+{{- end}}{{end}}
+	return s.{{$s.Interface}}.{{.Name}}({{.Args}})
+}
+{{end}}{{end}}`))
+
+// imports maps the package names used by the service interfaces to their
+// import paths.
+var imports = map[string]string{
+	"context": "context",
+	"io":      "io",
+}
+
+// typedServices returns the services of the client whose methods take IDs,
+// parsed from the package in the current directory.
+func typedServices() ([]service, []string) {
+	idTypes := make(map[string]string)
+	for _, r := range resources {
+		for _, p := range r.Params {
+			idTypes[p] = r.Type
+		}
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	pkg := pkgs["tfe"]
+
+	// Collect the interfaces and the fields of the client.
+	interfaces := make(map[string]*ast.InterfaceType)
+	var fields *ast.FieldList
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+				switch t := ts.Type.(type) {
+				case *ast.InterfaceType:
+					interfaces[ts.Name.Name] = t
+				case *ast.StructType:
+					if ts.Name.Name == "Client" {
+						fields = t.Fields
+					}
+				}
+			}
+		}
+	}
+
+	expr := func(e ast.Expr) string {
+		var buf bytes.Buffer
+		if err := printer.Fprint(&buf, fset, e); err != nil {
+			log.Fatal(err)
+		}
+		return buf.String()
+	}
+
+	used := make(map[string]bool)
+	var services []service
+	for _, f := range fields.List {
+		ident, ok := f.Type.(*ast.Ident)
+		if !ok || len(f.Names) != 1 || !f.Names[0].IsExported() {
+			continue
+		}
+		iface, ok := interfaces[ident.Name]
+		if !ok {
+			continue
+		}
+
+		s := service{Field: f.Names[0].Name, Interface: ident.Name}
+		for _, m := range iface.Methods.List {
+			ft, ok := m.Type.(*ast.FuncType)
+			if !ok || len(m.Names) != 1 {
+				continue
+			}
+
+			meth := method{Name: m.Names[0].Name}
+			typed := false
+			for _, p := range ft.Params.List {
+				typ := expr(p.Type)
+				for _, name := range p.Names {
+					mp := param{Name: name.Name, Type: typ}
+					if id, ok := idTypes[name.Name]; ok && typ == "string" {
+						mp = param{Name: lowerFirst(name.Name), Type: id, ID: true}
+						typed = true
+					}
+					meth.Params = append(meth.Params, mp)
+				}
+			}
+			if !typed {
+				continue
+			}
+
+			switch n := ft.Results.NumFields(); n {
+			case 1:
+				meth.Results, meth.Zero = expr(ft.Results.List[0].Type), "err"
+			case 2:
+				meth.Results = fmt.Sprintf("(%s, %s)", expr(ft.Results.List[0].Type), expr(ft.Results.List[1].Type))
+				meth.Zero = "nil, err"
+			default:
+				log.Fatalf("%s.%s: unexpected number of results: %d", s.Interface, meth.Name, n)
+			}
+
+			ast.Inspect(ft, func(n ast.Node) bool {
+				if sel, ok := n.(*ast.SelectorExpr); ok {
+					if x, ok := sel.X.(*ast.Ident); ok {
+						if _, ok := imports[x.Name]; !ok {
+							log.Fatalf("%s.%s: unknown package %s", s.Interface, meth.Name, x.Name)
+						}
+						used[imports[x.Name]] = true
+					}
+				}
+				return true
+			})
+
+			s.Methods = append(s.Methods, meth)
+		}
+		if len(s.Methods) > 0 {
+			services = append(services, s)
+		}
+	}
+
+	var paths []string
+	for path := range used {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	return services, paths
+}
+
+func lowerFirst(s string) string {
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// generate executes the template with data and writes the formatted result
+// to the file.
+func generate(file string, t *template.Template, data interface{}) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("%s: %v", file, err)
+	}
+
+	if err := ioutil.WriteFile(file, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func main() {
+	out := flag.String("out", ".", "the directory to write the generated files to")
+	flag.Parse()
+
+	generate(filepath.Join(*out, "resource_id_gen.go"), tmpl, resources)
+
+	services, paths := typedServices()
+	generate(filepath.Join(*out, "typed_services_gen.go"), typedTmpl, struct {
+		Imports  []string
+		Services []service
+	}{paths, services})
+}
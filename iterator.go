@@ -0,0 +1,234 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ListPageFunc retrieves a single page of a list. The returned value must be a
+// pointer to one of the list structs returned by the List methods, like
+// *WorkspaceList, which contain an Items slice and a *Pagination.
+type ListPageFunc func(ctx context.Context, options ListOptions) (interface{}, error)
+
+// IteratorOptions represents the options for iterating over a list.
+type IteratorOptions struct {
+	// The number of elements to request per page. The API default is used
+	// when left empty.
+	PageSize int
+
+	// The maximum number of items to return. All items are returned when
+	// left empty.
+	MaxItems int
+
+	// Fetch the next page in the background while the current page is
+	// being iterated over.
+	Prefetch bool
+}
+
+// Iterator lazily walks over all the items of a paginated list, requesting
+// the next page only when the items of the current page are exhausted.
+//
+// An Iterator is not safe for concurrent use by multiple goroutines.
+type Iterator struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	fn      ListPageFunc
+	options IteratorOptions
+
+	items    reflect.Value
+	index    int
+	count    int
+	nextPage int
+	pending  chan *listPage
+
+	value interface{}
+	err   error
+	done  bool
+}
+
+// listPage is the result of retrieving a single page.
+type listPage struct {
+	items      reflect.Value
+	pagination *Pagination
+	err        error
+}
+
+// NewIterator returns an iterator that uses fn to retrieve the pages of a
+// list. A typical use looks like:
+//
+//	it := tfe.NewIterator(ctx, func(ctx context.Context, lo tfe.ListOptions) (interface{}, error) {
+//		return client.Workspaces.List(ctx, "my-org", tfe.WorkspaceListOptions{ListOptions: lo})
+//	}, tfe.IteratorOptions{})
+//	defer it.Close()
+//
+//	for it.Next() {
+//		ws := it.Value().(*tfe.Workspace)
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+func NewIterator(ctx context.Context, fn ListPageFunc, options IteratorOptions) *Iterator {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Iterator{
+		ctx:      ctx,
+		cancel:   cancel,
+		fn:       fn,
+		options:  options,
+		nextPage: 1,
+	}
+}
+
+// Next advances the iterator to the next item, which will then be available
+// through the Value method. It returns false when there are no more items,
+// the max items cap is reached, the context is done or an error occurred.
+func (it *Iterator) Next() bool {
+	if it.done {
+		return false
+	}
+
+	if it.options.MaxItems > 0 && it.count >= it.options.MaxItems {
+		it.finish(nil)
+		return false
+	}
+
+	if err := it.ctx.Err(); err != nil {
+		it.finish(err)
+		return false
+	}
+
+	for !it.items.IsValid() || it.index >= it.items.Len() {
+		if it.nextPage == 0 {
+			it.finish(nil)
+			return false
+		}
+
+		page := it.fetch()
+		if page.err != nil {
+			it.finish(page.err)
+			return false
+		}
+
+		it.items = page.items
+		it.index = 0
+		it.nextPage = 0
+		if page.pagination != nil {
+			it.nextPage = page.pagination.NextPage
+		}
+
+		if it.options.Prefetch && it.nextPage != 0 &&
+			(it.options.MaxItems == 0 || it.count+it.items.Len() < it.options.MaxItems) {
+			it.prefetch(it.nextPage)
+		}
+	}
+
+	it.value = it.items.Index(it.index).Interface()
+	it.index++
+	it.count++
+
+	return true
+}
+
+// Value returns the current item. It should only be called after a call to
+// Next returned true.
+func (it *Iterator) Value() interface{} {
+	return it.value
+}
+
+// Err returns the first error encountered while iterating, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Close stops the iterator and cancels any page request that is still in
+// flight. It is safe to call Close multiple times.
+func (it *Iterator) Close() {
+	it.finish(nil)
+}
+
+// Collect iterates over all remaining items and appends them to the slice
+// that dst points to, for example a *[]*Workspace.
+func (it *Iterator) Collect(dst interface{}) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Slice {
+		return errors.New("dst must be a pointer to a slice")
+	}
+	s := v.Elem()
+
+	for it.Next() {
+		item := reflect.ValueOf(it.value)
+		if !item.Type().AssignableTo(s.Type().Elem()) {
+			it.Close()
+			return fmt.Errorf("cannot collect %s into %s", item.Type(), s.Type())
+		}
+		s.Set(reflect.Append(s, item))
+	}
+
+	return it.err
+}
+
+// fetch returns the next page, either from the request that is already in
+// flight or by requesting it now.
+func (it *Iterator) fetch() *listPage {
+	if it.pending != nil {
+		page := <-it.pending
+		it.pending = nil
+		return page
+	}
+	return it.fetchPage(it.nextPage)
+}
+
+// prefetch requests the given page in the background.
+func (it *Iterator) prefetch(number int) {
+	it.pending = make(chan *listPage, 1)
+	go func(pending chan<- *listPage) {
+		pending <- it.fetchPage(number)
+	}(it.pending)
+}
+
+// fetchPage requests a single page and extracts its items and pagination.
+func (it *Iterator) fetchPage(number int) *listPage {
+	options := ListOptions{
+		PageNumber: number,
+		PageSize:   it.options.PageSize,
+	}
+
+	list, err := it.fn(it.ctx, options)
+	if err != nil {
+		return &listPage{err: err}
+	}
+
+	v := reflect.ValueOf(list)
+	if list == nil || (v.Kind() == reflect.Ptr && v.IsNil()) {
+		return &listPage{items: reflect.ValueOf([]interface{}{})}
+	}
+
+	v = reflect.Indirect(v)
+	if v.Kind() != reflect.Struct {
+		return &listPage{err: errors.New("list must be a struct or a pointer to a struct")}
+	}
+
+	items := v.FieldByName("Items")
+	if !items.IsValid() || items.Kind() != reflect.Slice {
+		return &listPage{err: errors.New("list must have an Items slice")}
+	}
+
+	page := &listPage{items: items}
+	if pagination := v.FieldByName("Pagination"); pagination.IsValid() {
+		page.pagination, _ = pagination.Interface().(*Pagination)
+	}
+
+	return page
+}
+
+// finish marks the iterator as done, recording err if it is the first error.
+func (it *Iterator) finish(err error) {
+	if it.err == nil {
+		it.err = err
+	}
+	it.done = true
+	it.value = nil
+	it.cancel()
+}
@@ -0,0 +1,167 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testListPages returns a ListPageFunc that serves total workspaces in
+// pages of the requested size, counting the number of requests made.
+func testListPages(total int, requests *int32) ListPageFunc {
+	return func(ctx context.Context, options ListOptions) (interface{}, error) {
+		atomic.AddInt32(requests, 1)
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		size := options.PageSize
+		if size == 0 {
+			size = 20
+		}
+		totalPages := (total + size - 1) / size
+
+		list := &WorkspaceList{
+			Pagination: &Pagination{
+				CurrentPage: options.PageNumber,
+				TotalPages:  totalPages,
+				TotalCount:  total,
+			},
+		}
+		if options.PageNumber < totalPages {
+			list.NextPage = options.PageNumber + 1
+		}
+
+		for i := (options.PageNumber - 1) * size; i < options.PageNumber*size && i < total; i++ {
+			list.Items = append(list.Items, &Workspace{ID: string(rune('a' + i%26))})
+		}
+
+		return list, nil
+	}
+}
+
+func TestIterator_Next(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("walks all pages", func(t *testing.T) {
+		var requests int32
+		it := NewIterator(ctx, testListPages(25, &requests), IteratorOptions{PageSize: 10})
+		defer it.Close()
+
+		count := 0
+		for it.Next() {
+			_, ok := it.Value().(*Workspace)
+			require.True(t, ok)
+			count++
+		}
+		require.NoError(t, it.Err())
+		assert.Equal(t, 25, count)
+		assert.Equal(t, int32(3), requests)
+	})
+
+	t.Run("is lazy", func(t *testing.T) {
+		var requests int32
+		it := NewIterator(ctx, testListPages(25, &requests), IteratorOptions{PageSize: 10})
+		defer it.Close()
+
+		assert.Equal(t, int32(0), requests)
+		require.True(t, it.Next())
+		assert.Equal(t, int32(1), requests)
+	})
+
+	t.Run("with max items", func(t *testing.T) {
+		var requests int32
+		it := NewIterator(ctx, testListPages(25, &requests), IteratorOptions{
+			PageSize: 10,
+			MaxItems: 12,
+		})
+		defer it.Close()
+
+		var ws []*Workspace
+		require.NoError(t, it.Collect(&ws))
+		assert.Len(t, ws, 12)
+		assert.Equal(t, int32(2), requests)
+	})
+
+	t.Run("with prefetch", func(t *testing.T) {
+		var requests int32
+		it := NewIterator(ctx, testListPages(25, &requests), IteratorOptions{
+			PageSize: 10,
+			Prefetch: true,
+		})
+		defer it.Close()
+
+		var ws []*Workspace
+		require.NoError(t, it.Collect(&ws))
+		assert.Len(t, ws, 25)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("with a canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+
+		var requests int32
+		it := NewIterator(ctx, testListPages(25, &requests), IteratorOptions{PageSize: 10})
+		defer it.Close()
+
+		require.True(t, it.Next())
+		cancel()
+
+		assert.False(t, it.Next())
+		assert.Equal(t, context.Canceled, it.Err())
+		assert.Nil(t, it.Value())
+	})
+
+	t.Run("when a page request fails", func(t *testing.T) {
+		errPage := errors.New("page error")
+		it := NewIterator(ctx, func(ctx context.Context, options ListOptions) (interface{}, error) {
+			return nil, errPage
+		}, IteratorOptions{})
+		defer it.Close()
+
+		assert.False(t, it.Next())
+		assert.Equal(t, errPage, it.Err())
+	})
+
+	t.Run("without pagination", func(t *testing.T) {
+		var requests int32
+		it := NewIterator(ctx, func(ctx context.Context, options ListOptions) (interface{}, error) {
+			atomic.AddInt32(&requests, 1)
+			return &WorkspaceList{Items: []*Workspace{{ID: "ws-1"}}}, nil
+		}, IteratorOptions{})
+		defer it.Close()
+
+		var ws []*Workspace
+		require.NoError(t, it.Collect(&ws))
+		assert.Len(t, ws, 1)
+		assert.Equal(t, int32(1), requests)
+	})
+
+	t.Run("with an invalid list", func(t *testing.T) {
+		it := NewIterator(ctx, func(ctx context.Context, options ListOptions) (interface{}, error) {
+			return &Workspace{}, nil
+		}, IteratorOptions{})
+		defer it.Close()
+
+		assert.False(t, it.Next())
+		assert.EqualError(t, it.Err(), "list must have an Items slice")
+	})
+}
+
+func TestIterator_Collect(t *testing.T) {
+	var requests int32
+	it := NewIterator(context.Background(), testListPages(5, &requests), IteratorOptions{})
+	defer it.Close()
+
+	var runs []*Run
+	err := it.Collect(&runs)
+	assert.EqualError(t, err, "cannot collect *tfe.Workspace into []*tfe.Run")
+
+	err = it.Collect(runs)
+	assert.EqualError(t, err, "dst must be a pointer to a slice")
+}
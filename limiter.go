@@ -0,0 +1,167 @@
+package tfe
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const headerRateRemaining = "X-RateLimit-Remaining"
+
+// RateLimiter limits the rate at which API calls are made. A single
+// RateLimiter can be shared by multiple clients, so all of them cooperate on
+// the same budget. Implementations must be safe for concurrent use.
+type RateLimiter interface {
+	// Wait blocks until an API call may be made or ctx is done.
+	Wait(ctx context.Context) error
+
+	// Update is called with the headers of every API response, so the
+	// limiter can adapt to the rate limit reported by the server.
+	Update(header http.Header)
+}
+
+// AdaptiveLimiter is a RateLimiter that adapts to the X-RateLimit-Limit,
+// X-RateLimit-Remaining and X-RateLimit-Reset response headers. It is the
+// limiter used by a client when no RateLimiter is configured.
+type AdaptiveLimiter struct {
+	mu sync.Mutex
+
+	// The limit reported by the server, which determines the base rate.
+	rateLimit float64
+
+	limiter       *rate.Limiter
+	pausedUntil   time.Time
+	adjustedUntil time.Time
+}
+
+var _ RateLimiter = (*AdaptiveLimiter)(nil)
+
+// NewAdaptiveLimiter creates a new AdaptiveLimiter. It does not limit any
+// calls until it is updated with the rate limit reported by the server.
+func NewAdaptiveLimiter() *AdaptiveLimiter {
+	return &AdaptiveLimiter{
+		limiter: rate.NewLimiter(rate.Inf, 0),
+	}
+}
+
+// Limit returns the current rate limit in calls per second.
+func (l *AdaptiveLimiter) Limit() rate.Limit {
+	return l.current().Limit()
+}
+
+// Burst returns the current burst size.
+func (l *AdaptiveLimiter) Burst() int {
+	return l.current().Burst()
+}
+
+// Wait implements RateLimiter.
+func (l *AdaptiveLimiter) Wait(ctx context.Context) error {
+	limiter := l.current()
+
+	l.mu.Lock()
+	pause := time.Until(l.pausedUntil)
+	l.mu.Unlock()
+
+	if pause > 0 {
+		timer := time.NewTimer(pause)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	return limiter.Wait(ctx)
+}
+
+// Update implements RateLimiter.
+func (l *AdaptiveLimiter) Update(header http.Header) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if v := header.Get(headerRateLimit); v != "" {
+		if rateLimit, err := strconv.ParseFloat(v, 64); err == nil && rateLimit >= 0 {
+			l.setRateLimit(rateLimit)
+		}
+	}
+
+	// Without a rate limit there is nothing to adapt.
+	if l.rateLimit == 0 {
+		return
+	}
+
+	remaining, err := strconv.ParseFloat(header.Get(headerRateRemaining), 64)
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseFloat(header.Get(headerRateReset), 64)
+	if err != nil || reset <= 0 {
+		return
+	}
+	resetAt := time.Now().Add(time.Duration(reset * 1e9))
+
+	// Stop all calls until the reset when the budget is used up.
+	if remaining < 1 {
+		if resetAt.After(l.pausedUntil) {
+			l.pausedUntil = resetAt
+		}
+		return
+	}
+
+	// Spread the remaining calls evenly until the reset when they would
+	// otherwise be used up before the reset.
+	base := l.baseLimit()
+	if adjusted := rate.Limit(remaining / reset); adjusted < base {
+		l.limiter.SetLimit(adjusted)
+		l.adjustedUntil = resetAt
+	}
+}
+
+// current returns the underlying limiter, restoring the base rate if the
+// adjusted rate has expired.
+func (l *AdaptiveLimiter) current() *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.adjustedUntil.IsZero() && time.Now().After(l.adjustedUntil) {
+		l.limiter.SetLimit(l.baseLimit())
+		l.adjustedUntil = time.Time{}
+	}
+
+	return l.limiter
+}
+
+// setRateLimit configures the base rate for the rate limit reported by the
+// server. Configure the limit and burst using a split of 2/3 for the limit
+// and 1/3 for the burst. This enables clients to burst 1/3 of the allowed
+// calls before the limiter kicks in. The remaining calls will then be
+// spread out evenly using intervals of time.Second / limit which should
+// prevent hitting the rate limit.
+func (l *AdaptiveLimiter) setRateLimit(rateLimit float64) {
+	if rateLimit == l.rateLimit {
+		return
+	}
+	l.rateLimit = rateLimit
+	l.adjustedUntil = time.Time{}
+
+	if rateLimit == 0 {
+		l.limiter = rate.NewLimiter(rate.Inf, 0)
+		return
+	}
+
+	l.limiter = rate.NewLimiter(l.baseLimit(), int(rateLimit*0.33))
+}
+
+// baseLimit returns the rate used when the server reports no shortage.
+func (l *AdaptiveLimiter) baseLimit() rate.Limit {
+	if l.rateLimit == 0 {
+		return rate.Inf
+	}
+	return rate.Limit(l.rateLimit * 0.66)
+}
@@ -0,0 +1,123 @@
+package tfe
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func testRateLimitHeader(limit, remaining, reset string) http.Header {
+	header := make(http.Header)
+	if limit != "" {
+		header.Set("X-RateLimit-Limit", limit)
+	}
+	if remaining != "" {
+		header.Set("X-RateLimit-Remaining", remaining)
+	}
+	if reset != "" {
+		header.Set("X-RateLimit-Reset", reset)
+	}
+	return header
+}
+
+func TestAdaptiveLimiter_Update(t *testing.T) {
+	t.Run("without a rate limit", func(t *testing.T) {
+		l := NewAdaptiveLimiter()
+		l.Update(testRateLimitHeader("", "0", "10"))
+		assert.Equal(t, rate.Inf, l.Limit())
+		assert.NoError(t, l.Wait(context.Background()))
+	})
+
+	t.Run("with a rate limit", func(t *testing.T) {
+		l := NewAdaptiveLimiter()
+		l.Update(testRateLimitHeader("30", "", ""))
+		assert.Equal(t, rate.Limit(19.8), l.Limit())
+		assert.Equal(t, 9, l.Burst())
+
+		l.Update(testRateLimitHeader("100", "", ""))
+		assert.Equal(t, rate.Limit(66), l.Limit())
+		assert.Equal(t, 33, l.Burst())
+	})
+
+	t.Run("with few remaining calls", func(t *testing.T) {
+		l := NewAdaptiveLimiter()
+		l.Update(testRateLimitHeader("30", "", ""))
+
+		l.Update(testRateLimitHeader("", "5", "0.05"))
+		assert.Equal(t, rate.Limit(19.8), l.Limit())
+
+		l.Update(testRateLimitHeader("", "2", "0.5"))
+		assert.Equal(t, rate.Limit(4), l.Limit())
+
+		time.Sleep(600 * time.Millisecond)
+		assert.Equal(t, rate.Limit(19.8), l.Limit())
+	})
+
+	t.Run("without remaining calls", func(t *testing.T) {
+		l := NewAdaptiveLimiter()
+		l.Update(testRateLimitHeader("30", "0", "0.2"))
+
+		start := time.Now()
+		require.NoError(t, l.Wait(context.Background()))
+		assert.True(t, time.Since(start) >= 150*time.Millisecond, "waited only %s", time.Since(start))
+	})
+
+	t.Run("with a canceled context while paused", func(t *testing.T) {
+		l := NewAdaptiveLimiter()
+		l.Update(testRateLimitHeader("30", "0", "10"))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		assert.Equal(t, context.DeadlineExceeded, l.Wait(ctx))
+	})
+}
+
+func TestAdaptiveLimiter_shared(t *testing.T) {
+	remaining := "30"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "30")
+		w.Header().Set("X-RateLimit-Remaining", remaining)
+		w.Header().Set("X-RateLimit-Reset", "0.2")
+		if r.URL.Path == DefaultBasePath+PingEndpoint {
+			w.WriteHeader(204)
+			return
+		}
+		testWorkspaceHandler(w, r)
+	}))
+	defer ts.Close()
+
+	shared := NewAdaptiveLimiter()
+	cfg := &Config{
+		Address:     ts.URL,
+		Token:       "dummy-token",
+		HTTPClient:  ts.Client(),
+		RateLimiter: shared,
+	}
+
+	client1, err := NewClient(cfg)
+	require.NoError(t, err)
+	client2, err := NewClient(cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, RateLimiter(shared), client1.limiter)
+	assert.Equal(t, RateLimiter(shared), client2.limiter)
+	assert.Equal(t, rate.Limit(19.8), shared.Limit())
+
+	// A response of one client pauses the calls of the other.
+	remaining = "0"
+	_, err = client1.Workspaces.ReadByID(context.Background(), "ws-123")
+	require.NoError(t, err)
+
+	remaining = "30"
+	start := time.Now()
+	_, err = client2.Workspaces.ReadByID(context.Background(), "ws-123")
+	require.NoError(t, err)
+	assert.True(t, time.Since(start) >= 150*time.Millisecond, "waited only %s", time.Since(start))
+}
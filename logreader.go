@@ -61,22 +61,41 @@ func (r *LogReader) read(l []byte) (int, error) {
 	if err != nil {
 		return 0, err
 	}
-	req = req.WithContext(r.ctx)
 
 	// Attach the default headers.
 	for k, v := range r.client.headers {
 		req.Header[k] = v
 	}
 
+	// Only send the token when the logs are served by the API host itself,
+	// as log URLs of other hosts are authorized by their secret URL.
+	if r.logURL.Host == r.client.baseURL.Host {
+		token, err := r.client.tokenSource.Token(r.ctx)
+		if err != nil {
+			return 0, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	op := Operation{Name: "LogReader.Read", ResourceType: "logs"}
+	ctx, end := r.client.startCall(r.ctx, op, req)
+	req = req.WithContext(ctx)
+
 	// Retrieve the next chunk.
 	resp, err := r.client.http.HTTPClient.Do(req)
 	if err != nil {
+		end(err)
 		return 0, err
 	}
 	defer resp.Body.Close()
 
+	if info := callInfo(ctx); info != nil {
+		info.StatusCode = resp.StatusCode
+	}
 	// Basic response checking.
-	if err := checkResponseCode(resp); err != nil {
+	err = checkResponseCode(resp)
+	end(err)
+	if err != nil {
 		return 0, err
 	}
 
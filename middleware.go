@@ -0,0 +1,144 @@
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// Operation describes the API call a request is made for.
+type Operation struct {
+	// The name of the API call in the form "Service.Method", for example
+	// "Workspaces.Read". It is empty for requests that are not made by one
+	// of the services.
+	Name string
+
+	// The type of the resource addressed by the request path, for example
+	// "workspaces".
+	ResourceType string
+
+	// The resource names and IDs found in the request path, keyed by the
+	// collection they belong to, for example:
+	//
+	//	{"organizations": "my-org", "workspaces": "my-workspace"}
+	IDs map[string]string
+}
+
+// RequestHandler sends the request for an operation and returns the
+// response. The response is checked and decoded by the client afterwards.
+type RequestHandler func(ctx context.Context, op Operation, req *http.Request) (*http.Response, error)
+
+// Middleware wraps a RequestHandler. A middleware can modify the request
+// before calling next, observe the response or error returned by next, or
+// short-circuit the call by returning a response without calling next.
+//
+// The request passed to the handler has already been prepared by the client,
+// including its headers and a rewindable body. Retries happen within the
+// innermost handler, so each middleware is called once per API call.
+type Middleware func(next RequestHandler) RequestHandler
+
+// operationContextKey is the context key used to attach an operation to a
+// request created by newRequest.
+type operationContextKey struct{}
+
+// withOperation attaches the operation with the given name to the request.
+func withOperation(req *retryablehttp.Request, name, basePath string) *retryablehttp.Request {
+	op := parseOperationPath(strings.TrimPrefix(req.URL.Path, basePath))
+	op.Name = name
+
+	ctx := context.WithValue(req.Context(), operationContextKey{}, op)
+	return req.WithContext(ctx)
+}
+
+// requestOperation returns the operation attached to the request.
+func requestOperation(req *retryablehttp.Request) Operation {
+	op, _ := req.Context().Value(operationContextKey{}).(Operation)
+	return op
+}
+
+// parseOperationPath extracts the resource type and IDs from a request path
+// relative to the base path, like "organizations/my-org/workspaces".
+func parseOperationPath(path string) Operation {
+	op := Operation{IDs: make(map[string]string)}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i < len(segments); i += 2 {
+		collection := segments[i]
+		if collection == "" || collection == "actions" || collection == "relationships" {
+			break
+		}
+		op.ResourceType = collection
+
+		if i+1 < len(segments) {
+			id, err := url.PathUnescape(segments[i+1])
+			if err != nil {
+				id = segments[i+1]
+			}
+			op.IDs[collection] = id
+		}
+	}
+
+	return op
+}
+
+// sendRequest sends the request through the configured middleware, with
+// the retrying HTTP client as the innermost handler.
+func (c *Client) sendRequest(ctx context.Context, op Operation, req *retryablehttp.Request) (*http.Response, error) {
+	if len(c.middleware) == 0 {
+		return c.http.Do(req)
+	}
+
+	// Give the middleware access to a readable copy of the body.
+	body, err := req.BodyBytes()
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		req.ContentLength = int64(len(body))
+	}
+
+	handler := RequestHandler(func(ctx context.Context, op Operation, r *http.Request) (*http.Response, error) {
+		var rawBody interface{}
+		if r.Body != nil {
+			rawBody = r.Body
+		}
+
+		rreq, err := retryablehttp.NewRequest(r.Method, r.URL.String(), rawBody)
+		if err != nil {
+			return nil, err
+		}
+		rreq.Header = r.Header
+
+		return c.http.Do(rreq.WithContext(ctx))
+	})
+
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		handler = c.middleware[i](handler)
+	}
+
+	resp, err := handler(ctx, op, req.Request)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errors.New("middleware returned neither a response nor an error")
+	}
+
+	// Make sure responses returned by a short-circuiting middleware
+	// can be checked and decoded like any other response.
+	if resp.Request == nil {
+		resp.Request = req.Request
+	}
+	if resp.Body == nil {
+		resp.Body = ioutil.NopCloser(bytes.NewReader(nil))
+	}
+
+	return resp, nil
+}
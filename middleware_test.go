@@ -0,0 +1,294 @@
+package tfe
+
+import (
+	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMiddlewareClient(t *testing.T, h http.HandlerFunc, middleware ...Middleware) (*Client, func()) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == DefaultBasePath+PingEndpoint {
+			w.WriteHeader(204)
+			return
+		}
+		h(w, r)
+	}))
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Middleware: middleware,
+	})
+	if err != nil {
+		ts.Close()
+		t.Fatal(err)
+	}
+
+	return client, ts.Close
+}
+
+func testWorkspaceHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/vnd.api+json")
+	w.Write([]byte(`{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"my-workspace"}}}`))
+}
+
+func TestMiddleware_operation(t *testing.T) {
+	var ops []Operation
+	record := func(next RequestHandler) RequestHandler {
+		return func(ctx context.Context, op Operation, req *http.Request) (*http.Response, error) {
+			ops = append(ops, op)
+			return next(ctx, op, req)
+		}
+	}
+
+	client, done := testMiddlewareClient(t, testWorkspaceHandler, record)
+	defer done()
+	ctx := context.Background()
+
+	_, err := client.Workspaces.Read(ctx, "my-org", "my-workspace")
+	require.NoError(t, err)
+
+	_, err = client.Workspaces.Lock(ctx, "ws-123", WorkspaceLockOptions{})
+	require.NoError(t, err)
+
+	require.Len(t, ops, 2)
+	assert.Equal(t, Operation{
+		Name:         "Workspaces.Read",
+		ResourceType: "workspaces",
+		IDs:          map[string]string{"organizations": "my-org", "workspaces": "my-workspace"},
+	}, ops[0])
+	assert.Equal(t, Operation{
+		Name:         "Workspaces.Lock",
+		ResourceType: "workspaces",
+		IDs:          map[string]string{"workspaces": "ws-123"},
+	}, ops[1])
+}
+
+func TestMiddleware_operationOfHelpers(t *testing.T) {
+	var names []string
+	record := func(next RequestHandler) RequestHandler {
+		return func(ctx context.Context, op Operation, req *http.Request) (*http.Response, error) {
+			names = append(names, op.Name)
+			return next(ctx, op, req)
+		}
+	}
+
+	client, done := testMiddlewareClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if strings.HasPrefix(r.URL.Path, DefaultBasePath+"runs/") {
+			w.Write([]byte(`{"data":{"id":"run-123","type":"runs","attributes":{"status":"applied"}}}`))
+			return
+		}
+		w.Write([]byte(`{"data":[{"id":"ws-123","type":"workspaces"}],"meta":{"pagination":{"current-page":1,"total-pages":1}}}`))
+	}, record)
+	defer done()
+	ctx := context.Background()
+
+	_, err := NewRunWatcher(client.Runs, RunWatcherOptions{}).WaitForStatus(ctx, "run-123", RunApplied)
+	require.NoError(t, err)
+
+	it := NewIterator(ctx, func(ctx context.Context, lo ListOptions) (interface{}, error) {
+		return client.Workspaces.List(ctx, "my-org", WorkspaceListOptions{ListOptions: lo})
+	}, IteratorOptions{})
+	defer it.Close()
+	var workspaces []*Workspace
+	require.NoError(t, it.Collect(&workspaces))
+
+	_, err = client.Runs.Read(ctx, "run-123")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Runs.ReadWithOptions", "Workspaces.List", "Runs.Read"}, names)
+}
+
+func TestMiddleware_operationNames(t *testing.T) {
+	client, done := testMiddlewareClient(t, testWorkspaceHandler)
+	defer done()
+
+	// Map the types implementing the services to the names of the services.
+	services := make(map[string]string)
+	v := reflect.ValueOf(client).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if f.Kind() != reflect.Interface || f.IsNil() || !f.CanInterface() {
+			continue
+		}
+		services[reflect.Indirect(f.Elem()).Type().Name()] = f.Type().Name()
+	}
+
+	// Every request made by a service method is named after the method.
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	require.NoError(t, err)
+
+	var requests int
+	for _, file := range pkgs["tfe"].Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || fn.Body == nil {
+				continue
+			}
+
+			var want string
+			if star, ok := fn.Recv.List[0].Type.(*ast.StarExpr); ok {
+				if ident, ok := star.X.(*ast.Ident); ok && services[ident.Name] != "" {
+					want = strconv.Quote(services[ident.Name] + "." + fn.Name.Name)
+				}
+			}
+
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+				sel, ok := call.Fun.(*ast.SelectorExpr)
+				if !ok || sel.Sel.Name != "newRequest" {
+					return true
+				}
+
+				requests++
+				pos := fset.Position(call.Pos())
+				if want == "" {
+					t.Errorf("%s: request made outside of a service method", pos)
+					return true
+				}
+				// Unexported helpers are passed the operation by the
+				// methods delegating to them.
+				if ident, ok := call.Args[0].(*ast.Ident); ok && !fn.Name.IsExported() {
+					assert.Equal(t, "operation", ident.Name, "operation at %s", pos)
+					return true
+				}
+				lit, ok := call.Args[0].(*ast.BasicLit)
+				if assert.True(t, ok, "%s: operation is not a literal", pos) {
+					assert.Equal(t, want, lit.Value, "operation at %s", pos)
+				}
+				return true
+			})
+		}
+	}
+	assert.NotZero(t, requests)
+}
+
+func TestMiddleware_chain(t *testing.T) {
+	var order []string
+	trace := func(name string) Middleware {
+		return func(next RequestHandler) RequestHandler {
+			return func(ctx context.Context, op Operation, req *http.Request) (*http.Response, error) {
+				order = append(order, name+" before")
+				resp, err := next(ctx, op, req)
+				order = append(order, name+" after")
+				return resp, err
+			}
+		}
+	}
+
+	client, done := testMiddlewareClient(t, testWorkspaceHandler, trace("outer"), trace("inner"))
+	defer done()
+
+	_, err := client.Workspaces.ReadByID(context.Background(), "ws-123")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"outer before", "inner before", "inner after", "outer after"}, order)
+}
+
+func TestMiddleware_modifyRequest(t *testing.T) {
+	var header, body string
+	h := func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("X-Signature")
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		testWorkspaceHandler(w, r)
+	}
+
+	sign := func(next RequestHandler) RequestHandler {
+		return func(ctx context.Context, op Operation, req *http.Request) (*http.Response, error) {
+			b, err := ioutil.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set("X-Signature", "signed-"+op.Name)
+			req.Body = ioutil.NopCloser(strings.NewReader(strings.Replace(string(b), "before", "after", 1)))
+			return next(ctx, op, req)
+		}
+	}
+
+	client, done := testMiddlewareClient(t, h, sign)
+	defer done()
+
+	_, err := client.Workspaces.UpdateByID(context.Background(), "ws-123", WorkspaceUpdateOptions{
+		Name: String("before"),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "signed-Workspaces.UpdateByID", header)
+	assert.Contains(t, body, `"name":"after"`)
+}
+
+func TestMiddleware_shortCircuit(t *testing.T) {
+	called := false
+	h := func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		testWorkspaceHandler(w, r)
+	}
+
+	deny := func(next RequestHandler) RequestHandler {
+		return func(ctx context.Context, op Operation, req *http.Request) (*http.Response, error) {
+			if req.Method == "DELETE" {
+				return &http.Response{StatusCode: http.StatusForbidden}, nil
+			}
+			return next(ctx, op, req)
+		}
+	}
+
+	client, done := testMiddlewareClient(t, h, deny)
+	defer done()
+
+	err := client.Workspaces.DeleteByID(context.Background(), "ws-123")
+	require.Error(t, err)
+	assert.Equal(t, "403 Forbidden", err.Error())
+	assert.False(t, called)
+}
+
+func TestMiddleware_parseOperationPath(t *testing.T) {
+	cases := map[string]Operation{
+		"organizations/my-org/workspaces": {
+			ResourceType: "workspaces",
+			IDs:          map[string]string{"organizations": "my-org"},
+		},
+		"runs/run-123/actions/apply": {
+			ResourceType: "runs",
+			IDs:          map[string]string{"runs": "run-123"},
+		},
+		"teams/team-123/relationships/users": {
+			ResourceType: "teams",
+			IDs:          map[string]string{"teams": "team-123"},
+		},
+		"workspaces/ws-123/current-state-version": {
+			ResourceType: "current-state-version",
+			IDs:          map[string]string{"workspaces": "ws-123"},
+		},
+		"organizations/my%20org": {
+			ResourceType: "organizations",
+			IDs:          map[string]string{"organizations": "my org"},
+		},
+	}
+
+	for path, want := range cases {
+		assert.Equal(t, want, parseOperationPath(path), path)
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.Applies = (*Applies)(nil)
+
+// Applies is a call-recording fake of tfe.Applies.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type Applies struct {
+	mu sync.Mutex
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, applyID string) (*tfe.Apply, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []AppliesReadCall
+
+	// LogsFunc is invoked by Logs.
+	LogsFunc func(ctx context.Context, applyID string) (io.Reader, error)
+	// LogsCalls records the arguments of every call to Logs.
+	LogsCalls []AppliesLogsCall
+}
+
+// AppliesReadCall holds the arguments of a single call to Applies.Read.
+type AppliesReadCall struct {
+	Ctx     context.Context
+	ApplyID string
+}
+
+// Read an apply by its ID.
+func (m *Applies) Read(ctx context.Context, applyID string) (r0 *tfe.Apply, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, AppliesReadCall{Ctx: ctx, ApplyID: applyID})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, applyID)
+}
+
+// AppliesLogsCall holds the arguments of a single call to Applies.Logs.
+type AppliesLogsCall struct {
+	Ctx     context.Context
+	ApplyID string
+}
+
+// Logs retrieves the logs of an apply.
+func (m *Applies) Logs(ctx context.Context, applyID string) (r0 io.Reader, r1 error) {
+	m.mu.Lock()
+	m.LogsCalls = append(m.LogsCalls, AppliesLogsCall{Ctx: ctx, ApplyID: applyID})
+	fn := m.LogsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, applyID)
+}
@@ -0,0 +1,105 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Fakes holds the fakes of a client created by NewClient.
+type Fakes struct {
+	Applies                    *Applies
+	ConfigurationVersions      *ConfigurationVersions
+	CostEstimates              *CostEstimates
+	NotificationConfigurations *NotificationConfigurations
+	OAuthClients               *OAuthClients
+	OAuthTokens                *OAuthTokens
+	Organizations              *Organizations
+	OrganizationMemberships    *OrganizationMemberships
+	OrganizationTokens         *OrganizationTokens
+	Plans                      *Plans
+	PlanExports                *PlanExports
+	Policies                   *Policies
+	PolicyChecks               *PolicyChecks
+	PolicySetParameters        *PolicySetParameters
+	PolicySets                 *PolicySets
+	RegistryModules            *RegistryModules
+	Runs                       *Runs
+	RunTriggers                *RunTriggers
+	SSHKeys                    *SSHKeys
+	StateVersions              *StateVersions
+	Teams                      *Teams
+	TeamAccess                 *TeamAccesses
+	TeamMembers                *TeamMembers
+	TeamTokens                 *TeamTokens
+	Users                      *Users
+	Variables                  *Variables
+	Workspaces                 *Workspaces
+}
+
+// NewClient returns a *tfe.Client with every service replaced by a
+// call-recording fake, together with the fakes so they can be configured
+// and inspected.
+func NewClient() (*tfe.Client, *Fakes) {
+	fakes := &Fakes{
+		Applies:                    &Applies{},
+		ConfigurationVersions:      &ConfigurationVersions{},
+		CostEstimates:              &CostEstimates{},
+		NotificationConfigurations: &NotificationConfigurations{},
+		OAuthClients:               &OAuthClients{},
+		OAuthTokens:                &OAuthTokens{},
+		Organizations:              &Organizations{},
+		OrganizationMemberships:    &OrganizationMemberships{},
+		OrganizationTokens:         &OrganizationTokens{},
+		Plans:                      &Plans{},
+		PlanExports:                &PlanExports{},
+		Policies:                   &Policies{},
+		PolicyChecks:               &PolicyChecks{},
+		PolicySetParameters:        &PolicySetParameters{},
+		PolicySets:                 &PolicySets{},
+		RegistryModules:            &RegistryModules{},
+		Runs:                       &Runs{},
+		RunTriggers:                &RunTriggers{},
+		SSHKeys:                    &SSHKeys{},
+		StateVersions:              &StateVersions{},
+		Teams:                      &Teams{},
+		TeamAccess:                 &TeamAccesses{},
+		TeamMembers:                &TeamMembers{},
+		TeamTokens:                 &TeamTokens{},
+		Users:                      &Users{},
+		Variables:                  &Variables{},
+		Workspaces:                 &Workspaces{},
+	}
+
+	client := &tfe.Client{
+		Applies:                    fakes.Applies,
+		ConfigurationVersions:      fakes.ConfigurationVersions,
+		CostEstimates:              fakes.CostEstimates,
+		NotificationConfigurations: fakes.NotificationConfigurations,
+		OAuthClients:               fakes.OAuthClients,
+		OAuthTokens:                fakes.OAuthTokens,
+		Organizations:              fakes.Organizations,
+		OrganizationMemberships:    fakes.OrganizationMemberships,
+		OrganizationTokens:         fakes.OrganizationTokens,
+		Plans:                      fakes.Plans,
+		PlanExports:                fakes.PlanExports,
+		Policies:                   fakes.Policies,
+		PolicyChecks:               fakes.PolicyChecks,
+		PolicySetParameters:        fakes.PolicySetParameters,
+		PolicySets:                 fakes.PolicySets,
+		RegistryModules:            fakes.RegistryModules,
+		Runs:                       fakes.Runs,
+		RunTriggers:                fakes.RunTriggers,
+		SSHKeys:                    fakes.SSHKeys,
+		StateVersions:              fakes.StateVersions,
+		Teams:                      fakes.Teams,
+		TeamAccess:                 fakes.TeamAccess,
+		TeamMembers:                fakes.TeamMembers,
+		TeamTokens:                 fakes.TeamTokens,
+		Users:                      fakes.Users,
+		Variables:                  fakes.Variables,
+		Workspaces:                 fakes.Workspaces,
+	}
+
+	return client, fakes
+}
@@ -0,0 +1,150 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.ConfigurationVersions = (*ConfigurationVersions)(nil)
+
+// ConfigurationVersions is a call-recording fake of tfe.ConfigurationVersions.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type ConfigurationVersions struct {
+	mu sync.Mutex
+
+	// ListFunc is invoked by List.
+	ListFunc func(ctx context.Context, workspaceID string, options tfe.ConfigurationVersionListOptions) (*tfe.ConfigurationVersionList, error)
+	// ListCalls records the arguments of every call to List.
+	ListCalls []ConfigurationVersionsListCall
+
+	// CreateFunc is invoked by Create.
+	CreateFunc func(ctx context.Context, workspaceID string, options tfe.ConfigurationVersionCreateOptions) (*tfe.ConfigurationVersion, error)
+	// CreateCalls records the arguments of every call to Create.
+	CreateCalls []ConfigurationVersionsCreateCall
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, cvID string) (*tfe.ConfigurationVersion, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []ConfigurationVersionsReadCall
+
+	// ReadWithOptionsFunc is invoked by ReadWithOptions.
+	ReadWithOptionsFunc func(ctx context.Context, cvID string, options tfe.ConfigurationVersionReadOptions) (*tfe.ConfigurationVersion, error)
+	// ReadWithOptionsCalls records the arguments of every call to ReadWithOptions.
+	ReadWithOptionsCalls []ConfigurationVersionsReadWithOptionsCall
+
+	// UploadFunc is invoked by Upload.
+	UploadFunc func(ctx context.Context, url string, path string) error
+	// UploadCalls records the arguments of every call to Upload.
+	UploadCalls []ConfigurationVersionsUploadCall
+}
+
+// ConfigurationVersionsListCall holds the arguments of a single call to ConfigurationVersions.List.
+type ConfigurationVersionsListCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+	Options     tfe.ConfigurationVersionListOptions
+}
+
+// List returns all configuration versions of a workspace.
+func (m *ConfigurationVersions) List(ctx context.Context, workspaceID string, options tfe.ConfigurationVersionListOptions) (r0 *tfe.ConfigurationVersionList, r1 error) {
+	m.mu.Lock()
+	m.ListCalls = append(m.ListCalls, ConfigurationVersionsListCall{Ctx: ctx, WorkspaceID: workspaceID, Options: options})
+	fn := m.ListFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID, options)
+}
+
+// ConfigurationVersionsCreateCall holds the arguments of a single call to ConfigurationVersions.Create.
+type ConfigurationVersionsCreateCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+	Options     tfe.ConfigurationVersionCreateOptions
+}
+
+// Create is used to create a new configuration version. The created
+// configuration version will be usable once data is uploaded to it.
+func (m *ConfigurationVersions) Create(ctx context.Context, workspaceID string, options tfe.ConfigurationVersionCreateOptions) (r0 *tfe.ConfigurationVersion, r1 error) {
+	m.mu.Lock()
+	m.CreateCalls = append(m.CreateCalls, ConfigurationVersionsCreateCall{Ctx: ctx, WorkspaceID: workspaceID, Options: options})
+	fn := m.CreateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID, options)
+}
+
+// ConfigurationVersionsReadCall holds the arguments of a single call to ConfigurationVersions.Read.
+type ConfigurationVersionsReadCall struct {
+	Ctx  context.Context
+	CvID string
+}
+
+// Read a configuration version by its ID.
+func (m *ConfigurationVersions) Read(ctx context.Context, cvID string) (r0 *tfe.ConfigurationVersion, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, ConfigurationVersionsReadCall{Ctx: ctx, CvID: cvID})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, cvID)
+}
+
+// ConfigurationVersionsReadWithOptionsCall holds the arguments of a single call to ConfigurationVersions.ReadWithOptions.
+type ConfigurationVersionsReadWithOptionsCall struct {
+	Ctx     context.Context
+	CvID    string
+	Options tfe.ConfigurationVersionReadOptions
+}
+
+// ReadWithOptions reads a configuration version by its ID with the given
+// options.
+func (m *ConfigurationVersions) ReadWithOptions(ctx context.Context, cvID string, options tfe.ConfigurationVersionReadOptions) (r0 *tfe.ConfigurationVersion, r1 error) {
+	m.mu.Lock()
+	m.ReadWithOptionsCalls = append(m.ReadWithOptionsCalls, ConfigurationVersionsReadWithOptionsCall{Ctx: ctx, CvID: cvID, Options: options})
+	fn := m.ReadWithOptionsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, cvID, options)
+}
+
+// ConfigurationVersionsUploadCall holds the arguments of a single call to ConfigurationVersions.Upload.
+type ConfigurationVersionsUploadCall struct {
+	Ctx  context.Context
+	URL  string
+	Path string
+}
+
+// Upload packages and uploads Terraform configuration files. It requires
+// the upload URL from a configuration version and the full path to the
+// configuration files on disk.
+func (m *ConfigurationVersions) Upload(ctx context.Context, url string, path string) (r0 error) {
+	m.mu.Lock()
+	m.UploadCalls = append(m.UploadCalls, ConfigurationVersionsUploadCall{Ctx: ctx, URL: url, Path: path})
+	fn := m.UploadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, url, path)
+}
@@ -0,0 +1,71 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.CostEstimates = (*CostEstimates)(nil)
+
+// CostEstimates is a call-recording fake of tfe.CostEstimates.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type CostEstimates struct {
+	mu sync.Mutex
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, costEstimateID string) (*tfe.CostEstimate, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []CostEstimatesReadCall
+
+	// LogsFunc is invoked by Logs.
+	LogsFunc func(ctx context.Context, costEstimateID string) (io.Reader, error)
+	// LogsCalls records the arguments of every call to Logs.
+	LogsCalls []CostEstimatesLogsCall
+}
+
+// CostEstimatesReadCall holds the arguments of a single call to CostEstimates.Read.
+type CostEstimatesReadCall struct {
+	Ctx            context.Context
+	CostEstimateID string
+}
+
+// Read a costEstimate by its ID.
+func (m *CostEstimates) Read(ctx context.Context, costEstimateID string) (r0 *tfe.CostEstimate, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, CostEstimatesReadCall{Ctx: ctx, CostEstimateID: costEstimateID})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, costEstimateID)
+}
+
+// CostEstimatesLogsCall holds the arguments of a single call to CostEstimates.Logs.
+type CostEstimatesLogsCall struct {
+	Ctx            context.Context
+	CostEstimateID string
+}
+
+// Logs retrieves the logs of a costEstimate.
+func (m *CostEstimates) Logs(ctx context.Context, costEstimateID string) (r0 io.Reader, r1 error) {
+	m.mu.Lock()
+	m.LogsCalls = append(m.LogsCalls, CostEstimatesLogsCall{Ctx: ctx, CostEstimateID: costEstimateID})
+	fn := m.LogsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, costEstimateID)
+}
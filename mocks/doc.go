@@ -0,0 +1,20 @@
+// Package mocks provides call-recording fakes for all service interfaces
+// exposed by tfe.Client.
+//
+// The fakes are generated from the interfaces in the tfe package, so they
+// always match the current API of the client. Use NewClient to get a
+// *tfe.Client that is wired entirely with fakes:
+//
+//	client, fakes := mocks.NewClient()
+//	fakes.Workspaces.ReadFunc = func(ctx context.Context, organization, workspace string) (*tfe.Workspace, error) {
+//		return &tfe.Workspace{Name: workspace}, nil
+//	}
+//
+//	// Exercise the code under test using client...
+//
+//	if len(fakes.Workspaces.ReadCalls) != 1 {
+//		t.Fatal("expected a single call to Workspaces.Read")
+//	}
+package mocks
+
+//go:generate go run gen.go
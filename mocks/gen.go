@@ -0,0 +1,27 @@
+//go:build ignore
+// +build ignore
+
+// This program generates the fakes in this package. It is invoked by
+// running go generate in the mocks directory.
+package main
+
+import (
+	"io/ioutil"
+	"log"
+	"path/filepath"
+
+	"github.com/hashicorp/go-tfe/internal/mockgen"
+)
+
+func main() {
+	files, err := mockgen.Generate("..")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for name, src := range files {
+		if err := ioutil.WriteFile(filepath.Join(".", name), src, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
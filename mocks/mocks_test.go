@@ -0,0 +1,62 @@
+package mocks
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/go-tfe/internal/mockgen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMocks_upToDate(t *testing.T) {
+	files, err := mockgen.Generate("..")
+	require.NoError(t, err)
+
+	for name, src := range files {
+		current, err := ioutil.ReadFile(name)
+		if err != nil {
+			t.Errorf("missing generated file %s, run go generate", name)
+			continue
+		}
+		if !bytes.Equal(src, current) {
+			t.Errorf("generated file %s is out of date, run go generate", name)
+		}
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	client, fakes := NewClient()
+	ctx := context.Background()
+
+	t.Run("without a configured func", func(t *testing.T) {
+		w, err := client.Workspaces.ReadByID(ctx, "ws-123")
+		assert.Nil(t, w)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with a configured func", func(t *testing.T) {
+		fakes.Workspaces.ReadFunc = func(ctx context.Context, organization, workspace string) (*tfe.Workspace, error) {
+			return &tfe.Workspace{Name: workspace}, nil
+		}
+
+		w, err := client.Workspaces.Read(ctx, "my-org", "my-workspace")
+		require.NoError(t, err)
+		assert.Equal(t, "my-workspace", w.Name)
+	})
+
+	t.Run("records calls", func(t *testing.T) {
+		err := client.Runs.Apply(ctx, "run-123", tfe.RunApplyOptions{Comment: tfe.String("lgtm")})
+		require.NoError(t, err)
+
+		require.Len(t, fakes.Runs.ApplyCalls, 1)
+		assert.Equal(t, "run-123", fakes.Runs.ApplyCalls[0].RunID)
+		assert.Equal(t, "lgtm", *fakes.Runs.ApplyCalls[0].Options.Comment)
+
+		require.Len(t, fakes.Workspaces.ReadByIDCalls, 1)
+		assert.Equal(t, "ws-123", fakes.Workspaces.ReadByIDCalls[0].WorkspaceID)
+	})
+}
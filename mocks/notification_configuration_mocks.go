@@ -0,0 +1,169 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.NotificationConfigurations = (*NotificationConfigurations)(nil)
+
+// NotificationConfigurations is a call-recording fake of tfe.NotificationConfigurations.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type NotificationConfigurations struct {
+	mu sync.Mutex
+
+	// ListFunc is invoked by List.
+	ListFunc func(ctx context.Context, workspaceID string, options tfe.NotificationConfigurationListOptions) (*tfe.NotificationConfigurationList, error)
+	// ListCalls records the arguments of every call to List.
+	ListCalls []NotificationConfigurationsListCall
+
+	// CreateFunc is invoked by Create.
+	CreateFunc func(ctx context.Context, workspaceID string, options tfe.NotificationConfigurationCreateOptions) (*tfe.NotificationConfiguration, error)
+	// CreateCalls records the arguments of every call to Create.
+	CreateCalls []NotificationConfigurationsCreateCall
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, notificationConfigurationID string) (*tfe.NotificationConfiguration, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []NotificationConfigurationsReadCall
+
+	// UpdateFunc is invoked by Update.
+	UpdateFunc func(ctx context.Context, notificationConfigurationID string, options tfe.NotificationConfigurationUpdateOptions) (*tfe.NotificationConfiguration, error)
+	// UpdateCalls records the arguments of every call to Update.
+	UpdateCalls []NotificationConfigurationsUpdateCall
+
+	// DeleteFunc is invoked by Delete.
+	DeleteFunc func(ctx context.Context, notificationConfigurationID string) error
+	// DeleteCalls records the arguments of every call to Delete.
+	DeleteCalls []NotificationConfigurationsDeleteCall
+
+	// VerifyFunc is invoked by Verify.
+	VerifyFunc func(ctx context.Context, notificationConfigurationID string) (*tfe.NotificationConfiguration, error)
+	// VerifyCalls records the arguments of every call to Verify.
+	VerifyCalls []NotificationConfigurationsVerifyCall
+}
+
+// NotificationConfigurationsListCall holds the arguments of a single call to NotificationConfigurations.List.
+type NotificationConfigurationsListCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+	Options     tfe.NotificationConfigurationListOptions
+}
+
+// List all the notification configurations within a workspace.
+func (m *NotificationConfigurations) List(ctx context.Context, workspaceID string, options tfe.NotificationConfigurationListOptions) (r0 *tfe.NotificationConfigurationList, r1 error) {
+	m.mu.Lock()
+	m.ListCalls = append(m.ListCalls, NotificationConfigurationsListCall{Ctx: ctx, WorkspaceID: workspaceID, Options: options})
+	fn := m.ListFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID, options)
+}
+
+// NotificationConfigurationsCreateCall holds the arguments of a single call to NotificationConfigurations.Create.
+type NotificationConfigurationsCreateCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+	Options     tfe.NotificationConfigurationCreateOptions
+}
+
+// Create a new notification configuration with the given options.
+func (m *NotificationConfigurations) Create(ctx context.Context, workspaceID string, options tfe.NotificationConfigurationCreateOptions) (r0 *tfe.NotificationConfiguration, r1 error) {
+	m.mu.Lock()
+	m.CreateCalls = append(m.CreateCalls, NotificationConfigurationsCreateCall{Ctx: ctx, WorkspaceID: workspaceID, Options: options})
+	fn := m.CreateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID, options)
+}
+
+// NotificationConfigurationsReadCall holds the arguments of a single call to NotificationConfigurations.Read.
+type NotificationConfigurationsReadCall struct {
+	Ctx                         context.Context
+	NotificationConfigurationID string
+}
+
+// Read a notification configuration by its ID.
+func (m *NotificationConfigurations) Read(ctx context.Context, notificationConfigurationID string) (r0 *tfe.NotificationConfiguration, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, NotificationConfigurationsReadCall{Ctx: ctx, NotificationConfigurationID: notificationConfigurationID})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, notificationConfigurationID)
+}
+
+// NotificationConfigurationsUpdateCall holds the arguments of a single call to NotificationConfigurations.Update.
+type NotificationConfigurationsUpdateCall struct {
+	Ctx                         context.Context
+	NotificationConfigurationID string
+	Options                     tfe.NotificationConfigurationUpdateOptions
+}
+
+// Update an existing notification configuration.
+func (m *NotificationConfigurations) Update(ctx context.Context, notificationConfigurationID string, options tfe.NotificationConfigurationUpdateOptions) (r0 *tfe.NotificationConfiguration, r1 error) {
+	m.mu.Lock()
+	m.UpdateCalls = append(m.UpdateCalls, NotificationConfigurationsUpdateCall{Ctx: ctx, NotificationConfigurationID: notificationConfigurationID, Options: options})
+	fn := m.UpdateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, notificationConfigurationID, options)
+}
+
+// NotificationConfigurationsDeleteCall holds the arguments of a single call to NotificationConfigurations.Delete.
+type NotificationConfigurationsDeleteCall struct {
+	Ctx                         context.Context
+	NotificationConfigurationID string
+}
+
+// Delete a notification configuration by its ID.
+func (m *NotificationConfigurations) Delete(ctx context.Context, notificationConfigurationID string) (r0 error) {
+	m.mu.Lock()
+	m.DeleteCalls = append(m.DeleteCalls, NotificationConfigurationsDeleteCall{Ctx: ctx, NotificationConfigurationID: notificationConfigurationID})
+	fn := m.DeleteFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, notificationConfigurationID)
+}
+
+// NotificationConfigurationsVerifyCall holds the arguments of a single call to NotificationConfigurations.Verify.
+type NotificationConfigurationsVerifyCall struct {
+	Ctx                         context.Context
+	NotificationConfigurationID string
+}
+
+// Verify a notification configuration by its ID.
+func (m *NotificationConfigurations) Verify(ctx context.Context, notificationConfigurationID string) (r0 *tfe.NotificationConfiguration, r1 error) {
+	m.mu.Lock()
+	m.VerifyCalls = append(m.VerifyCalls, NotificationConfigurationsVerifyCall{Ctx: ctx, NotificationConfigurationID: notificationConfigurationID})
+	fn := m.VerifyFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, notificationConfigurationID)
+}
@@ -0,0 +1,120 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.OAuthClients = (*OAuthClients)(nil)
+
+// OAuthClients is a call-recording fake of tfe.OAuthClients.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type OAuthClients struct {
+	mu sync.Mutex
+
+	// ListFunc is invoked by List.
+	ListFunc func(ctx context.Context, organization string, options tfe.OAuthClientListOptions) (*tfe.OAuthClientList, error)
+	// ListCalls records the arguments of every call to List.
+	ListCalls []OAuthClientsListCall
+
+	// CreateFunc is invoked by Create.
+	CreateFunc func(ctx context.Context, organization string, options tfe.OAuthClientCreateOptions) (*tfe.OAuthClient, error)
+	// CreateCalls records the arguments of every call to Create.
+	CreateCalls []OAuthClientsCreateCall
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, oAuthClientID string) (*tfe.OAuthClient, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []OAuthClientsReadCall
+
+	// DeleteFunc is invoked by Delete.
+	DeleteFunc func(ctx context.Context, oAuthClientID string) error
+	// DeleteCalls records the arguments of every call to Delete.
+	DeleteCalls []OAuthClientsDeleteCall
+}
+
+// OAuthClientsListCall holds the arguments of a single call to OAuthClients.List.
+type OAuthClientsListCall struct {
+	Ctx          context.Context
+	Organization string
+	Options      tfe.OAuthClientListOptions
+}
+
+// List all the OAuth clients for a given organization.
+func (m *OAuthClients) List(ctx context.Context, organization string, options tfe.OAuthClientListOptions) (r0 *tfe.OAuthClientList, r1 error) {
+	m.mu.Lock()
+	m.ListCalls = append(m.ListCalls, OAuthClientsListCall{Ctx: ctx, Organization: organization, Options: options})
+	fn := m.ListFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, options)
+}
+
+// OAuthClientsCreateCall holds the arguments of a single call to OAuthClients.Create.
+type OAuthClientsCreateCall struct {
+	Ctx          context.Context
+	Organization string
+	Options      tfe.OAuthClientCreateOptions
+}
+
+// Create an OAuth client to connect an organization and a VCS provider.
+func (m *OAuthClients) Create(ctx context.Context, organization string, options tfe.OAuthClientCreateOptions) (r0 *tfe.OAuthClient, r1 error) {
+	m.mu.Lock()
+	m.CreateCalls = append(m.CreateCalls, OAuthClientsCreateCall{Ctx: ctx, Organization: organization, Options: options})
+	fn := m.CreateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, options)
+}
+
+// OAuthClientsReadCall holds the arguments of a single call to OAuthClients.Read.
+type OAuthClientsReadCall struct {
+	Ctx           context.Context
+	OAuthClientID string
+}
+
+// Read an OAuth client by its ID.
+func (m *OAuthClients) Read(ctx context.Context, oAuthClientID string) (r0 *tfe.OAuthClient, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, OAuthClientsReadCall{Ctx: ctx, OAuthClientID: oAuthClientID})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, oAuthClientID)
+}
+
+// OAuthClientsDeleteCall holds the arguments of a single call to OAuthClients.Delete.
+type OAuthClientsDeleteCall struct {
+	Ctx           context.Context
+	OAuthClientID string
+}
+
+// Delete an OAuth client by its ID.
+func (m *OAuthClients) Delete(ctx context.Context, oAuthClientID string) (r0 error) {
+	m.mu.Lock()
+	m.DeleteCalls = append(m.DeleteCalls, OAuthClientsDeleteCall{Ctx: ctx, OAuthClientID: oAuthClientID})
+	fn := m.DeleteFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, oAuthClientID)
+}
@@ -0,0 +1,120 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.OAuthTokens = (*OAuthTokens)(nil)
+
+// OAuthTokens is a call-recording fake of tfe.OAuthTokens.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type OAuthTokens struct {
+	mu sync.Mutex
+
+	// ListFunc is invoked by List.
+	ListFunc func(ctx context.Context, organization string, options tfe.OAuthTokenListOptions) (*tfe.OAuthTokenList, error)
+	// ListCalls records the arguments of every call to List.
+	ListCalls []OAuthTokensListCall
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, oAuthTokenID string) (*tfe.OAuthToken, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []OAuthTokensReadCall
+
+	// UpdateFunc is invoked by Update.
+	UpdateFunc func(ctx context.Context, oAuthTokenID string, options tfe.OAuthTokenUpdateOptions) (*tfe.OAuthToken, error)
+	// UpdateCalls records the arguments of every call to Update.
+	UpdateCalls []OAuthTokensUpdateCall
+
+	// DeleteFunc is invoked by Delete.
+	DeleteFunc func(ctx context.Context, oAuthTokenID string) error
+	// DeleteCalls records the arguments of every call to Delete.
+	DeleteCalls []OAuthTokensDeleteCall
+}
+
+// OAuthTokensListCall holds the arguments of a single call to OAuthTokens.List.
+type OAuthTokensListCall struct {
+	Ctx          context.Context
+	Organization string
+	Options      tfe.OAuthTokenListOptions
+}
+
+// List all the OAuth tokens for a given organization.
+func (m *OAuthTokens) List(ctx context.Context, organization string, options tfe.OAuthTokenListOptions) (r0 *tfe.OAuthTokenList, r1 error) {
+	m.mu.Lock()
+	m.ListCalls = append(m.ListCalls, OAuthTokensListCall{Ctx: ctx, Organization: organization, Options: options})
+	fn := m.ListFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, options)
+}
+
+// OAuthTokensReadCall holds the arguments of a single call to OAuthTokens.Read.
+type OAuthTokensReadCall struct {
+	Ctx          context.Context
+	OAuthTokenID string
+}
+
+// Read a OAuth token by its ID.
+func (m *OAuthTokens) Read(ctx context.Context, oAuthTokenID string) (r0 *tfe.OAuthToken, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, OAuthTokensReadCall{Ctx: ctx, OAuthTokenID: oAuthTokenID})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, oAuthTokenID)
+}
+
+// OAuthTokensUpdateCall holds the arguments of a single call to OAuthTokens.Update.
+type OAuthTokensUpdateCall struct {
+	Ctx          context.Context
+	OAuthTokenID string
+	Options      tfe.OAuthTokenUpdateOptions
+}
+
+// Update an existing OAuth token.
+func (m *OAuthTokens) Update(ctx context.Context, oAuthTokenID string, options tfe.OAuthTokenUpdateOptions) (r0 *tfe.OAuthToken, r1 error) {
+	m.mu.Lock()
+	m.UpdateCalls = append(m.UpdateCalls, OAuthTokensUpdateCall{Ctx: ctx, OAuthTokenID: oAuthTokenID, Options: options})
+	fn := m.UpdateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, oAuthTokenID, options)
+}
+
+// OAuthTokensDeleteCall holds the arguments of a single call to OAuthTokens.Delete.
+type OAuthTokensDeleteCall struct {
+	Ctx          context.Context
+	OAuthTokenID string
+}
+
+// Delete a OAuth token by its ID.
+func (m *OAuthTokens) Delete(ctx context.Context, oAuthTokenID string) (r0 error) {
+	m.mu.Lock()
+	m.DeleteCalls = append(m.DeleteCalls, OAuthTokensDeleteCall{Ctx: ctx, OAuthTokenID: oAuthTokenID})
+	fn := m.DeleteFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, oAuthTokenID)
+}
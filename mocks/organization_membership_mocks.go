@@ -0,0 +1,145 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.OrganizationMemberships = (*OrganizationMemberships)(nil)
+
+// OrganizationMemberships is a call-recording fake of tfe.OrganizationMemberships.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type OrganizationMemberships struct {
+	mu sync.Mutex
+
+	// ListFunc is invoked by List.
+	ListFunc func(ctx context.Context, organization string, options tfe.OrganizationMembershipListOptions) (*tfe.OrganizationMembershipList, error)
+	// ListCalls records the arguments of every call to List.
+	ListCalls []OrganizationMembershipsListCall
+
+	// CreateFunc is invoked by Create.
+	CreateFunc func(ctx context.Context, organization string, options tfe.OrganizationMembershipCreateOptions) (*tfe.OrganizationMembership, error)
+	// CreateCalls records the arguments of every call to Create.
+	CreateCalls []OrganizationMembershipsCreateCall
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, organizationMembershipID string) (*tfe.OrganizationMembership, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []OrganizationMembershipsReadCall
+
+	// ReadWithOptionsFunc is invoked by ReadWithOptions.
+	ReadWithOptionsFunc func(ctx context.Context, organizationMembershipID string, options tfe.OrganizationMembershipReadOptions) (*tfe.OrganizationMembership, error)
+	// ReadWithOptionsCalls records the arguments of every call to ReadWithOptions.
+	ReadWithOptionsCalls []OrganizationMembershipsReadWithOptionsCall
+
+	// DeleteFunc is invoked by Delete.
+	DeleteFunc func(ctx context.Context, organizationMembershipID string) error
+	// DeleteCalls records the arguments of every call to Delete.
+	DeleteCalls []OrganizationMembershipsDeleteCall
+}
+
+// OrganizationMembershipsListCall holds the arguments of a single call to OrganizationMemberships.List.
+type OrganizationMembershipsListCall struct {
+	Ctx          context.Context
+	Organization string
+	Options      tfe.OrganizationMembershipListOptions
+}
+
+// List all the organization memberships of the given organization.
+func (m *OrganizationMemberships) List(ctx context.Context, organization string, options tfe.OrganizationMembershipListOptions) (r0 *tfe.OrganizationMembershipList, r1 error) {
+	m.mu.Lock()
+	m.ListCalls = append(m.ListCalls, OrganizationMembershipsListCall{Ctx: ctx, Organization: organization, Options: options})
+	fn := m.ListFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, options)
+}
+
+// OrganizationMembershipsCreateCall holds the arguments of a single call to OrganizationMemberships.Create.
+type OrganizationMembershipsCreateCall struct {
+	Ctx          context.Context
+	Organization string
+	Options      tfe.OrganizationMembershipCreateOptions
+}
+
+// Create a new organization membership with the given options.
+func (m *OrganizationMemberships) Create(ctx context.Context, organization string, options tfe.OrganizationMembershipCreateOptions) (r0 *tfe.OrganizationMembership, r1 error) {
+	m.mu.Lock()
+	m.CreateCalls = append(m.CreateCalls, OrganizationMembershipsCreateCall{Ctx: ctx, Organization: organization, Options: options})
+	fn := m.CreateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, options)
+}
+
+// OrganizationMembershipsReadCall holds the arguments of a single call to OrganizationMemberships.Read.
+type OrganizationMembershipsReadCall struct {
+	Ctx                      context.Context
+	OrganizationMembershipID string
+}
+
+// Read an organization membership by ID
+func (m *OrganizationMemberships) Read(ctx context.Context, organizationMembershipID string) (r0 *tfe.OrganizationMembership, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, OrganizationMembershipsReadCall{Ctx: ctx, OrganizationMembershipID: organizationMembershipID})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organizationMembershipID)
+}
+
+// OrganizationMembershipsReadWithOptionsCall holds the arguments of a single call to OrganizationMemberships.ReadWithOptions.
+type OrganizationMembershipsReadWithOptionsCall struct {
+	Ctx                      context.Context
+	OrganizationMembershipID string
+	Options                  tfe.OrganizationMembershipReadOptions
+}
+
+// Read an organization membership by ID with options
+func (m *OrganizationMemberships) ReadWithOptions(ctx context.Context, organizationMembershipID string, options tfe.OrganizationMembershipReadOptions) (r0 *tfe.OrganizationMembership, r1 error) {
+	m.mu.Lock()
+	m.ReadWithOptionsCalls = append(m.ReadWithOptionsCalls, OrganizationMembershipsReadWithOptionsCall{Ctx: ctx, OrganizationMembershipID: organizationMembershipID, Options: options})
+	fn := m.ReadWithOptionsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organizationMembershipID, options)
+}
+
+// OrganizationMembershipsDeleteCall holds the arguments of a single call to OrganizationMemberships.Delete.
+type OrganizationMembershipsDeleteCall struct {
+	Ctx                      context.Context
+	OrganizationMembershipID string
+}
+
+// Delete an organization membership by its ID.
+func (m *OrganizationMemberships) Delete(ctx context.Context, organizationMembershipID string) (r0 error) {
+	m.mu.Lock()
+	m.DeleteCalls = append(m.DeleteCalls, OrganizationMembershipsDeleteCall{Ctx: ctx, OrganizationMembershipID: organizationMembershipID})
+	fn := m.DeleteFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organizationMembershipID)
+}
@@ -0,0 +1,216 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.Organizations = (*Organizations)(nil)
+
+// Organizations is a call-recording fake of tfe.Organizations.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type Organizations struct {
+	mu sync.Mutex
+
+	// ListFunc is invoked by List.
+	ListFunc func(ctx context.Context, options tfe.OrganizationListOptions) (*tfe.OrganizationList, error)
+	// ListCalls records the arguments of every call to List.
+	ListCalls []OrganizationsListCall
+
+	// CreateFunc is invoked by Create.
+	CreateFunc func(ctx context.Context, options tfe.OrganizationCreateOptions) (*tfe.Organization, error)
+	// CreateCalls records the arguments of every call to Create.
+	CreateCalls []OrganizationsCreateCall
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, organization string) (*tfe.Organization, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []OrganizationsReadCall
+
+	// UpdateFunc is invoked by Update.
+	UpdateFunc func(ctx context.Context, organization string, options tfe.OrganizationUpdateOptions) (*tfe.Organization, error)
+	// UpdateCalls records the arguments of every call to Update.
+	UpdateCalls []OrganizationsUpdateCall
+
+	// DeleteFunc is invoked by Delete.
+	DeleteFunc func(ctx context.Context, organization string) error
+	// DeleteCalls records the arguments of every call to Delete.
+	DeleteCalls []OrganizationsDeleteCall
+
+	// CapacityFunc is invoked by Capacity.
+	CapacityFunc func(ctx context.Context, organization string) (*tfe.Capacity, error)
+	// CapacityCalls records the arguments of every call to Capacity.
+	CapacityCalls []OrganizationsCapacityCall
+
+	// EntitlementsFunc is invoked by Entitlements.
+	EntitlementsFunc func(ctx context.Context, organization string) (*tfe.Entitlements, error)
+	// EntitlementsCalls records the arguments of every call to Entitlements.
+	EntitlementsCalls []OrganizationsEntitlementsCall
+
+	// RunQueueFunc is invoked by RunQueue.
+	RunQueueFunc func(ctx context.Context, organization string, options tfe.RunQueueOptions) (*tfe.RunQueue, error)
+	// RunQueueCalls records the arguments of every call to RunQueue.
+	RunQueueCalls []OrganizationsRunQueueCall
+}
+
+// OrganizationsListCall holds the arguments of a single call to Organizations.List.
+type OrganizationsListCall struct {
+	Ctx     context.Context
+	Options tfe.OrganizationListOptions
+}
+
+// List all the organizations visible to the current user.
+func (m *Organizations) List(ctx context.Context, options tfe.OrganizationListOptions) (r0 *tfe.OrganizationList, r1 error) {
+	m.mu.Lock()
+	m.ListCalls = append(m.ListCalls, OrganizationsListCall{Ctx: ctx, Options: options})
+	fn := m.ListFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, options)
+}
+
+// OrganizationsCreateCall holds the arguments of a single call to Organizations.Create.
+type OrganizationsCreateCall struct {
+	Ctx     context.Context
+	Options tfe.OrganizationCreateOptions
+}
+
+// Create a new organization with the given options.
+func (m *Organizations) Create(ctx context.Context, options tfe.OrganizationCreateOptions) (r0 *tfe.Organization, r1 error) {
+	m.mu.Lock()
+	m.CreateCalls = append(m.CreateCalls, OrganizationsCreateCall{Ctx: ctx, Options: options})
+	fn := m.CreateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, options)
+}
+
+// OrganizationsReadCall holds the arguments of a single call to Organizations.Read.
+type OrganizationsReadCall struct {
+	Ctx          context.Context
+	Organization string
+}
+
+// Read an organization by its name.
+func (m *Organizations) Read(ctx context.Context, organization string) (r0 *tfe.Organization, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, OrganizationsReadCall{Ctx: ctx, Organization: organization})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization)
+}
+
+// OrganizationsUpdateCall holds the arguments of a single call to Organizations.Update.
+type OrganizationsUpdateCall struct {
+	Ctx          context.Context
+	Organization string
+	Options      tfe.OrganizationUpdateOptions
+}
+
+// Update attributes of an existing organization.
+func (m *Organizations) Update(ctx context.Context, organization string, options tfe.OrganizationUpdateOptions) (r0 *tfe.Organization, r1 error) {
+	m.mu.Lock()
+	m.UpdateCalls = append(m.UpdateCalls, OrganizationsUpdateCall{Ctx: ctx, Organization: organization, Options: options})
+	fn := m.UpdateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, options)
+}
+
+// OrganizationsDeleteCall holds the arguments of a single call to Organizations.Delete.
+type OrganizationsDeleteCall struct {
+	Ctx          context.Context
+	Organization string
+}
+
+// Delete an organization by its name.
+func (m *Organizations) Delete(ctx context.Context, organization string) (r0 error) {
+	m.mu.Lock()
+	m.DeleteCalls = append(m.DeleteCalls, OrganizationsDeleteCall{Ctx: ctx, Organization: organization})
+	fn := m.DeleteFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization)
+}
+
+// OrganizationsCapacityCall holds the arguments of a single call to Organizations.Capacity.
+type OrganizationsCapacityCall struct {
+	Ctx          context.Context
+	Organization string
+}
+
+// Capacity shows the current run capacity of an organization.
+func (m *Organizations) Capacity(ctx context.Context, organization string) (r0 *tfe.Capacity, r1 error) {
+	m.mu.Lock()
+	m.CapacityCalls = append(m.CapacityCalls, OrganizationsCapacityCall{Ctx: ctx, Organization: organization})
+	fn := m.CapacityFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization)
+}
+
+// OrganizationsEntitlementsCall holds the arguments of a single call to Organizations.Entitlements.
+type OrganizationsEntitlementsCall struct {
+	Ctx          context.Context
+	Organization string
+}
+
+// Entitlements shows the entitlements of an organization.
+func (m *Organizations) Entitlements(ctx context.Context, organization string) (r0 *tfe.Entitlements, r1 error) {
+	m.mu.Lock()
+	m.EntitlementsCalls = append(m.EntitlementsCalls, OrganizationsEntitlementsCall{Ctx: ctx, Organization: organization})
+	fn := m.EntitlementsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization)
+}
+
+// OrganizationsRunQueueCall holds the arguments of a single call to Organizations.RunQueue.
+type OrganizationsRunQueueCall struct {
+	Ctx          context.Context
+	Organization string
+	Options      tfe.RunQueueOptions
+}
+
+// RunQueue shows the current run queue of an organization.
+func (m *Organizations) RunQueue(ctx context.Context, organization string, options tfe.RunQueueOptions) (r0 *tfe.RunQueue, r1 error) {
+	m.mu.Lock()
+	m.RunQueueCalls = append(m.RunQueueCalls, OrganizationsRunQueueCall{Ctx: ctx, Organization: organization, Options: options})
+	fn := m.RunQueueFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, options)
+}
@@ -0,0 +1,94 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.OrganizationTokens = (*OrganizationTokens)(nil)
+
+// OrganizationTokens is a call-recording fake of tfe.OrganizationTokens.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type OrganizationTokens struct {
+	mu sync.Mutex
+
+	// GenerateFunc is invoked by Generate.
+	GenerateFunc func(ctx context.Context, organization string) (*tfe.OrganizationToken, error)
+	// GenerateCalls records the arguments of every call to Generate.
+	GenerateCalls []OrganizationTokensGenerateCall
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, organization string) (*tfe.OrganizationToken, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []OrganizationTokensReadCall
+
+	// DeleteFunc is invoked by Delete.
+	DeleteFunc func(ctx context.Context, organization string) error
+	// DeleteCalls records the arguments of every call to Delete.
+	DeleteCalls []OrganizationTokensDeleteCall
+}
+
+// OrganizationTokensGenerateCall holds the arguments of a single call to OrganizationTokens.Generate.
+type OrganizationTokensGenerateCall struct {
+	Ctx          context.Context
+	Organization string
+}
+
+// Generate a new organization token, replacing any existing token.
+func (m *OrganizationTokens) Generate(ctx context.Context, organization string) (r0 *tfe.OrganizationToken, r1 error) {
+	m.mu.Lock()
+	m.GenerateCalls = append(m.GenerateCalls, OrganizationTokensGenerateCall{Ctx: ctx, Organization: organization})
+	fn := m.GenerateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization)
+}
+
+// OrganizationTokensReadCall holds the arguments of a single call to OrganizationTokens.Read.
+type OrganizationTokensReadCall struct {
+	Ctx          context.Context
+	Organization string
+}
+
+// Read an organization token.
+func (m *OrganizationTokens) Read(ctx context.Context, organization string) (r0 *tfe.OrganizationToken, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, OrganizationTokensReadCall{Ctx: ctx, Organization: organization})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization)
+}
+
+// OrganizationTokensDeleteCall holds the arguments of a single call to OrganizationTokens.Delete.
+type OrganizationTokensDeleteCall struct {
+	Ctx          context.Context
+	Organization string
+}
+
+// Delete an organization token.
+func (m *OrganizationTokens) Delete(ctx context.Context, organization string) (r0 error) {
+	m.mu.Lock()
+	m.DeleteCalls = append(m.DeleteCalls, OrganizationTokensDeleteCall{Ctx: ctx, Organization: organization})
+	fn := m.DeleteFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization)
+}
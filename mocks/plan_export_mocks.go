@@ -0,0 +1,118 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.PlanExports = (*PlanExports)(nil)
+
+// PlanExports is a call-recording fake of tfe.PlanExports.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type PlanExports struct {
+	mu sync.Mutex
+
+	// CreateFunc is invoked by Create.
+	CreateFunc func(ctx context.Context, options tfe.PlanExportCreateOptions) (*tfe.PlanExport, error)
+	// CreateCalls records the arguments of every call to Create.
+	CreateCalls []PlanExportsCreateCall
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, planExportID string) (*tfe.PlanExport, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []PlanExportsReadCall
+
+	// DeleteFunc is invoked by Delete.
+	DeleteFunc func(ctx context.Context, planExportID string) error
+	// DeleteCalls records the arguments of every call to Delete.
+	DeleteCalls []PlanExportsDeleteCall
+
+	// DownloadFunc is invoked by Download.
+	DownloadFunc func(ctx context.Context, planExportID string) ([]byte, error)
+	// DownloadCalls records the arguments of every call to Download.
+	DownloadCalls []PlanExportsDownloadCall
+}
+
+// PlanExportsCreateCall holds the arguments of a single call to PlanExports.Create.
+type PlanExportsCreateCall struct {
+	Ctx     context.Context
+	Options tfe.PlanExportCreateOptions
+}
+
+// Export a plan by its ID with the given options.
+func (m *PlanExports) Create(ctx context.Context, options tfe.PlanExportCreateOptions) (r0 *tfe.PlanExport, r1 error) {
+	m.mu.Lock()
+	m.CreateCalls = append(m.CreateCalls, PlanExportsCreateCall{Ctx: ctx, Options: options})
+	fn := m.CreateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, options)
+}
+
+// PlanExportsReadCall holds the arguments of a single call to PlanExports.Read.
+type PlanExportsReadCall struct {
+	Ctx          context.Context
+	PlanExportID string
+}
+
+// Read a plan export by its ID.
+func (m *PlanExports) Read(ctx context.Context, planExportID string) (r0 *tfe.PlanExport, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, PlanExportsReadCall{Ctx: ctx, PlanExportID: planExportID})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, planExportID)
+}
+
+// PlanExportsDeleteCall holds the arguments of a single call to PlanExports.Delete.
+type PlanExportsDeleteCall struct {
+	Ctx          context.Context
+	PlanExportID string
+}
+
+// Delete a plan export by its ID.
+func (m *PlanExports) Delete(ctx context.Context, planExportID string) (r0 error) {
+	m.mu.Lock()
+	m.DeleteCalls = append(m.DeleteCalls, PlanExportsDeleteCall{Ctx: ctx, PlanExportID: planExportID})
+	fn := m.DeleteFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, planExportID)
+}
+
+// PlanExportsDownloadCall holds the arguments of a single call to PlanExports.Download.
+type PlanExportsDownloadCall struct {
+	Ctx          context.Context
+	PlanExportID string
+}
+
+// Download the data of an plan export.
+func (m *PlanExports) Download(ctx context.Context, planExportID string) (r0 []byte, r1 error) {
+	m.mu.Lock()
+	m.DownloadCalls = append(m.DownloadCalls, PlanExportsDownloadCall{Ctx: ctx, PlanExportID: planExportID})
+	fn := m.DownloadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, planExportID)
+}
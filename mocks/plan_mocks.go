@@ -0,0 +1,71 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.Plans = (*Plans)(nil)
+
+// Plans is a call-recording fake of tfe.Plans.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type Plans struct {
+	mu sync.Mutex
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, planID string) (*tfe.Plan, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []PlansReadCall
+
+	// LogsFunc is invoked by Logs.
+	LogsFunc func(ctx context.Context, planID string) (io.Reader, error)
+	// LogsCalls records the arguments of every call to Logs.
+	LogsCalls []PlansLogsCall
+}
+
+// PlansReadCall holds the arguments of a single call to Plans.Read.
+type PlansReadCall struct {
+	Ctx    context.Context
+	PlanID string
+}
+
+// Read a plan by its ID.
+func (m *Plans) Read(ctx context.Context, planID string) (r0 *tfe.Plan, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, PlansReadCall{Ctx: ctx, PlanID: planID})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, planID)
+}
+
+// PlansLogsCall holds the arguments of a single call to Plans.Logs.
+type PlansLogsCall struct {
+	Ctx    context.Context
+	PlanID string
+}
+
+// Logs retrieves the logs of a plan.
+func (m *Plans) Logs(ctx context.Context, planID string) (r0 io.Reader, r1 error) {
+	m.mu.Lock()
+	m.LogsCalls = append(m.LogsCalls, PlansLogsCall{Ctx: ctx, PlanID: planID})
+	fn := m.LogsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, planID)
+}
@@ -0,0 +1,120 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.PolicyChecks = (*PolicyChecks)(nil)
+
+// PolicyChecks is a call-recording fake of tfe.PolicyChecks.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type PolicyChecks struct {
+	mu sync.Mutex
+
+	// ListFunc is invoked by List.
+	ListFunc func(ctx context.Context, runID string, options tfe.PolicyCheckListOptions) (*tfe.PolicyCheckList, error)
+	// ListCalls records the arguments of every call to List.
+	ListCalls []PolicyChecksListCall
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, policyCheckID string) (*tfe.PolicyCheck, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []PolicyChecksReadCall
+
+	// OverrideFunc is invoked by Override.
+	OverrideFunc func(ctx context.Context, policyCheckID string) (*tfe.PolicyCheck, error)
+	// OverrideCalls records the arguments of every call to Override.
+	OverrideCalls []PolicyChecksOverrideCall
+
+	// LogsFunc is invoked by Logs.
+	LogsFunc func(ctx context.Context, policyCheckID string) (io.Reader, error)
+	// LogsCalls records the arguments of every call to Logs.
+	LogsCalls []PolicyChecksLogsCall
+}
+
+// PolicyChecksListCall holds the arguments of a single call to PolicyChecks.List.
+type PolicyChecksListCall struct {
+	Ctx     context.Context
+	RunID   string
+	Options tfe.PolicyCheckListOptions
+}
+
+// List all policy checks of the given run.
+func (m *PolicyChecks) List(ctx context.Context, runID string, options tfe.PolicyCheckListOptions) (r0 *tfe.PolicyCheckList, r1 error) {
+	m.mu.Lock()
+	m.ListCalls = append(m.ListCalls, PolicyChecksListCall{Ctx: ctx, RunID: runID, Options: options})
+	fn := m.ListFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, runID, options)
+}
+
+// PolicyChecksReadCall holds the arguments of a single call to PolicyChecks.Read.
+type PolicyChecksReadCall struct {
+	Ctx           context.Context
+	PolicyCheckID string
+}
+
+// Read a policy check by its ID.
+func (m *PolicyChecks) Read(ctx context.Context, policyCheckID string) (r0 *tfe.PolicyCheck, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, PolicyChecksReadCall{Ctx: ctx, PolicyCheckID: policyCheckID})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, policyCheckID)
+}
+
+// PolicyChecksOverrideCall holds the arguments of a single call to PolicyChecks.Override.
+type PolicyChecksOverrideCall struct {
+	Ctx           context.Context
+	PolicyCheckID string
+}
+
+// Override a soft-mandatory or warning policy.
+func (m *PolicyChecks) Override(ctx context.Context, policyCheckID string) (r0 *tfe.PolicyCheck, r1 error) {
+	m.mu.Lock()
+	m.OverrideCalls = append(m.OverrideCalls, PolicyChecksOverrideCall{Ctx: ctx, PolicyCheckID: policyCheckID})
+	fn := m.OverrideFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, policyCheckID)
+}
+
+// PolicyChecksLogsCall holds the arguments of a single call to PolicyChecks.Logs.
+type PolicyChecksLogsCall struct {
+	Ctx           context.Context
+	PolicyCheckID string
+}
+
+// Logs retrieves the logs of a policy check.
+func (m *PolicyChecks) Logs(ctx context.Context, policyCheckID string) (r0 io.Reader, r1 error) {
+	m.mu.Lock()
+	m.LogsCalls = append(m.LogsCalls, PolicyChecksLogsCall{Ctx: ctx, PolicyCheckID: policyCheckID})
+	fn := m.LogsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, policyCheckID)
+}
@@ -0,0 +1,194 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.Policies = (*Policies)(nil)
+
+// Policies is a call-recording fake of tfe.Policies.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type Policies struct {
+	mu sync.Mutex
+
+	// ListFunc is invoked by List.
+	ListFunc func(ctx context.Context, organization string, options tfe.PolicyListOptions) (*tfe.PolicyList, error)
+	// ListCalls records the arguments of every call to List.
+	ListCalls []PoliciesListCall
+
+	// CreateFunc is invoked by Create.
+	CreateFunc func(ctx context.Context, organization string, options tfe.PolicyCreateOptions) (*tfe.Policy, error)
+	// CreateCalls records the arguments of every call to Create.
+	CreateCalls []PoliciesCreateCall
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, policyID string) (*tfe.Policy, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []PoliciesReadCall
+
+	// UpdateFunc is invoked by Update.
+	UpdateFunc func(ctx context.Context, policyID string, options tfe.PolicyUpdateOptions) (*tfe.Policy, error)
+	// UpdateCalls records the arguments of every call to Update.
+	UpdateCalls []PoliciesUpdateCall
+
+	// DeleteFunc is invoked by Delete.
+	DeleteFunc func(ctx context.Context, policyID string) error
+	// DeleteCalls records the arguments of every call to Delete.
+	DeleteCalls []PoliciesDeleteCall
+
+	// UploadFunc is invoked by Upload.
+	UploadFunc func(ctx context.Context, policyID string, content []byte) error
+	// UploadCalls records the arguments of every call to Upload.
+	UploadCalls []PoliciesUploadCall
+
+	// DownloadFunc is invoked by Download.
+	DownloadFunc func(ctx context.Context, policyID string) ([]byte, error)
+	// DownloadCalls records the arguments of every call to Download.
+	DownloadCalls []PoliciesDownloadCall
+}
+
+// PoliciesListCall holds the arguments of a single call to Policies.List.
+type PoliciesListCall struct {
+	Ctx          context.Context
+	Organization string
+	Options      tfe.PolicyListOptions
+}
+
+// List all the policies for a given organization
+func (m *Policies) List(ctx context.Context, organization string, options tfe.PolicyListOptions) (r0 *tfe.PolicyList, r1 error) {
+	m.mu.Lock()
+	m.ListCalls = append(m.ListCalls, PoliciesListCall{Ctx: ctx, Organization: organization, Options: options})
+	fn := m.ListFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, options)
+}
+
+// PoliciesCreateCall holds the arguments of a single call to Policies.Create.
+type PoliciesCreateCall struct {
+	Ctx          context.Context
+	Organization string
+	Options      tfe.PolicyCreateOptions
+}
+
+// Create a policy and associate it with an organization.
+func (m *Policies) Create(ctx context.Context, organization string, options tfe.PolicyCreateOptions) (r0 *tfe.Policy, r1 error) {
+	m.mu.Lock()
+	m.CreateCalls = append(m.CreateCalls, PoliciesCreateCall{Ctx: ctx, Organization: organization, Options: options})
+	fn := m.CreateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, options)
+}
+
+// PoliciesReadCall holds the arguments of a single call to Policies.Read.
+type PoliciesReadCall struct {
+	Ctx      context.Context
+	PolicyID string
+}
+
+// Read a policy by its ID.
+func (m *Policies) Read(ctx context.Context, policyID string) (r0 *tfe.Policy, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, PoliciesReadCall{Ctx: ctx, PolicyID: policyID})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, policyID)
+}
+
+// PoliciesUpdateCall holds the arguments of a single call to Policies.Update.
+type PoliciesUpdateCall struct {
+	Ctx      context.Context
+	PolicyID string
+	Options  tfe.PolicyUpdateOptions
+}
+
+// Update an existing policy.
+func (m *Policies) Update(ctx context.Context, policyID string, options tfe.PolicyUpdateOptions) (r0 *tfe.Policy, r1 error) {
+	m.mu.Lock()
+	m.UpdateCalls = append(m.UpdateCalls, PoliciesUpdateCall{Ctx: ctx, PolicyID: policyID, Options: options})
+	fn := m.UpdateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, policyID, options)
+}
+
+// PoliciesDeleteCall holds the arguments of a single call to Policies.Delete.
+type PoliciesDeleteCall struct {
+	Ctx      context.Context
+	PolicyID string
+}
+
+// Delete a policy by its ID.
+func (m *Policies) Delete(ctx context.Context, policyID string) (r0 error) {
+	m.mu.Lock()
+	m.DeleteCalls = append(m.DeleteCalls, PoliciesDeleteCall{Ctx: ctx, PolicyID: policyID})
+	fn := m.DeleteFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, policyID)
+}
+
+// PoliciesUploadCall holds the arguments of a single call to Policies.Upload.
+type PoliciesUploadCall struct {
+	Ctx      context.Context
+	PolicyID string
+	Content  []byte
+}
+
+// Upload the policy content of the policy.
+func (m *Policies) Upload(ctx context.Context, policyID string, content []byte) (r0 error) {
+	m.mu.Lock()
+	m.UploadCalls = append(m.UploadCalls, PoliciesUploadCall{Ctx: ctx, PolicyID: policyID, Content: content})
+	fn := m.UploadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, policyID, content)
+}
+
+// PoliciesDownloadCall holds the arguments of a single call to Policies.Download.
+type PoliciesDownloadCall struct {
+	Ctx      context.Context
+	PolicyID string
+}
+
+// Upload the policy content of the policy.
+func (m *Policies) Download(ctx context.Context, policyID string) (r0 []byte, r1 error) {
+	m.mu.Lock()
+	m.DownloadCalls = append(m.DownloadCalls, PoliciesDownloadCall{Ctx: ctx, PolicyID: policyID})
+	fn := m.DownloadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, policyID)
+}
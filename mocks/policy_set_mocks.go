@@ -0,0 +1,272 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.PolicySets = (*PolicySets)(nil)
+
+// PolicySets is a call-recording fake of tfe.PolicySets.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type PolicySets struct {
+	mu sync.Mutex
+
+	// ListFunc is invoked by List.
+	ListFunc func(ctx context.Context, organization string, options tfe.PolicySetListOptions) (*tfe.PolicySetList, error)
+	// ListCalls records the arguments of every call to List.
+	ListCalls []PolicySetsListCall
+
+	// CreateFunc is invoked by Create.
+	CreateFunc func(ctx context.Context, organization string, options tfe.PolicySetCreateOptions) (*tfe.PolicySet, error)
+	// CreateCalls records the arguments of every call to Create.
+	CreateCalls []PolicySetsCreateCall
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, policySetID string) (*tfe.PolicySet, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []PolicySetsReadCall
+
+	// ReadWithOptionsFunc is invoked by ReadWithOptions.
+	ReadWithOptionsFunc func(ctx context.Context, policySetID string, options tfe.PolicySetReadOptions) (*tfe.PolicySet, error)
+	// ReadWithOptionsCalls records the arguments of every call to ReadWithOptions.
+	ReadWithOptionsCalls []PolicySetsReadWithOptionsCall
+
+	// UpdateFunc is invoked by Update.
+	UpdateFunc func(ctx context.Context, policySetID string, options tfe.PolicySetUpdateOptions) (*tfe.PolicySet, error)
+	// UpdateCalls records the arguments of every call to Update.
+	UpdateCalls []PolicySetsUpdateCall
+
+	// AddPoliciesFunc is invoked by AddPolicies.
+	AddPoliciesFunc func(ctx context.Context, policySetID string, options tfe.PolicySetAddPoliciesOptions) error
+	// AddPoliciesCalls records the arguments of every call to AddPolicies.
+	AddPoliciesCalls []PolicySetsAddPoliciesCall
+
+	// RemovePoliciesFunc is invoked by RemovePolicies.
+	RemovePoliciesFunc func(ctx context.Context, policySetID string, options tfe.PolicySetRemovePoliciesOptions) error
+	// RemovePoliciesCalls records the arguments of every call to RemovePolicies.
+	RemovePoliciesCalls []PolicySetsRemovePoliciesCall
+
+	// AddWorkspacesFunc is invoked by AddWorkspaces.
+	AddWorkspacesFunc func(ctx context.Context, policySetID string, options tfe.PolicySetAddWorkspacesOptions) error
+	// AddWorkspacesCalls records the arguments of every call to AddWorkspaces.
+	AddWorkspacesCalls []PolicySetsAddWorkspacesCall
+
+	// RemoveWorkspacesFunc is invoked by RemoveWorkspaces.
+	RemoveWorkspacesFunc func(ctx context.Context, policySetID string, options tfe.PolicySetRemoveWorkspacesOptions) error
+	// RemoveWorkspacesCalls records the arguments of every call to RemoveWorkspaces.
+	RemoveWorkspacesCalls []PolicySetsRemoveWorkspacesCall
+
+	// DeleteFunc is invoked by Delete.
+	DeleteFunc func(ctx context.Context, policyID string) error
+	// DeleteCalls records the arguments of every call to Delete.
+	DeleteCalls []PolicySetsDeleteCall
+}
+
+// PolicySetsListCall holds the arguments of a single call to PolicySets.List.
+type PolicySetsListCall struct {
+	Ctx          context.Context
+	Organization string
+	Options      tfe.PolicySetListOptions
+}
+
+// List all the policy sets for a given organization.
+func (m *PolicySets) List(ctx context.Context, organization string, options tfe.PolicySetListOptions) (r0 *tfe.PolicySetList, r1 error) {
+	m.mu.Lock()
+	m.ListCalls = append(m.ListCalls, PolicySetsListCall{Ctx: ctx, Organization: organization, Options: options})
+	fn := m.ListFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, options)
+}
+
+// PolicySetsCreateCall holds the arguments of a single call to PolicySets.Create.
+type PolicySetsCreateCall struct {
+	Ctx          context.Context
+	Organization string
+	Options      tfe.PolicySetCreateOptions
+}
+
+// Create a policy set and associate it with an organization.
+func (m *PolicySets) Create(ctx context.Context, organization string, options tfe.PolicySetCreateOptions) (r0 *tfe.PolicySet, r1 error) {
+	m.mu.Lock()
+	m.CreateCalls = append(m.CreateCalls, PolicySetsCreateCall{Ctx: ctx, Organization: organization, Options: options})
+	fn := m.CreateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, options)
+}
+
+// PolicySetsReadCall holds the arguments of a single call to PolicySets.Read.
+type PolicySetsReadCall struct {
+	Ctx         context.Context
+	PolicySetID string
+}
+
+// Read a policy set by its ID.
+func (m *PolicySets) Read(ctx context.Context, policySetID string) (r0 *tfe.PolicySet, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, PolicySetsReadCall{Ctx: ctx, PolicySetID: policySetID})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, policySetID)
+}
+
+// PolicySetsReadWithOptionsCall holds the arguments of a single call to PolicySets.ReadWithOptions.
+type PolicySetsReadWithOptionsCall struct {
+	Ctx         context.Context
+	PolicySetID string
+	Options     tfe.PolicySetReadOptions
+}
+
+// ReadWithOptions reads a policy set by its ID with the given options.
+func (m *PolicySets) ReadWithOptions(ctx context.Context, policySetID string, options tfe.PolicySetReadOptions) (r0 *tfe.PolicySet, r1 error) {
+	m.mu.Lock()
+	m.ReadWithOptionsCalls = append(m.ReadWithOptionsCalls, PolicySetsReadWithOptionsCall{Ctx: ctx, PolicySetID: policySetID, Options: options})
+	fn := m.ReadWithOptionsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, policySetID, options)
+}
+
+// PolicySetsUpdateCall holds the arguments of a single call to PolicySets.Update.
+type PolicySetsUpdateCall struct {
+	Ctx         context.Context
+	PolicySetID string
+	Options     tfe.PolicySetUpdateOptions
+}
+
+// Update an existing policy set.
+func (m *PolicySets) Update(ctx context.Context, policySetID string, options tfe.PolicySetUpdateOptions) (r0 *tfe.PolicySet, r1 error) {
+	m.mu.Lock()
+	m.UpdateCalls = append(m.UpdateCalls, PolicySetsUpdateCall{Ctx: ctx, PolicySetID: policySetID, Options: options})
+	fn := m.UpdateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, policySetID, options)
+}
+
+// PolicySetsAddPoliciesCall holds the arguments of a single call to PolicySets.AddPolicies.
+type PolicySetsAddPoliciesCall struct {
+	Ctx         context.Context
+	PolicySetID string
+	Options     tfe.PolicySetAddPoliciesOptions
+}
+
+// Add policies to a policy set. This function can only be used when
+// there is no VCS repository associated with the policy set.
+func (m *PolicySets) AddPolicies(ctx context.Context, policySetID string, options tfe.PolicySetAddPoliciesOptions) (r0 error) {
+	m.mu.Lock()
+	m.AddPoliciesCalls = append(m.AddPoliciesCalls, PolicySetsAddPoliciesCall{Ctx: ctx, PolicySetID: policySetID, Options: options})
+	fn := m.AddPoliciesFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, policySetID, options)
+}
+
+// PolicySetsRemovePoliciesCall holds the arguments of a single call to PolicySets.RemovePolicies.
+type PolicySetsRemovePoliciesCall struct {
+	Ctx         context.Context
+	PolicySetID string
+	Options     tfe.PolicySetRemovePoliciesOptions
+}
+
+// Remove policies from a policy set. This function can only be used
+// when there is no VCS repository associated with the policy set.
+func (m *PolicySets) RemovePolicies(ctx context.Context, policySetID string, options tfe.PolicySetRemovePoliciesOptions) (r0 error) {
+	m.mu.Lock()
+	m.RemovePoliciesCalls = append(m.RemovePoliciesCalls, PolicySetsRemovePoliciesCall{Ctx: ctx, PolicySetID: policySetID, Options: options})
+	fn := m.RemovePoliciesFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, policySetID, options)
+}
+
+// PolicySetsAddWorkspacesCall holds the arguments of a single call to PolicySets.AddWorkspaces.
+type PolicySetsAddWorkspacesCall struct {
+	Ctx         context.Context
+	PolicySetID string
+	Options     tfe.PolicySetAddWorkspacesOptions
+}
+
+// Add workspaces to a policy set.
+func (m *PolicySets) AddWorkspaces(ctx context.Context, policySetID string, options tfe.PolicySetAddWorkspacesOptions) (r0 error) {
+	m.mu.Lock()
+	m.AddWorkspacesCalls = append(m.AddWorkspacesCalls, PolicySetsAddWorkspacesCall{Ctx: ctx, PolicySetID: policySetID, Options: options})
+	fn := m.AddWorkspacesFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, policySetID, options)
+}
+
+// PolicySetsRemoveWorkspacesCall holds the arguments of a single call to PolicySets.RemoveWorkspaces.
+type PolicySetsRemoveWorkspacesCall struct {
+	Ctx         context.Context
+	PolicySetID string
+	Options     tfe.PolicySetRemoveWorkspacesOptions
+}
+
+// Remove workspaces from a policy set.
+func (m *PolicySets) RemoveWorkspaces(ctx context.Context, policySetID string, options tfe.PolicySetRemoveWorkspacesOptions) (r0 error) {
+	m.mu.Lock()
+	m.RemoveWorkspacesCalls = append(m.RemoveWorkspacesCalls, PolicySetsRemoveWorkspacesCall{Ctx: ctx, PolicySetID: policySetID, Options: options})
+	fn := m.RemoveWorkspacesFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, policySetID, options)
+}
+
+// PolicySetsDeleteCall holds the arguments of a single call to PolicySets.Delete.
+type PolicySetsDeleteCall struct {
+	Ctx      context.Context
+	PolicyID string
+}
+
+// Delete a policy set by its ID.
+func (m *PolicySets) Delete(ctx context.Context, policyID string) (r0 error) {
+	m.mu.Lock()
+	m.DeleteCalls = append(m.DeleteCalls, PolicySetsDeleteCall{Ctx: ctx, PolicyID: policyID})
+	fn := m.DeleteFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, policyID)
+}
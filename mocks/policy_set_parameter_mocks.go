@@ -0,0 +1,148 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.PolicySetParameters = (*PolicySetParameters)(nil)
+
+// PolicySetParameters is a call-recording fake of tfe.PolicySetParameters.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type PolicySetParameters struct {
+	mu sync.Mutex
+
+	// ListFunc is invoked by List.
+	ListFunc func(ctx context.Context, policySetID string, options tfe.PolicySetParameterListOptions) (*tfe.PolicySetParameterList, error)
+	// ListCalls records the arguments of every call to List.
+	ListCalls []PolicySetParametersListCall
+
+	// CreateFunc is invoked by Create.
+	CreateFunc func(ctx context.Context, policySetID string, options tfe.PolicySetParameterCreateOptions) (*tfe.PolicySetParameter, error)
+	// CreateCalls records the arguments of every call to Create.
+	CreateCalls []PolicySetParametersCreateCall
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, policySetID string, parameterID string) (*tfe.PolicySetParameter, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []PolicySetParametersReadCall
+
+	// UpdateFunc is invoked by Update.
+	UpdateFunc func(ctx context.Context, policySetID string, parameterID string, options tfe.PolicySetParameterUpdateOptions) (*tfe.PolicySetParameter, error)
+	// UpdateCalls records the arguments of every call to Update.
+	UpdateCalls []PolicySetParametersUpdateCall
+
+	// DeleteFunc is invoked by Delete.
+	DeleteFunc func(ctx context.Context, policySetID string, parameterID string) error
+	// DeleteCalls records the arguments of every call to Delete.
+	DeleteCalls []PolicySetParametersDeleteCall
+}
+
+// PolicySetParametersListCall holds the arguments of a single call to PolicySetParameters.List.
+type PolicySetParametersListCall struct {
+	Ctx         context.Context
+	PolicySetID string
+	Options     tfe.PolicySetParameterListOptions
+}
+
+// List all the parameters associated with the given policy-set.
+func (m *PolicySetParameters) List(ctx context.Context, policySetID string, options tfe.PolicySetParameterListOptions) (r0 *tfe.PolicySetParameterList, r1 error) {
+	m.mu.Lock()
+	m.ListCalls = append(m.ListCalls, PolicySetParametersListCall{Ctx: ctx, PolicySetID: policySetID, Options: options})
+	fn := m.ListFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, policySetID, options)
+}
+
+// PolicySetParametersCreateCall holds the arguments of a single call to PolicySetParameters.Create.
+type PolicySetParametersCreateCall struct {
+	Ctx         context.Context
+	PolicySetID string
+	Options     tfe.PolicySetParameterCreateOptions
+}
+
+// Create is used to create a new parameter.
+func (m *PolicySetParameters) Create(ctx context.Context, policySetID string, options tfe.PolicySetParameterCreateOptions) (r0 *tfe.PolicySetParameter, r1 error) {
+	m.mu.Lock()
+	m.CreateCalls = append(m.CreateCalls, PolicySetParametersCreateCall{Ctx: ctx, PolicySetID: policySetID, Options: options})
+	fn := m.CreateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, policySetID, options)
+}
+
+// PolicySetParametersReadCall holds the arguments of a single call to PolicySetParameters.Read.
+type PolicySetParametersReadCall struct {
+	Ctx         context.Context
+	PolicySetID string
+	ParameterID string
+}
+
+// Read a parameter by its ID.
+func (m *PolicySetParameters) Read(ctx context.Context, policySetID string, parameterID string) (r0 *tfe.PolicySetParameter, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, PolicySetParametersReadCall{Ctx: ctx, PolicySetID: policySetID, ParameterID: parameterID})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, policySetID, parameterID)
+}
+
+// PolicySetParametersUpdateCall holds the arguments of a single call to PolicySetParameters.Update.
+type PolicySetParametersUpdateCall struct {
+	Ctx         context.Context
+	PolicySetID string
+	ParameterID string
+	Options     tfe.PolicySetParameterUpdateOptions
+}
+
+// Update values of an existing parameter.
+func (m *PolicySetParameters) Update(ctx context.Context, policySetID string, parameterID string, options tfe.PolicySetParameterUpdateOptions) (r0 *tfe.PolicySetParameter, r1 error) {
+	m.mu.Lock()
+	m.UpdateCalls = append(m.UpdateCalls, PolicySetParametersUpdateCall{Ctx: ctx, PolicySetID: policySetID, ParameterID: parameterID, Options: options})
+	fn := m.UpdateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, policySetID, parameterID, options)
+}
+
+// PolicySetParametersDeleteCall holds the arguments of a single call to PolicySetParameters.Delete.
+type PolicySetParametersDeleteCall struct {
+	Ctx         context.Context
+	PolicySetID string
+	ParameterID string
+}
+
+// Delete a parameter by its ID.
+func (m *PolicySetParameters) Delete(ctx context.Context, policySetID string, parameterID string) (r0 error) {
+	m.mu.Lock()
+	m.DeleteCalls = append(m.DeleteCalls, PolicySetParametersDeleteCall{Ctx: ctx, PolicySetID: policySetID, ParameterID: parameterID})
+	fn := m.DeleteFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, policySetID, parameterID)
+}
@@ -0,0 +1,202 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.RegistryModules = (*RegistryModules)(nil)
+
+// RegistryModules is a call-recording fake of tfe.RegistryModules.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type RegistryModules struct {
+	mu sync.Mutex
+
+	// CreateFunc is invoked by Create.
+	CreateFunc func(ctx context.Context, organization string, options tfe.RegistryModuleCreateOptions) (*tfe.RegistryModule, error)
+	// CreateCalls records the arguments of every call to Create.
+	CreateCalls []RegistryModulesCreateCall
+
+	// CreateVersionFunc is invoked by CreateVersion.
+	CreateVersionFunc func(ctx context.Context, organization string, name string, provider string, options tfe.RegistryModuleCreateVersionOptions) (*tfe.RegistryModuleVersion, error)
+	// CreateVersionCalls records the arguments of every call to CreateVersion.
+	CreateVersionCalls []RegistryModulesCreateVersionCall
+
+	// CreateWithVCSConnectionFunc is invoked by CreateWithVCSConnection.
+	CreateWithVCSConnectionFunc func(ctx context.Context, options tfe.RegistryModuleCreateWithVCSConnectionOptions) (*tfe.RegistryModule, error)
+	// CreateWithVCSConnectionCalls records the arguments of every call to CreateWithVCSConnection.
+	CreateWithVCSConnectionCalls []RegistryModulesCreateWithVCSConnectionCall
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, organization string, name string, provider string) (*tfe.RegistryModule, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []RegistryModulesReadCall
+
+	// DeleteFunc is invoked by Delete.
+	DeleteFunc func(ctx context.Context, organization string, name string) error
+	// DeleteCalls records the arguments of every call to Delete.
+	DeleteCalls []RegistryModulesDeleteCall
+
+	// DeleteProviderFunc is invoked by DeleteProvider.
+	DeleteProviderFunc func(ctx context.Context, organization string, name string, provider string) error
+	// DeleteProviderCalls records the arguments of every call to DeleteProvider.
+	DeleteProviderCalls []RegistryModulesDeleteProviderCall
+
+	// DeleteVersionFunc is invoked by DeleteVersion.
+	DeleteVersionFunc func(ctx context.Context, organization string, name string, provider string, version string) error
+	// DeleteVersionCalls records the arguments of every call to DeleteVersion.
+	DeleteVersionCalls []RegistryModulesDeleteVersionCall
+}
+
+// RegistryModulesCreateCall holds the arguments of a single call to RegistryModules.Create.
+type RegistryModulesCreateCall struct {
+	Ctx          context.Context
+	Organization string
+	Options      tfe.RegistryModuleCreateOptions
+}
+
+// Create a registry module without a VCS repo
+func (m *RegistryModules) Create(ctx context.Context, organization string, options tfe.RegistryModuleCreateOptions) (r0 *tfe.RegistryModule, r1 error) {
+	m.mu.Lock()
+	m.CreateCalls = append(m.CreateCalls, RegistryModulesCreateCall{Ctx: ctx, Organization: organization, Options: options})
+	fn := m.CreateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, options)
+}
+
+// RegistryModulesCreateVersionCall holds the arguments of a single call to RegistryModules.CreateVersion.
+type RegistryModulesCreateVersionCall struct {
+	Ctx          context.Context
+	Organization string
+	Name         string
+	Provider     string
+	Options      tfe.RegistryModuleCreateVersionOptions
+}
+
+// Create a registry module version
+func (m *RegistryModules) CreateVersion(ctx context.Context, organization string, name string, provider string, options tfe.RegistryModuleCreateVersionOptions) (r0 *tfe.RegistryModuleVersion, r1 error) {
+	m.mu.Lock()
+	m.CreateVersionCalls = append(m.CreateVersionCalls, RegistryModulesCreateVersionCall{Ctx: ctx, Organization: organization, Name: name, Provider: provider, Options: options})
+	fn := m.CreateVersionFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, name, provider, options)
+}
+
+// RegistryModulesCreateWithVCSConnectionCall holds the arguments of a single call to RegistryModules.CreateWithVCSConnection.
+type RegistryModulesCreateWithVCSConnectionCall struct {
+	Ctx     context.Context
+	Options tfe.RegistryModuleCreateWithVCSConnectionOptions
+}
+
+// Create and publish a registry module with a VCS repo
+func (m *RegistryModules) CreateWithVCSConnection(ctx context.Context, options tfe.RegistryModuleCreateWithVCSConnectionOptions) (r0 *tfe.RegistryModule, r1 error) {
+	m.mu.Lock()
+	m.CreateWithVCSConnectionCalls = append(m.CreateWithVCSConnectionCalls, RegistryModulesCreateWithVCSConnectionCall{Ctx: ctx, Options: options})
+	fn := m.CreateWithVCSConnectionFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, options)
+}
+
+// RegistryModulesReadCall holds the arguments of a single call to RegistryModules.Read.
+type RegistryModulesReadCall struct {
+	Ctx          context.Context
+	Organization string
+	Name         string
+	Provider     string
+}
+
+// Read a registry module
+func (m *RegistryModules) Read(ctx context.Context, organization string, name string, provider string) (r0 *tfe.RegistryModule, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, RegistryModulesReadCall{Ctx: ctx, Organization: organization, Name: name, Provider: provider})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, name, provider)
+}
+
+// RegistryModulesDeleteCall holds the arguments of a single call to RegistryModules.Delete.
+type RegistryModulesDeleteCall struct {
+	Ctx          context.Context
+	Organization string
+	Name         string
+}
+
+// Delete a registry module
+func (m *RegistryModules) Delete(ctx context.Context, organization string, name string) (r0 error) {
+	m.mu.Lock()
+	m.DeleteCalls = append(m.DeleteCalls, RegistryModulesDeleteCall{Ctx: ctx, Organization: organization, Name: name})
+	fn := m.DeleteFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, name)
+}
+
+// RegistryModulesDeleteProviderCall holds the arguments of a single call to RegistryModules.DeleteProvider.
+type RegistryModulesDeleteProviderCall struct {
+	Ctx          context.Context
+	Organization string
+	Name         string
+	Provider     string
+}
+
+// Delete a specific registry module provider
+func (m *RegistryModules) DeleteProvider(ctx context.Context, organization string, name string, provider string) (r0 error) {
+	m.mu.Lock()
+	m.DeleteProviderCalls = append(m.DeleteProviderCalls, RegistryModulesDeleteProviderCall{Ctx: ctx, Organization: organization, Name: name, Provider: provider})
+	fn := m.DeleteProviderFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, name, provider)
+}
+
+// RegistryModulesDeleteVersionCall holds the arguments of a single call to RegistryModules.DeleteVersion.
+type RegistryModulesDeleteVersionCall struct {
+	Ctx          context.Context
+	Organization string
+	Name         string
+	Provider     string
+	Version      string
+}
+
+// Delete a specific registry module version
+func (m *RegistryModules) DeleteVersion(ctx context.Context, organization string, name string, provider string, version string) (r0 error) {
+	m.mu.Lock()
+	m.DeleteVersionCalls = append(m.DeleteVersionCalls, RegistryModulesDeleteVersionCall{Ctx: ctx, Organization: organization, Name: name, Provider: provider, Version: version})
+	fn := m.DeleteVersionFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, name, provider, version)
+}
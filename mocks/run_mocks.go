@@ -0,0 +1,221 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.Runs = (*Runs)(nil)
+
+// Runs is a call-recording fake of tfe.Runs.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type Runs struct {
+	mu sync.Mutex
+
+	// ListFunc is invoked by List.
+	ListFunc func(ctx context.Context, workspaceID string, options tfe.RunListOptions) (*tfe.RunList, error)
+	// ListCalls records the arguments of every call to List.
+	ListCalls []RunsListCall
+
+	// CreateFunc is invoked by Create.
+	CreateFunc func(ctx context.Context, options tfe.RunCreateOptions) (*tfe.Run, error)
+	// CreateCalls records the arguments of every call to Create.
+	CreateCalls []RunsCreateCall
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, runID string) (*tfe.Run, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []RunsReadCall
+
+	// ReadWithOptionsFunc is invoked by ReadWithOptions.
+	ReadWithOptionsFunc func(ctx context.Context, runID string, options tfe.RunReadOptions) (*tfe.Run, error)
+	// ReadWithOptionsCalls records the arguments of every call to ReadWithOptions.
+	ReadWithOptionsCalls []RunsReadWithOptionsCall
+
+	// ApplyFunc is invoked by Apply.
+	ApplyFunc func(ctx context.Context, runID string, options tfe.RunApplyOptions) error
+	// ApplyCalls records the arguments of every call to Apply.
+	ApplyCalls []RunsApplyCall
+
+	// CancelFunc is invoked by Cancel.
+	CancelFunc func(ctx context.Context, runID string, options tfe.RunCancelOptions) error
+	// CancelCalls records the arguments of every call to Cancel.
+	CancelCalls []RunsCancelCall
+
+	// ForceCancelFunc is invoked by ForceCancel.
+	ForceCancelFunc func(ctx context.Context, runID string, options tfe.RunForceCancelOptions) error
+	// ForceCancelCalls records the arguments of every call to ForceCancel.
+	ForceCancelCalls []RunsForceCancelCall
+
+	// DiscardFunc is invoked by Discard.
+	DiscardFunc func(ctx context.Context, runID string, options tfe.RunDiscardOptions) error
+	// DiscardCalls records the arguments of every call to Discard.
+	DiscardCalls []RunsDiscardCall
+}
+
+// RunsListCall holds the arguments of a single call to Runs.List.
+type RunsListCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+	Options     tfe.RunListOptions
+}
+
+// List all the runs of the given workspace. A time range set in the
+// options is applied by the client to the retrieved page only.
+func (m *Runs) List(ctx context.Context, workspaceID string, options tfe.RunListOptions) (r0 *tfe.RunList, r1 error) {
+	m.mu.Lock()
+	m.ListCalls = append(m.ListCalls, RunsListCall{Ctx: ctx, WorkspaceID: workspaceID, Options: options})
+	fn := m.ListFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID, options)
+}
+
+// RunsCreateCall holds the arguments of a single call to Runs.Create.
+type RunsCreateCall struct {
+	Ctx     context.Context
+	Options tfe.RunCreateOptions
+}
+
+// Create a new run with the given options.
+func (m *Runs) Create(ctx context.Context, options tfe.RunCreateOptions) (r0 *tfe.Run, r1 error) {
+	m.mu.Lock()
+	m.CreateCalls = append(m.CreateCalls, RunsCreateCall{Ctx: ctx, Options: options})
+	fn := m.CreateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, options)
+}
+
+// RunsReadCall holds the arguments of a single call to Runs.Read.
+type RunsReadCall struct {
+	Ctx   context.Context
+	RunID string
+}
+
+// Read a run by its ID.
+func (m *Runs) Read(ctx context.Context, runID string) (r0 *tfe.Run, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, RunsReadCall{Ctx: ctx, RunID: runID})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, runID)
+}
+
+// RunsReadWithOptionsCall holds the arguments of a single call to Runs.ReadWithOptions.
+type RunsReadWithOptionsCall struct {
+	Ctx     context.Context
+	RunID   string
+	Options tfe.RunReadOptions
+}
+
+// ReadWithOptions reads a run by its ID with the given options.
+func (m *Runs) ReadWithOptions(ctx context.Context, runID string, options tfe.RunReadOptions) (r0 *tfe.Run, r1 error) {
+	m.mu.Lock()
+	m.ReadWithOptionsCalls = append(m.ReadWithOptionsCalls, RunsReadWithOptionsCall{Ctx: ctx, RunID: runID, Options: options})
+	fn := m.ReadWithOptionsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, runID, options)
+}
+
+// RunsApplyCall holds the arguments of a single call to Runs.Apply.
+type RunsApplyCall struct {
+	Ctx     context.Context
+	RunID   string
+	Options tfe.RunApplyOptions
+}
+
+// Apply a run by its ID.
+func (m *Runs) Apply(ctx context.Context, runID string, options tfe.RunApplyOptions) (r0 error) {
+	m.mu.Lock()
+	m.ApplyCalls = append(m.ApplyCalls, RunsApplyCall{Ctx: ctx, RunID: runID, Options: options})
+	fn := m.ApplyFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, runID, options)
+}
+
+// RunsCancelCall holds the arguments of a single call to Runs.Cancel.
+type RunsCancelCall struct {
+	Ctx     context.Context
+	RunID   string
+	Options tfe.RunCancelOptions
+}
+
+// Cancel a run by its ID.
+func (m *Runs) Cancel(ctx context.Context, runID string, options tfe.RunCancelOptions) (r0 error) {
+	m.mu.Lock()
+	m.CancelCalls = append(m.CancelCalls, RunsCancelCall{Ctx: ctx, RunID: runID, Options: options})
+	fn := m.CancelFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, runID, options)
+}
+
+// RunsForceCancelCall holds the arguments of a single call to Runs.ForceCancel.
+type RunsForceCancelCall struct {
+	Ctx     context.Context
+	RunID   string
+	Options tfe.RunForceCancelOptions
+}
+
+// Force-cancel a run by its ID.
+func (m *Runs) ForceCancel(ctx context.Context, runID string, options tfe.RunForceCancelOptions) (r0 error) {
+	m.mu.Lock()
+	m.ForceCancelCalls = append(m.ForceCancelCalls, RunsForceCancelCall{Ctx: ctx, RunID: runID, Options: options})
+	fn := m.ForceCancelFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, runID, options)
+}
+
+// RunsDiscardCall holds the arguments of a single call to Runs.Discard.
+type RunsDiscardCall struct {
+	Ctx     context.Context
+	RunID   string
+	Options tfe.RunDiscardOptions
+}
+
+// Discard a run by its ID.
+func (m *Runs) Discard(ctx context.Context, runID string, options tfe.RunDiscardOptions) (r0 error) {
+	m.mu.Lock()
+	m.DiscardCalls = append(m.DiscardCalls, RunsDiscardCall{Ctx: ctx, RunID: runID, Options: options})
+	fn := m.DiscardFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, runID, options)
+}
@@ -0,0 +1,120 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.RunTriggers = (*RunTriggers)(nil)
+
+// RunTriggers is a call-recording fake of tfe.RunTriggers.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type RunTriggers struct {
+	mu sync.Mutex
+
+	// ListFunc is invoked by List.
+	ListFunc func(ctx context.Context, workspaceID string, options tfe.RunTriggerListOptions) (*tfe.RunTriggerList, error)
+	// ListCalls records the arguments of every call to List.
+	ListCalls []RunTriggersListCall
+
+	// CreateFunc is invoked by Create.
+	CreateFunc func(ctx context.Context, workspaceID string, options tfe.RunTriggerCreateOptions) (*tfe.RunTrigger, error)
+	// CreateCalls records the arguments of every call to Create.
+	CreateCalls []RunTriggersCreateCall
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, RunTriggerID string) (*tfe.RunTrigger, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []RunTriggersReadCall
+
+	// DeleteFunc is invoked by Delete.
+	DeleteFunc func(ctx context.Context, RunTriggerID string) error
+	// DeleteCalls records the arguments of every call to Delete.
+	DeleteCalls []RunTriggersDeleteCall
+}
+
+// RunTriggersListCall holds the arguments of a single call to RunTriggers.List.
+type RunTriggersListCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+	Options     tfe.RunTriggerListOptions
+}
+
+// List all the run triggers within a workspace.
+func (m *RunTriggers) List(ctx context.Context, workspaceID string, options tfe.RunTriggerListOptions) (r0 *tfe.RunTriggerList, r1 error) {
+	m.mu.Lock()
+	m.ListCalls = append(m.ListCalls, RunTriggersListCall{Ctx: ctx, WorkspaceID: workspaceID, Options: options})
+	fn := m.ListFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID, options)
+}
+
+// RunTriggersCreateCall holds the arguments of a single call to RunTriggers.Create.
+type RunTriggersCreateCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+	Options     tfe.RunTriggerCreateOptions
+}
+
+// Create a new run trigger with the given options.
+func (m *RunTriggers) Create(ctx context.Context, workspaceID string, options tfe.RunTriggerCreateOptions) (r0 *tfe.RunTrigger, r1 error) {
+	m.mu.Lock()
+	m.CreateCalls = append(m.CreateCalls, RunTriggersCreateCall{Ctx: ctx, WorkspaceID: workspaceID, Options: options})
+	fn := m.CreateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID, options)
+}
+
+// RunTriggersReadCall holds the arguments of a single call to RunTriggers.Read.
+type RunTriggersReadCall struct {
+	Ctx          context.Context
+	RunTriggerID string
+}
+
+// Read a run trigger by its ID.
+func (m *RunTriggers) Read(ctx context.Context, RunTriggerID string) (r0 *tfe.RunTrigger, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, RunTriggersReadCall{Ctx: ctx, RunTriggerID: RunTriggerID})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, RunTriggerID)
+}
+
+// RunTriggersDeleteCall holds the arguments of a single call to RunTriggers.Delete.
+type RunTriggersDeleteCall struct {
+	Ctx          context.Context
+	RunTriggerID string
+}
+
+// Delete a run trigger by its ID.
+func (m *RunTriggers) Delete(ctx context.Context, RunTriggerID string) (r0 error) {
+	m.mu.Lock()
+	m.DeleteCalls = append(m.DeleteCalls, RunTriggersDeleteCall{Ctx: ctx, RunTriggerID: RunTriggerID})
+	fn := m.DeleteFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, RunTriggerID)
+}
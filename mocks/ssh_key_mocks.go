@@ -0,0 +1,145 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.SSHKeys = (*SSHKeys)(nil)
+
+// SSHKeys is a call-recording fake of tfe.SSHKeys.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type SSHKeys struct {
+	mu sync.Mutex
+
+	// ListFunc is invoked by List.
+	ListFunc func(ctx context.Context, organization string, options tfe.SSHKeyListOptions) (*tfe.SSHKeyList, error)
+	// ListCalls records the arguments of every call to List.
+	ListCalls []SSHKeysListCall
+
+	// CreateFunc is invoked by Create.
+	CreateFunc func(ctx context.Context, organization string, options tfe.SSHKeyCreateOptions) (*tfe.SSHKey, error)
+	// CreateCalls records the arguments of every call to Create.
+	CreateCalls []SSHKeysCreateCall
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, sshKeyID string) (*tfe.SSHKey, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []SSHKeysReadCall
+
+	// UpdateFunc is invoked by Update.
+	UpdateFunc func(ctx context.Context, sshKeyID string, options tfe.SSHKeyUpdateOptions) (*tfe.SSHKey, error)
+	// UpdateCalls records the arguments of every call to Update.
+	UpdateCalls []SSHKeysUpdateCall
+
+	// DeleteFunc is invoked by Delete.
+	DeleteFunc func(ctx context.Context, sshKeyID string) error
+	// DeleteCalls records the arguments of every call to Delete.
+	DeleteCalls []SSHKeysDeleteCall
+}
+
+// SSHKeysListCall holds the arguments of a single call to SSHKeys.List.
+type SSHKeysListCall struct {
+	Ctx          context.Context
+	Organization string
+	Options      tfe.SSHKeyListOptions
+}
+
+// List all the SSH keys for a given organization
+func (m *SSHKeys) List(ctx context.Context, organization string, options tfe.SSHKeyListOptions) (r0 *tfe.SSHKeyList, r1 error) {
+	m.mu.Lock()
+	m.ListCalls = append(m.ListCalls, SSHKeysListCall{Ctx: ctx, Organization: organization, Options: options})
+	fn := m.ListFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, options)
+}
+
+// SSHKeysCreateCall holds the arguments of a single call to SSHKeys.Create.
+type SSHKeysCreateCall struct {
+	Ctx          context.Context
+	Organization string
+	Options      tfe.SSHKeyCreateOptions
+}
+
+// Create an SSH key and associate it with an organization.
+func (m *SSHKeys) Create(ctx context.Context, organization string, options tfe.SSHKeyCreateOptions) (r0 *tfe.SSHKey, r1 error) {
+	m.mu.Lock()
+	m.CreateCalls = append(m.CreateCalls, SSHKeysCreateCall{Ctx: ctx, Organization: organization, Options: options})
+	fn := m.CreateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, options)
+}
+
+// SSHKeysReadCall holds the arguments of a single call to SSHKeys.Read.
+type SSHKeysReadCall struct {
+	Ctx      context.Context
+	SshKeyID string
+}
+
+// Read an SSH key by its ID.
+func (m *SSHKeys) Read(ctx context.Context, sshKeyID string) (r0 *tfe.SSHKey, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, SSHKeysReadCall{Ctx: ctx, SshKeyID: sshKeyID})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, sshKeyID)
+}
+
+// SSHKeysUpdateCall holds the arguments of a single call to SSHKeys.Update.
+type SSHKeysUpdateCall struct {
+	Ctx      context.Context
+	SshKeyID string
+	Options  tfe.SSHKeyUpdateOptions
+}
+
+// Update an SSH key by its ID.
+func (m *SSHKeys) Update(ctx context.Context, sshKeyID string, options tfe.SSHKeyUpdateOptions) (r0 *tfe.SSHKey, r1 error) {
+	m.mu.Lock()
+	m.UpdateCalls = append(m.UpdateCalls, SSHKeysUpdateCall{Ctx: ctx, SshKeyID: sshKeyID, Options: options})
+	fn := m.UpdateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, sshKeyID, options)
+}
+
+// SSHKeysDeleteCall holds the arguments of a single call to SSHKeys.Delete.
+type SSHKeysDeleteCall struct {
+	Ctx      context.Context
+	SshKeyID string
+}
+
+// Delete an SSH key by its ID.
+func (m *SSHKeys) Delete(ctx context.Context, sshKeyID string) (r0 error) {
+	m.mu.Lock()
+	m.DeleteCalls = append(m.DeleteCalls, SSHKeysDeleteCall{Ctx: ctx, SshKeyID: sshKeyID})
+	fn := m.DeleteFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, sshKeyID)
+}
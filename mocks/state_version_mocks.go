@@ -0,0 +1,194 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.StateVersions = (*StateVersions)(nil)
+
+// StateVersions is a call-recording fake of tfe.StateVersions.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type StateVersions struct {
+	mu sync.Mutex
+
+	// ListFunc is invoked by List.
+	ListFunc func(ctx context.Context, options tfe.StateVersionListOptions) (*tfe.StateVersionList, error)
+	// ListCalls records the arguments of every call to List.
+	ListCalls []StateVersionsListCall
+
+	// CreateFunc is invoked by Create.
+	CreateFunc func(ctx context.Context, workspaceID string, options tfe.StateVersionCreateOptions) (*tfe.StateVersion, error)
+	// CreateCalls records the arguments of every call to Create.
+	CreateCalls []StateVersionsCreateCall
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, svID string) (*tfe.StateVersion, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []StateVersionsReadCall
+
+	// ReadWithOptionsFunc is invoked by ReadWithOptions.
+	ReadWithOptionsFunc func(ctx context.Context, svID string, options tfe.StateVersionReadOptions) (*tfe.StateVersion, error)
+	// ReadWithOptionsCalls records the arguments of every call to ReadWithOptions.
+	ReadWithOptionsCalls []StateVersionsReadWithOptionsCall
+
+	// CurrentFunc is invoked by Current.
+	CurrentFunc func(ctx context.Context, workspaceID string) (*tfe.StateVersion, error)
+	// CurrentCalls records the arguments of every call to Current.
+	CurrentCalls []StateVersionsCurrentCall
+
+	// CurrentWithOptionsFunc is invoked by CurrentWithOptions.
+	CurrentWithOptionsFunc func(ctx context.Context, workspaceID string, options tfe.StateVersionReadOptions) (*tfe.StateVersion, error)
+	// CurrentWithOptionsCalls records the arguments of every call to CurrentWithOptions.
+	CurrentWithOptionsCalls []StateVersionsCurrentWithOptionsCall
+
+	// DownloadFunc is invoked by Download.
+	DownloadFunc func(ctx context.Context, url string) ([]byte, error)
+	// DownloadCalls records the arguments of every call to Download.
+	DownloadCalls []StateVersionsDownloadCall
+}
+
+// StateVersionsListCall holds the arguments of a single call to StateVersions.List.
+type StateVersionsListCall struct {
+	Ctx     context.Context
+	Options tfe.StateVersionListOptions
+}
+
+// List all the state versions for a given workspace.
+func (m *StateVersions) List(ctx context.Context, options tfe.StateVersionListOptions) (r0 *tfe.StateVersionList, r1 error) {
+	m.mu.Lock()
+	m.ListCalls = append(m.ListCalls, StateVersionsListCall{Ctx: ctx, Options: options})
+	fn := m.ListFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, options)
+}
+
+// StateVersionsCreateCall holds the arguments of a single call to StateVersions.Create.
+type StateVersionsCreateCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+	Options     tfe.StateVersionCreateOptions
+}
+
+// Create a new state version for the given workspace.
+func (m *StateVersions) Create(ctx context.Context, workspaceID string, options tfe.StateVersionCreateOptions) (r0 *tfe.StateVersion, r1 error) {
+	m.mu.Lock()
+	m.CreateCalls = append(m.CreateCalls, StateVersionsCreateCall{Ctx: ctx, WorkspaceID: workspaceID, Options: options})
+	fn := m.CreateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID, options)
+}
+
+// StateVersionsReadCall holds the arguments of a single call to StateVersions.Read.
+type StateVersionsReadCall struct {
+	Ctx  context.Context
+	SvID string
+}
+
+// Read a state version by its ID.
+func (m *StateVersions) Read(ctx context.Context, svID string) (r0 *tfe.StateVersion, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, StateVersionsReadCall{Ctx: ctx, SvID: svID})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, svID)
+}
+
+// StateVersionsReadWithOptionsCall holds the arguments of a single call to StateVersions.ReadWithOptions.
+type StateVersionsReadWithOptionsCall struct {
+	Ctx     context.Context
+	SvID    string
+	Options tfe.StateVersionReadOptions
+}
+
+// ReadWithOptions reads a state version by its ID with the given options.
+func (m *StateVersions) ReadWithOptions(ctx context.Context, svID string, options tfe.StateVersionReadOptions) (r0 *tfe.StateVersion, r1 error) {
+	m.mu.Lock()
+	m.ReadWithOptionsCalls = append(m.ReadWithOptionsCalls, StateVersionsReadWithOptionsCall{Ctx: ctx, SvID: svID, Options: options})
+	fn := m.ReadWithOptionsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, svID, options)
+}
+
+// StateVersionsCurrentCall holds the arguments of a single call to StateVersions.Current.
+type StateVersionsCurrentCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+}
+
+// Current reads the latest available state from the given workspace.
+func (m *StateVersions) Current(ctx context.Context, workspaceID string) (r0 *tfe.StateVersion, r1 error) {
+	m.mu.Lock()
+	m.CurrentCalls = append(m.CurrentCalls, StateVersionsCurrentCall{Ctx: ctx, WorkspaceID: workspaceID})
+	fn := m.CurrentFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID)
+}
+
+// StateVersionsCurrentWithOptionsCall holds the arguments of a single call to StateVersions.CurrentWithOptions.
+type StateVersionsCurrentWithOptionsCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+	Options     tfe.StateVersionReadOptions
+}
+
+// CurrentWithOptions reads the latest available state from the given
+// workspace with the given options.
+func (m *StateVersions) CurrentWithOptions(ctx context.Context, workspaceID string, options tfe.StateVersionReadOptions) (r0 *tfe.StateVersion, r1 error) {
+	m.mu.Lock()
+	m.CurrentWithOptionsCalls = append(m.CurrentWithOptionsCalls, StateVersionsCurrentWithOptionsCall{Ctx: ctx, WorkspaceID: workspaceID, Options: options})
+	fn := m.CurrentWithOptionsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID, options)
+}
+
+// StateVersionsDownloadCall holds the arguments of a single call to StateVersions.Download.
+type StateVersionsDownloadCall struct {
+	Ctx context.Context
+	URL string
+}
+
+// Download retrieves the actual stored state of a state version
+func (m *StateVersions) Download(ctx context.Context, url string) (r0 []byte, r1 error) {
+	m.mu.Lock()
+	m.DownloadCalls = append(m.DownloadCalls, StateVersionsDownloadCall{Ctx: ctx, URL: url})
+	fn := m.DownloadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, url)
+}
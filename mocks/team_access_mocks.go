@@ -0,0 +1,143 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.TeamAccesses = (*TeamAccesses)(nil)
+
+// TeamAccesses is a call-recording fake of tfe.TeamAccesses.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type TeamAccesses struct {
+	mu sync.Mutex
+
+	// ListFunc is invoked by List.
+	ListFunc func(ctx context.Context, options tfe.TeamAccessListOptions) (*tfe.TeamAccessList, error)
+	// ListCalls records the arguments of every call to List.
+	ListCalls []TeamAccessesListCall
+
+	// AddFunc is invoked by Add.
+	AddFunc func(ctx context.Context, options tfe.TeamAccessAddOptions) (*tfe.TeamAccess, error)
+	// AddCalls records the arguments of every call to Add.
+	AddCalls []TeamAccessesAddCall
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, teamAccessID string) (*tfe.TeamAccess, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []TeamAccessesReadCall
+
+	// UpdateFunc is invoked by Update.
+	UpdateFunc func(ctx context.Context, teamAccessID string, options tfe.TeamAccessUpdateOptions) (*tfe.TeamAccess, error)
+	// UpdateCalls records the arguments of every call to Update.
+	UpdateCalls []TeamAccessesUpdateCall
+
+	// RemoveFunc is invoked by Remove.
+	RemoveFunc func(ctx context.Context, teamAccessID string) error
+	// RemoveCalls records the arguments of every call to Remove.
+	RemoveCalls []TeamAccessesRemoveCall
+}
+
+// TeamAccessesListCall holds the arguments of a single call to TeamAccesses.List.
+type TeamAccessesListCall struct {
+	Ctx     context.Context
+	Options tfe.TeamAccessListOptions
+}
+
+// List all the team accesses for a given workspace.
+func (m *TeamAccesses) List(ctx context.Context, options tfe.TeamAccessListOptions) (r0 *tfe.TeamAccessList, r1 error) {
+	m.mu.Lock()
+	m.ListCalls = append(m.ListCalls, TeamAccessesListCall{Ctx: ctx, Options: options})
+	fn := m.ListFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, options)
+}
+
+// TeamAccessesAddCall holds the arguments of a single call to TeamAccesses.Add.
+type TeamAccessesAddCall struct {
+	Ctx     context.Context
+	Options tfe.TeamAccessAddOptions
+}
+
+// Add team access for a workspace.
+func (m *TeamAccesses) Add(ctx context.Context, options tfe.TeamAccessAddOptions) (r0 *tfe.TeamAccess, r1 error) {
+	m.mu.Lock()
+	m.AddCalls = append(m.AddCalls, TeamAccessesAddCall{Ctx: ctx, Options: options})
+	fn := m.AddFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, options)
+}
+
+// TeamAccessesReadCall holds the arguments of a single call to TeamAccesses.Read.
+type TeamAccessesReadCall struct {
+	Ctx          context.Context
+	TeamAccessID string
+}
+
+// Read a team access by its ID.
+func (m *TeamAccesses) Read(ctx context.Context, teamAccessID string) (r0 *tfe.TeamAccess, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, TeamAccessesReadCall{Ctx: ctx, TeamAccessID: teamAccessID})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, teamAccessID)
+}
+
+// TeamAccessesUpdateCall holds the arguments of a single call to TeamAccesses.Update.
+type TeamAccessesUpdateCall struct {
+	Ctx          context.Context
+	TeamAccessID string
+	Options      tfe.TeamAccessUpdateOptions
+}
+
+// Update a team access by its ID.
+func (m *TeamAccesses) Update(ctx context.Context, teamAccessID string, options tfe.TeamAccessUpdateOptions) (r0 *tfe.TeamAccess, r1 error) {
+	m.mu.Lock()
+	m.UpdateCalls = append(m.UpdateCalls, TeamAccessesUpdateCall{Ctx: ctx, TeamAccessID: teamAccessID, Options: options})
+	fn := m.UpdateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, teamAccessID, options)
+}
+
+// TeamAccessesRemoveCall holds the arguments of a single call to TeamAccesses.Remove.
+type TeamAccessesRemoveCall struct {
+	Ctx          context.Context
+	TeamAccessID string
+}
+
+// Remove team access from a workspace.
+func (m *TeamAccesses) Remove(ctx context.Context, teamAccessID string) (r0 error) {
+	m.mu.Lock()
+	m.RemoveCalls = append(m.RemoveCalls, TeamAccessesRemoveCall{Ctx: ctx, TeamAccessID: teamAccessID})
+	fn := m.RemoveFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, teamAccessID)
+}
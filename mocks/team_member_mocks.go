@@ -0,0 +1,145 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.TeamMembers = (*TeamMembers)(nil)
+
+// TeamMembers is a call-recording fake of tfe.TeamMembers.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type TeamMembers struct {
+	mu sync.Mutex
+
+	// ListFunc is invoked by List.
+	ListFunc func(ctx context.Context, teamID string) ([]*tfe.User, error)
+	// ListCalls records the arguments of every call to List.
+	ListCalls []TeamMembersListCall
+
+	// ListUsersFunc is invoked by ListUsers.
+	ListUsersFunc func(ctx context.Context, teamID string) ([]*tfe.User, error)
+	// ListUsersCalls records the arguments of every call to ListUsers.
+	ListUsersCalls []TeamMembersListUsersCall
+
+	// ListOrganizationMembershipsFunc is invoked by ListOrganizationMemberships.
+	ListOrganizationMembershipsFunc func(ctx context.Context, teamID string) ([]*tfe.OrganizationMembership, error)
+	// ListOrganizationMembershipsCalls records the arguments of every call to ListOrganizationMemberships.
+	ListOrganizationMembershipsCalls []TeamMembersListOrganizationMembershipsCall
+
+	// AddFunc is invoked by Add.
+	AddFunc func(ctx context.Context, teamID string, options tfe.TeamMemberAddOptions) error
+	// AddCalls records the arguments of every call to Add.
+	AddCalls []TeamMembersAddCall
+
+	// RemoveFunc is invoked by Remove.
+	RemoveFunc func(ctx context.Context, teamID string, options tfe.TeamMemberRemoveOptions) error
+	// RemoveCalls records the arguments of every call to Remove.
+	RemoveCalls []TeamMembersRemoveCall
+}
+
+// TeamMembersListCall holds the arguments of a single call to TeamMembers.List.
+type TeamMembersListCall struct {
+	Ctx    context.Context
+	TeamID string
+}
+
+// List returns all Users of a team calling ListUsers
+// See ListOrganizationMemberships for fetching memberships
+func (m *TeamMembers) List(ctx context.Context, teamID string) (r0 []*tfe.User, r1 error) {
+	m.mu.Lock()
+	m.ListCalls = append(m.ListCalls, TeamMembersListCall{Ctx: ctx, TeamID: teamID})
+	fn := m.ListFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, teamID)
+}
+
+// TeamMembersListUsersCall holds the arguments of a single call to TeamMembers.ListUsers.
+type TeamMembersListUsersCall struct {
+	Ctx    context.Context
+	TeamID string
+}
+
+// ListUsers returns the Users of this team.
+func (m *TeamMembers) ListUsers(ctx context.Context, teamID string) (r0 []*tfe.User, r1 error) {
+	m.mu.Lock()
+	m.ListUsersCalls = append(m.ListUsersCalls, TeamMembersListUsersCall{Ctx: ctx, TeamID: teamID})
+	fn := m.ListUsersFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, teamID)
+}
+
+// TeamMembersListOrganizationMembershipsCall holds the arguments of a single call to TeamMembers.ListOrganizationMemberships.
+type TeamMembersListOrganizationMembershipsCall struct {
+	Ctx    context.Context
+	TeamID string
+}
+
+// ListOrganizationMemberships returns the OrganizationMemberships of this team.
+func (m *TeamMembers) ListOrganizationMemberships(ctx context.Context, teamID string) (r0 []*tfe.OrganizationMembership, r1 error) {
+	m.mu.Lock()
+	m.ListOrganizationMembershipsCalls = append(m.ListOrganizationMembershipsCalls, TeamMembersListOrganizationMembershipsCall{Ctx: ctx, TeamID: teamID})
+	fn := m.ListOrganizationMembershipsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, teamID)
+}
+
+// TeamMembersAddCall holds the arguments of a single call to TeamMembers.Add.
+type TeamMembersAddCall struct {
+	Ctx     context.Context
+	TeamID  string
+	Options tfe.TeamMemberAddOptions
+}
+
+// Add multiple users to a team.
+func (m *TeamMembers) Add(ctx context.Context, teamID string, options tfe.TeamMemberAddOptions) (r0 error) {
+	m.mu.Lock()
+	m.AddCalls = append(m.AddCalls, TeamMembersAddCall{Ctx: ctx, TeamID: teamID, Options: options})
+	fn := m.AddFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, teamID, options)
+}
+
+// TeamMembersRemoveCall holds the arguments of a single call to TeamMembers.Remove.
+type TeamMembersRemoveCall struct {
+	Ctx     context.Context
+	TeamID  string
+	Options tfe.TeamMemberRemoveOptions
+}
+
+// Remove multiple users from a team.
+func (m *TeamMembers) Remove(ctx context.Context, teamID string, options tfe.TeamMemberRemoveOptions) (r0 error) {
+	m.mu.Lock()
+	m.RemoveCalls = append(m.RemoveCalls, TeamMembersRemoveCall{Ctx: ctx, TeamID: teamID, Options: options})
+	fn := m.RemoveFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, teamID, options)
+}
@@ -0,0 +1,145 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.Teams = (*Teams)(nil)
+
+// Teams is a call-recording fake of tfe.Teams.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type Teams struct {
+	mu sync.Mutex
+
+	// ListFunc is invoked by List.
+	ListFunc func(ctx context.Context, organization string, options tfe.TeamListOptions) (*tfe.TeamList, error)
+	// ListCalls records the arguments of every call to List.
+	ListCalls []TeamsListCall
+
+	// CreateFunc is invoked by Create.
+	CreateFunc func(ctx context.Context, organization string, options tfe.TeamCreateOptions) (*tfe.Team, error)
+	// CreateCalls records the arguments of every call to Create.
+	CreateCalls []TeamsCreateCall
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, teamID string) (*tfe.Team, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []TeamsReadCall
+
+	// UpdateFunc is invoked by Update.
+	UpdateFunc func(ctx context.Context, teamID string, options tfe.TeamUpdateOptions) (*tfe.Team, error)
+	// UpdateCalls records the arguments of every call to Update.
+	UpdateCalls []TeamsUpdateCall
+
+	// DeleteFunc is invoked by Delete.
+	DeleteFunc func(ctx context.Context, teamID string) error
+	// DeleteCalls records the arguments of every call to Delete.
+	DeleteCalls []TeamsDeleteCall
+}
+
+// TeamsListCall holds the arguments of a single call to Teams.List.
+type TeamsListCall struct {
+	Ctx          context.Context
+	Organization string
+	Options      tfe.TeamListOptions
+}
+
+// List all the teams of the given organization.
+func (m *Teams) List(ctx context.Context, organization string, options tfe.TeamListOptions) (r0 *tfe.TeamList, r1 error) {
+	m.mu.Lock()
+	m.ListCalls = append(m.ListCalls, TeamsListCall{Ctx: ctx, Organization: organization, Options: options})
+	fn := m.ListFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, options)
+}
+
+// TeamsCreateCall holds the arguments of a single call to Teams.Create.
+type TeamsCreateCall struct {
+	Ctx          context.Context
+	Organization string
+	Options      tfe.TeamCreateOptions
+}
+
+// Create a new team with the given options.
+func (m *Teams) Create(ctx context.Context, organization string, options tfe.TeamCreateOptions) (r0 *tfe.Team, r1 error) {
+	m.mu.Lock()
+	m.CreateCalls = append(m.CreateCalls, TeamsCreateCall{Ctx: ctx, Organization: organization, Options: options})
+	fn := m.CreateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, options)
+}
+
+// TeamsReadCall holds the arguments of a single call to Teams.Read.
+type TeamsReadCall struct {
+	Ctx    context.Context
+	TeamID string
+}
+
+// Read a team by its ID.
+func (m *Teams) Read(ctx context.Context, teamID string) (r0 *tfe.Team, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, TeamsReadCall{Ctx: ctx, TeamID: teamID})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, teamID)
+}
+
+// TeamsUpdateCall holds the arguments of a single call to Teams.Update.
+type TeamsUpdateCall struct {
+	Ctx     context.Context
+	TeamID  string
+	Options tfe.TeamUpdateOptions
+}
+
+// Update a team by its ID.
+func (m *Teams) Update(ctx context.Context, teamID string, options tfe.TeamUpdateOptions) (r0 *tfe.Team, r1 error) {
+	m.mu.Lock()
+	m.UpdateCalls = append(m.UpdateCalls, TeamsUpdateCall{Ctx: ctx, TeamID: teamID, Options: options})
+	fn := m.UpdateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, teamID, options)
+}
+
+// TeamsDeleteCall holds the arguments of a single call to Teams.Delete.
+type TeamsDeleteCall struct {
+	Ctx    context.Context
+	TeamID string
+}
+
+// Delete a team by its ID.
+func (m *Teams) Delete(ctx context.Context, teamID string) (r0 error) {
+	m.mu.Lock()
+	m.DeleteCalls = append(m.DeleteCalls, TeamsDeleteCall{Ctx: ctx, TeamID: teamID})
+	fn := m.DeleteFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, teamID)
+}
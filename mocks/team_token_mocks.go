@@ -0,0 +1,94 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.TeamTokens = (*TeamTokens)(nil)
+
+// TeamTokens is a call-recording fake of tfe.TeamTokens.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type TeamTokens struct {
+	mu sync.Mutex
+
+	// GenerateFunc is invoked by Generate.
+	GenerateFunc func(ctx context.Context, teamID string) (*tfe.TeamToken, error)
+	// GenerateCalls records the arguments of every call to Generate.
+	GenerateCalls []TeamTokensGenerateCall
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, teamID string) (*tfe.TeamToken, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []TeamTokensReadCall
+
+	// DeleteFunc is invoked by Delete.
+	DeleteFunc func(ctx context.Context, teamID string) error
+	// DeleteCalls records the arguments of every call to Delete.
+	DeleteCalls []TeamTokensDeleteCall
+}
+
+// TeamTokensGenerateCall holds the arguments of a single call to TeamTokens.Generate.
+type TeamTokensGenerateCall struct {
+	Ctx    context.Context
+	TeamID string
+}
+
+// Generate a new team token, replacing any existing token.
+func (m *TeamTokens) Generate(ctx context.Context, teamID string) (r0 *tfe.TeamToken, r1 error) {
+	m.mu.Lock()
+	m.GenerateCalls = append(m.GenerateCalls, TeamTokensGenerateCall{Ctx: ctx, TeamID: teamID})
+	fn := m.GenerateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, teamID)
+}
+
+// TeamTokensReadCall holds the arguments of a single call to TeamTokens.Read.
+type TeamTokensReadCall struct {
+	Ctx    context.Context
+	TeamID string
+}
+
+// Read a team token by its ID.
+func (m *TeamTokens) Read(ctx context.Context, teamID string) (r0 *tfe.TeamToken, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, TeamTokensReadCall{Ctx: ctx, TeamID: teamID})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, teamID)
+}
+
+// TeamTokensDeleteCall holds the arguments of a single call to TeamTokens.Delete.
+type TeamTokensDeleteCall struct {
+	Ctx    context.Context
+	TeamID string
+}
+
+// Delete a team token by its ID.
+func (m *TeamTokens) Delete(ctx context.Context, teamID string) (r0 error) {
+	m.mu.Lock()
+	m.DeleteCalls = append(m.DeleteCalls, TeamTokensDeleteCall{Ctx: ctx, TeamID: teamID})
+	fn := m.DeleteFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, teamID)
+}
@@ -0,0 +1,69 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.Users = (*Users)(nil)
+
+// Users is a call-recording fake of tfe.Users.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type Users struct {
+	mu sync.Mutex
+
+	// ReadCurrentFunc is invoked by ReadCurrent.
+	ReadCurrentFunc func(ctx context.Context) (*tfe.User, error)
+	// ReadCurrentCalls records the arguments of every call to ReadCurrent.
+	ReadCurrentCalls []UsersReadCurrentCall
+
+	// UpdateFunc is invoked by Update.
+	UpdateFunc func(ctx context.Context, options tfe.UserUpdateOptions) (*tfe.User, error)
+	// UpdateCalls records the arguments of every call to Update.
+	UpdateCalls []UsersUpdateCall
+}
+
+// UsersReadCurrentCall holds the arguments of a single call to Users.ReadCurrent.
+type UsersReadCurrentCall struct {
+	Ctx context.Context
+}
+
+// ReadCurrent reads the details of the currently authenticated user.
+func (m *Users) ReadCurrent(ctx context.Context) (r0 *tfe.User, r1 error) {
+	m.mu.Lock()
+	m.ReadCurrentCalls = append(m.ReadCurrentCalls, UsersReadCurrentCall{Ctx: ctx})
+	fn := m.ReadCurrentFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx)
+}
+
+// UsersUpdateCall holds the arguments of a single call to Users.Update.
+type UsersUpdateCall struct {
+	Ctx     context.Context
+	Options tfe.UserUpdateOptions
+}
+
+// Update attributes of the currently authenticated user.
+func (m *Users) Update(ctx context.Context, options tfe.UserUpdateOptions) (r0 *tfe.User, r1 error) {
+	m.mu.Lock()
+	m.UpdateCalls = append(m.UpdateCalls, UsersUpdateCall{Ctx: ctx, Options: options})
+	fn := m.UpdateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, options)
+}
@@ -0,0 +1,148 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.Variables = (*Variables)(nil)
+
+// Variables is a call-recording fake of tfe.Variables.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type Variables struct {
+	mu sync.Mutex
+
+	// ListFunc is invoked by List.
+	ListFunc func(ctx context.Context, workspaceID string, options tfe.VariableListOptions) (*tfe.VariableList, error)
+	// ListCalls records the arguments of every call to List.
+	ListCalls []VariablesListCall
+
+	// CreateFunc is invoked by Create.
+	CreateFunc func(ctx context.Context, workspaceID string, options tfe.VariableCreateOptions) (*tfe.Variable, error)
+	// CreateCalls records the arguments of every call to Create.
+	CreateCalls []VariablesCreateCall
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, workspaceID string, variableID string) (*tfe.Variable, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []VariablesReadCall
+
+	// UpdateFunc is invoked by Update.
+	UpdateFunc func(ctx context.Context, workspaceID string, variableID string, options tfe.VariableUpdateOptions) (*tfe.Variable, error)
+	// UpdateCalls records the arguments of every call to Update.
+	UpdateCalls []VariablesUpdateCall
+
+	// DeleteFunc is invoked by Delete.
+	DeleteFunc func(ctx context.Context, workspaceID string, variableID string) error
+	// DeleteCalls records the arguments of every call to Delete.
+	DeleteCalls []VariablesDeleteCall
+}
+
+// VariablesListCall holds the arguments of a single call to Variables.List.
+type VariablesListCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+	Options     tfe.VariableListOptions
+}
+
+// List all the variables associated with the given workspace.
+func (m *Variables) List(ctx context.Context, workspaceID string, options tfe.VariableListOptions) (r0 *tfe.VariableList, r1 error) {
+	m.mu.Lock()
+	m.ListCalls = append(m.ListCalls, VariablesListCall{Ctx: ctx, WorkspaceID: workspaceID, Options: options})
+	fn := m.ListFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID, options)
+}
+
+// VariablesCreateCall holds the arguments of a single call to Variables.Create.
+type VariablesCreateCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+	Options     tfe.VariableCreateOptions
+}
+
+// Create is used to create a new variable.
+func (m *Variables) Create(ctx context.Context, workspaceID string, options tfe.VariableCreateOptions) (r0 *tfe.Variable, r1 error) {
+	m.mu.Lock()
+	m.CreateCalls = append(m.CreateCalls, VariablesCreateCall{Ctx: ctx, WorkspaceID: workspaceID, Options: options})
+	fn := m.CreateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID, options)
+}
+
+// VariablesReadCall holds the arguments of a single call to Variables.Read.
+type VariablesReadCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+	VariableID  string
+}
+
+// Read a variable by its ID.
+func (m *Variables) Read(ctx context.Context, workspaceID string, variableID string) (r0 *tfe.Variable, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, VariablesReadCall{Ctx: ctx, WorkspaceID: workspaceID, VariableID: variableID})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID, variableID)
+}
+
+// VariablesUpdateCall holds the arguments of a single call to Variables.Update.
+type VariablesUpdateCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+	VariableID  string
+	Options     tfe.VariableUpdateOptions
+}
+
+// Update values of an existing variable.
+func (m *Variables) Update(ctx context.Context, workspaceID string, variableID string, options tfe.VariableUpdateOptions) (r0 *tfe.Variable, r1 error) {
+	m.mu.Lock()
+	m.UpdateCalls = append(m.UpdateCalls, VariablesUpdateCall{Ctx: ctx, WorkspaceID: workspaceID, VariableID: variableID, Options: options})
+	fn := m.UpdateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID, variableID, options)
+}
+
+// VariablesDeleteCall holds the arguments of a single call to Variables.Delete.
+type VariablesDeleteCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+	VariableID  string
+}
+
+// Delete a variable by its ID.
+func (m *Variables) Delete(ctx context.Context, workspaceID string, variableID string) (r0 error) {
+	m.mu.Lock()
+	m.DeleteCalls = append(m.DeleteCalls, VariablesDeleteCall{Ctx: ctx, WorkspaceID: workspaceID, VariableID: variableID})
+	fn := m.DeleteFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID, variableID)
+}
@@ -0,0 +1,443 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Compile-time proof of interface implementation.
+var _ tfe.Workspaces = (*Workspaces)(nil)
+
+// Workspaces is a call-recording fake of tfe.Workspaces.
+//
+// Each method records its arguments in the matching Calls field and then
+// invokes the matching Func field. If the Func field is nil, the method
+// returns zero values.
+type Workspaces struct {
+	mu sync.Mutex
+
+	// ListFunc is invoked by List.
+	ListFunc func(ctx context.Context, organization string, options tfe.WorkspaceListOptions) (*tfe.WorkspaceList, error)
+	// ListCalls records the arguments of every call to List.
+	ListCalls []WorkspacesListCall
+
+	// CreateFunc is invoked by Create.
+	CreateFunc func(ctx context.Context, organization string, options tfe.WorkspaceCreateOptions) (*tfe.Workspace, error)
+	// CreateCalls records the arguments of every call to Create.
+	CreateCalls []WorkspacesCreateCall
+
+	// ReadFunc is invoked by Read.
+	ReadFunc func(ctx context.Context, organization string, workspace string) (*tfe.Workspace, error)
+	// ReadCalls records the arguments of every call to Read.
+	ReadCalls []WorkspacesReadCall
+
+	// ReadWithOptionsFunc is invoked by ReadWithOptions.
+	ReadWithOptionsFunc func(ctx context.Context, organization string, workspace string, options tfe.WorkspaceReadOptions) (*tfe.Workspace, error)
+	// ReadWithOptionsCalls records the arguments of every call to ReadWithOptions.
+	ReadWithOptionsCalls []WorkspacesReadWithOptionsCall
+
+	// ReadByIDFunc is invoked by ReadByID.
+	ReadByIDFunc func(ctx context.Context, workspaceID string) (*tfe.Workspace, error)
+	// ReadByIDCalls records the arguments of every call to ReadByID.
+	ReadByIDCalls []WorkspacesReadByIDCall
+
+	// ReadByIDWithOptionsFunc is invoked by ReadByIDWithOptions.
+	ReadByIDWithOptionsFunc func(ctx context.Context, workspaceID string, options tfe.WorkspaceReadOptions) (*tfe.Workspace, error)
+	// ReadByIDWithOptionsCalls records the arguments of every call to ReadByIDWithOptions.
+	ReadByIDWithOptionsCalls []WorkspacesReadByIDWithOptionsCall
+
+	// UpdateFunc is invoked by Update.
+	UpdateFunc func(ctx context.Context, organization string, workspace string, options tfe.WorkspaceUpdateOptions) (*tfe.Workspace, error)
+	// UpdateCalls records the arguments of every call to Update.
+	UpdateCalls []WorkspacesUpdateCall
+
+	// UpdateByIDFunc is invoked by UpdateByID.
+	UpdateByIDFunc func(ctx context.Context, workspaceID string, options tfe.WorkspaceUpdateOptions) (*tfe.Workspace, error)
+	// UpdateByIDCalls records the arguments of every call to UpdateByID.
+	UpdateByIDCalls []WorkspacesUpdateByIDCall
+
+	// DeleteFunc is invoked by Delete.
+	DeleteFunc func(ctx context.Context, organization string, workspace string) error
+	// DeleteCalls records the arguments of every call to Delete.
+	DeleteCalls []WorkspacesDeleteCall
+
+	// DeleteByIDFunc is invoked by DeleteByID.
+	DeleteByIDFunc func(ctx context.Context, workspaceID string) error
+	// DeleteByIDCalls records the arguments of every call to DeleteByID.
+	DeleteByIDCalls []WorkspacesDeleteByIDCall
+
+	// RemoveVCSConnectionFunc is invoked by RemoveVCSConnection.
+	RemoveVCSConnectionFunc func(ctx context.Context, organization string, workspace string) (*tfe.Workspace, error)
+	// RemoveVCSConnectionCalls records the arguments of every call to RemoveVCSConnection.
+	RemoveVCSConnectionCalls []WorkspacesRemoveVCSConnectionCall
+
+	// RemoveVCSConnectionByIDFunc is invoked by RemoveVCSConnectionByID.
+	RemoveVCSConnectionByIDFunc func(ctx context.Context, workspaceID string) (*tfe.Workspace, error)
+	// RemoveVCSConnectionByIDCalls records the arguments of every call to RemoveVCSConnectionByID.
+	RemoveVCSConnectionByIDCalls []WorkspacesRemoveVCSConnectionByIDCall
+
+	// LockFunc is invoked by Lock.
+	LockFunc func(ctx context.Context, workspaceID string, options tfe.WorkspaceLockOptions) (*tfe.Workspace, error)
+	// LockCalls records the arguments of every call to Lock.
+	LockCalls []WorkspacesLockCall
+
+	// UnlockFunc is invoked by Unlock.
+	UnlockFunc func(ctx context.Context, workspaceID string) (*tfe.Workspace, error)
+	// UnlockCalls records the arguments of every call to Unlock.
+	UnlockCalls []WorkspacesUnlockCall
+
+	// ForceUnlockFunc is invoked by ForceUnlock.
+	ForceUnlockFunc func(ctx context.Context, workspaceID string) (*tfe.Workspace, error)
+	// ForceUnlockCalls records the arguments of every call to ForceUnlock.
+	ForceUnlockCalls []WorkspacesForceUnlockCall
+
+	// AssignSSHKeyFunc is invoked by AssignSSHKey.
+	AssignSSHKeyFunc func(ctx context.Context, workspaceID string, options tfe.WorkspaceAssignSSHKeyOptions) (*tfe.Workspace, error)
+	// AssignSSHKeyCalls records the arguments of every call to AssignSSHKey.
+	AssignSSHKeyCalls []WorkspacesAssignSSHKeyCall
+
+	// UnassignSSHKeyFunc is invoked by UnassignSSHKey.
+	UnassignSSHKeyFunc func(ctx context.Context, workspaceID string) (*tfe.Workspace, error)
+	// UnassignSSHKeyCalls records the arguments of every call to UnassignSSHKey.
+	UnassignSSHKeyCalls []WorkspacesUnassignSSHKeyCall
+}
+
+// WorkspacesListCall holds the arguments of a single call to Workspaces.List.
+type WorkspacesListCall struct {
+	Ctx          context.Context
+	Organization string
+	Options      tfe.WorkspaceListOptions
+}
+
+// List all the workspaces within an organization.
+func (m *Workspaces) List(ctx context.Context, organization string, options tfe.WorkspaceListOptions) (r0 *tfe.WorkspaceList, r1 error) {
+	m.mu.Lock()
+	m.ListCalls = append(m.ListCalls, WorkspacesListCall{Ctx: ctx, Organization: organization, Options: options})
+	fn := m.ListFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, options)
+}
+
+// WorkspacesCreateCall holds the arguments of a single call to Workspaces.Create.
+type WorkspacesCreateCall struct {
+	Ctx          context.Context
+	Organization string
+	Options      tfe.WorkspaceCreateOptions
+}
+
+// Create is used to create a new workspace.
+func (m *Workspaces) Create(ctx context.Context, organization string, options tfe.WorkspaceCreateOptions) (r0 *tfe.Workspace, r1 error) {
+	m.mu.Lock()
+	m.CreateCalls = append(m.CreateCalls, WorkspacesCreateCall{Ctx: ctx, Organization: organization, Options: options})
+	fn := m.CreateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, options)
+}
+
+// WorkspacesReadCall holds the arguments of a single call to Workspaces.Read.
+type WorkspacesReadCall struct {
+	Ctx          context.Context
+	Organization string
+	Workspace    string
+}
+
+// Read a workspace by its name.
+func (m *Workspaces) Read(ctx context.Context, organization string, workspace string) (r0 *tfe.Workspace, r1 error) {
+	m.mu.Lock()
+	m.ReadCalls = append(m.ReadCalls, WorkspacesReadCall{Ctx: ctx, Organization: organization, Workspace: workspace})
+	fn := m.ReadFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, workspace)
+}
+
+// WorkspacesReadWithOptionsCall holds the arguments of a single call to Workspaces.ReadWithOptions.
+type WorkspacesReadWithOptionsCall struct {
+	Ctx          context.Context
+	Organization string
+	Workspace    string
+	Options      tfe.WorkspaceReadOptions
+}
+
+// ReadWithOptions reads a workspace by its name with the given options.
+func (m *Workspaces) ReadWithOptions(ctx context.Context, organization string, workspace string, options tfe.WorkspaceReadOptions) (r0 *tfe.Workspace, r1 error) {
+	m.mu.Lock()
+	m.ReadWithOptionsCalls = append(m.ReadWithOptionsCalls, WorkspacesReadWithOptionsCall{Ctx: ctx, Organization: organization, Workspace: workspace, Options: options})
+	fn := m.ReadWithOptionsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, workspace, options)
+}
+
+// WorkspacesReadByIDCall holds the arguments of a single call to Workspaces.ReadByID.
+type WorkspacesReadByIDCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+}
+
+// ReadByID reads a workspace by its ID.
+func (m *Workspaces) ReadByID(ctx context.Context, workspaceID string) (r0 *tfe.Workspace, r1 error) {
+	m.mu.Lock()
+	m.ReadByIDCalls = append(m.ReadByIDCalls, WorkspacesReadByIDCall{Ctx: ctx, WorkspaceID: workspaceID})
+	fn := m.ReadByIDFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID)
+}
+
+// WorkspacesReadByIDWithOptionsCall holds the arguments of a single call to Workspaces.ReadByIDWithOptions.
+type WorkspacesReadByIDWithOptionsCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+	Options     tfe.WorkspaceReadOptions
+}
+
+// ReadByIDWithOptions reads a workspace by its ID with the given options.
+func (m *Workspaces) ReadByIDWithOptions(ctx context.Context, workspaceID string, options tfe.WorkspaceReadOptions) (r0 *tfe.Workspace, r1 error) {
+	m.mu.Lock()
+	m.ReadByIDWithOptionsCalls = append(m.ReadByIDWithOptionsCalls, WorkspacesReadByIDWithOptionsCall{Ctx: ctx, WorkspaceID: workspaceID, Options: options})
+	fn := m.ReadByIDWithOptionsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID, options)
+}
+
+// WorkspacesUpdateCall holds the arguments of a single call to Workspaces.Update.
+type WorkspacesUpdateCall struct {
+	Ctx          context.Context
+	Organization string
+	Workspace    string
+	Options      tfe.WorkspaceUpdateOptions
+}
+
+// Update settings of an existing workspace.
+func (m *Workspaces) Update(ctx context.Context, organization string, workspace string, options tfe.WorkspaceUpdateOptions) (r0 *tfe.Workspace, r1 error) {
+	m.mu.Lock()
+	m.UpdateCalls = append(m.UpdateCalls, WorkspacesUpdateCall{Ctx: ctx, Organization: organization, Workspace: workspace, Options: options})
+	fn := m.UpdateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, workspace, options)
+}
+
+// WorkspacesUpdateByIDCall holds the arguments of a single call to Workspaces.UpdateByID.
+type WorkspacesUpdateByIDCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+	Options     tfe.WorkspaceUpdateOptions
+}
+
+// UpdateByID updates the settings of an existing workspace.
+func (m *Workspaces) UpdateByID(ctx context.Context, workspaceID string, options tfe.WorkspaceUpdateOptions) (r0 *tfe.Workspace, r1 error) {
+	m.mu.Lock()
+	m.UpdateByIDCalls = append(m.UpdateByIDCalls, WorkspacesUpdateByIDCall{Ctx: ctx, WorkspaceID: workspaceID, Options: options})
+	fn := m.UpdateByIDFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID, options)
+}
+
+// WorkspacesDeleteCall holds the arguments of a single call to Workspaces.Delete.
+type WorkspacesDeleteCall struct {
+	Ctx          context.Context
+	Organization string
+	Workspace    string
+}
+
+// Delete a workspace by its name.
+func (m *Workspaces) Delete(ctx context.Context, organization string, workspace string) (r0 error) {
+	m.mu.Lock()
+	m.DeleteCalls = append(m.DeleteCalls, WorkspacesDeleteCall{Ctx: ctx, Organization: organization, Workspace: workspace})
+	fn := m.DeleteFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, workspace)
+}
+
+// WorkspacesDeleteByIDCall holds the arguments of a single call to Workspaces.DeleteByID.
+type WorkspacesDeleteByIDCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+}
+
+// DeleteByID deletes a workspace by its ID.
+func (m *Workspaces) DeleteByID(ctx context.Context, workspaceID string) (r0 error) {
+	m.mu.Lock()
+	m.DeleteByIDCalls = append(m.DeleteByIDCalls, WorkspacesDeleteByIDCall{Ctx: ctx, WorkspaceID: workspaceID})
+	fn := m.DeleteByIDFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID)
+}
+
+// WorkspacesRemoveVCSConnectionCall holds the arguments of a single call to Workspaces.RemoveVCSConnection.
+type WorkspacesRemoveVCSConnectionCall struct {
+	Ctx          context.Context
+	Organization string
+	Workspace    string
+}
+
+// RemoveVCSConnection from a workspace.
+func (m *Workspaces) RemoveVCSConnection(ctx context.Context, organization string, workspace string) (r0 *tfe.Workspace, r1 error) {
+	m.mu.Lock()
+	m.RemoveVCSConnectionCalls = append(m.RemoveVCSConnectionCalls, WorkspacesRemoveVCSConnectionCall{Ctx: ctx, Organization: organization, Workspace: workspace})
+	fn := m.RemoveVCSConnectionFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, organization, workspace)
+}
+
+// WorkspacesRemoveVCSConnectionByIDCall holds the arguments of a single call to Workspaces.RemoveVCSConnectionByID.
+type WorkspacesRemoveVCSConnectionByIDCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+}
+
+// RemoveVCSConnectionByID removes a VCS connection from a workspace.
+func (m *Workspaces) RemoveVCSConnectionByID(ctx context.Context, workspaceID string) (r0 *tfe.Workspace, r1 error) {
+	m.mu.Lock()
+	m.RemoveVCSConnectionByIDCalls = append(m.RemoveVCSConnectionByIDCalls, WorkspacesRemoveVCSConnectionByIDCall{Ctx: ctx, WorkspaceID: workspaceID})
+	fn := m.RemoveVCSConnectionByIDFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID)
+}
+
+// WorkspacesLockCall holds the arguments of a single call to Workspaces.Lock.
+type WorkspacesLockCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+	Options     tfe.WorkspaceLockOptions
+}
+
+// Lock a workspace by its ID.
+func (m *Workspaces) Lock(ctx context.Context, workspaceID string, options tfe.WorkspaceLockOptions) (r0 *tfe.Workspace, r1 error) {
+	m.mu.Lock()
+	m.LockCalls = append(m.LockCalls, WorkspacesLockCall{Ctx: ctx, WorkspaceID: workspaceID, Options: options})
+	fn := m.LockFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID, options)
+}
+
+// WorkspacesUnlockCall holds the arguments of a single call to Workspaces.Unlock.
+type WorkspacesUnlockCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+}
+
+// Unlock a workspace by its ID.
+func (m *Workspaces) Unlock(ctx context.Context, workspaceID string) (r0 *tfe.Workspace, r1 error) {
+	m.mu.Lock()
+	m.UnlockCalls = append(m.UnlockCalls, WorkspacesUnlockCall{Ctx: ctx, WorkspaceID: workspaceID})
+	fn := m.UnlockFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID)
+}
+
+// WorkspacesForceUnlockCall holds the arguments of a single call to Workspaces.ForceUnlock.
+type WorkspacesForceUnlockCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+}
+
+// ForceUnlock a workspace by its ID.
+func (m *Workspaces) ForceUnlock(ctx context.Context, workspaceID string) (r0 *tfe.Workspace, r1 error) {
+	m.mu.Lock()
+	m.ForceUnlockCalls = append(m.ForceUnlockCalls, WorkspacesForceUnlockCall{Ctx: ctx, WorkspaceID: workspaceID})
+	fn := m.ForceUnlockFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID)
+}
+
+// WorkspacesAssignSSHKeyCall holds the arguments of a single call to Workspaces.AssignSSHKey.
+type WorkspacesAssignSSHKeyCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+	Options     tfe.WorkspaceAssignSSHKeyOptions
+}
+
+// AssignSSHKey to a workspace.
+func (m *Workspaces) AssignSSHKey(ctx context.Context, workspaceID string, options tfe.WorkspaceAssignSSHKeyOptions) (r0 *tfe.Workspace, r1 error) {
+	m.mu.Lock()
+	m.AssignSSHKeyCalls = append(m.AssignSSHKeyCalls, WorkspacesAssignSSHKeyCall{Ctx: ctx, WorkspaceID: workspaceID, Options: options})
+	fn := m.AssignSSHKeyFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID, options)
+}
+
+// WorkspacesUnassignSSHKeyCall holds the arguments of a single call to Workspaces.UnassignSSHKey.
+type WorkspacesUnassignSSHKeyCall struct {
+	Ctx         context.Context
+	WorkspaceID string
+}
+
+// UnassignSSHKey from a workspace.
+func (m *Workspaces) UnassignSSHKey(ctx context.Context, workspaceID string) (r0 *tfe.Workspace, r1 error) {
+	m.mu.Lock()
+	m.UnassignSSHKeyCalls = append(m.UnassignSSHKeyCalls, WorkspacesUnassignSSHKeyCall{Ctx: ctx, WorkspaceID: workspaceID})
+	fn := m.UnassignSSHKeyFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		return
+	}
+	return fn(ctx, workspaceID)
+}
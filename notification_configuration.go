@@ -114,7 +114,7 @@ func (s *notificationConfigurations) List(ctx context.Context, workspaceID strin
 	}
 
 	u := fmt.Sprintf("workspaces/%s/notification-configurations", url.QueryEscape(workspaceID))
-	req, err := s.client.newRequest("GET", u, options)
+	req, err := s.client.newRequest("NotificationConfigurations.List", "GET", u, options)
 	if err != nil {
 		return nil, err
 	}
@@ -160,23 +160,17 @@ type NotificationConfigurationCreateOptions struct {
 	EmailUsers []*User `jsonapi:"relation,users,omitempty"`
 }
 
-func (o NotificationConfigurationCreateOptions) valid() error {
-	if o.DestinationType == nil {
-		return errors.New("destination type is required")
+// Validate checks that the destination type, enabled and name are set, and
+// that generic and Slack destinations have a URL.
+func (o NotificationConfigurationCreateOptions) Validate() error {
+	var v validator
+	v.check(o.DestinationType != nil, "destination-type", "destination type is required")
+	v.check(o.Enabled != nil, "enabled", "enabled is required")
+	v.check(validString(o.Name), "name", "name is required")
+	if o.DestinationType != nil && (*o.DestinationType == NotificationDestinationTypeGeneric || *o.DestinationType == NotificationDestinationTypeSlack) {
+		v.check(o.URL != nil, "url", "url is required")
 	}
-	if o.Enabled == nil {
-		return errors.New("enabled is required")
-	}
-	if !validString(o.Name) {
-		return errors.New("name is required")
-	}
-
-	if *o.DestinationType == NotificationDestinationTypeGeneric || *o.DestinationType == NotificationDestinationTypeSlack {
-		if o.URL == nil {
-			return errors.New("url is required")
-		}
-	}
-	return nil
+	return v.err()
 }
 
 // Creates a notification configuration with the given options.
@@ -184,7 +178,7 @@ func (s *notificationConfigurations) Create(ctx context.Context, workspaceID str
 	if !validStringID(&workspaceID) {
 		return nil, errors.New("invalid value for workspace ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -192,7 +186,7 @@ func (s *notificationConfigurations) Create(ctx context.Context, workspaceID str
 	options.ID = ""
 
 	u := fmt.Sprintf("workspaces/%s/notification-configurations", url.QueryEscape(workspaceID))
-	req, err := s.client.newRequest("POST", u, &options)
+	req, err := s.client.newRequest("NotificationConfigurations.Create", "POST", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -213,7 +207,7 @@ func (s *notificationConfigurations) Read(ctx context.Context, notificationConfi
 	}
 
 	u := fmt.Sprintf("notification-configurations/%s", url.QueryEscape(notificationConfigurationID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("NotificationConfigurations.Read", "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -256,17 +250,28 @@ type NotificationConfigurationUpdateOptions struct {
 	EmailUsers []*User `jsonapi:"relation,users,omitempty"`
 }
 
+// Validate checks that the name is not empty when set.
+func (o NotificationConfigurationUpdateOptions) Validate() error {
+	var v validator
+	v.check(o.Name == nil || *o.Name != "", "name", "invalid value for name")
+	return v.err()
+}
+
 // Updates a notification configuration with the given options.
 func (s *notificationConfigurations) Update(ctx context.Context, notificationConfigurationID string, options NotificationConfigurationUpdateOptions) (*NotificationConfiguration, error) {
 	if !validStringID(&notificationConfigurationID) {
 		return nil, errors.New("invalid value for notification configuration ID")
 	}
 
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Make sure we don't send a user provided ID.
 	options.ID = ""
 
 	u := fmt.Sprintf("notification-configurations/%s", url.QueryEscape(notificationConfigurationID))
-	req, err := s.client.newRequest("PATCH", u, &options)
+	req, err := s.client.newRequest("NotificationConfigurations.Update", "PATCH", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -287,7 +292,7 @@ func (s *notificationConfigurations) Delete(ctx context.Context, notificationCon
 	}
 
 	u := fmt.Sprintf("notification-configurations/%s", url.QueryEscape(notificationConfigurationID))
-	req, err := s.client.newRequest("DELETE", u, nil)
+	req, err := s.client.newRequest("NotificationConfigurations.Delete", "DELETE", u, nil)
 	if err != nil {
 		return err
 	}
@@ -304,7 +309,7 @@ func (s *notificationConfigurations) Verify(ctx context.Context, notificationCon
 
 	u := fmt.Sprintf(
 		"notification-configurations/%s/actions/verify", url.QueryEscape(notificationConfigurationID))
-	req, err := s.client.newRequest("POST", u, nil)
+	req, err := s.client.newRequest("NotificationConfigurations.Verify", "POST", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -168,7 +169,7 @@ func TestNotificationConfigurationRead(t *testing.T) {
 
 	t.Run("when the notification configuration does not exist", func(t *testing.T) {
 		_, err := client.NotificationConfigurations.Read(ctx, "nonexisting")
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("when the notification configuration ID is invalid", func(t *testing.T) {
@@ -255,7 +256,7 @@ func TestNotificationConfigurationUpdate(t *testing.T) {
 
 	t.Run("when the notification configuration does not exist", func(t *testing.T) {
 		_, err := client.NotificationConfigurations.Update(ctx, "nonexisting", NotificationConfigurationUpdateOptions{})
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("when the notification configuration ID is invalid", func(t *testing.T) {
@@ -278,12 +279,12 @@ func TestNotificationConfigurationDelete(t *testing.T) {
 		require.NoError(t, err)
 
 		_, err = client.NotificationConfigurations.Read(ctx, ncTest.ID)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("when the notification configuration does not exist", func(t *testing.T) {
 		err := client.NotificationConfigurations.Delete(ctx, "nonexisting")
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("when the notification configuration ID is invalid", func(t *testing.T) {
@@ -306,7 +307,7 @@ func TestNotificationConfigurationVerify(t *testing.T) {
 
 	t.Run("when the notification configuration does not exists", func(t *testing.T) {
 		_, err := client.NotificationConfigurations.Verify(ctx, "nonexisting")
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("when the notification configuration ID is invalid", func(t *testing.T) {
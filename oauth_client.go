@@ -92,7 +92,7 @@ func (s *oAuthClients) List(ctx context.Context, organization string, options OA
 	}
 
 	u := fmt.Sprintf("organizations/%s/oauth-clients", url.QueryEscape(organization))
-	req, err := s.client.newRequest("GET", u, &options)
+	req, err := s.client.newRequest("OAuthClients.List", "GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -127,23 +127,20 @@ type OAuthClientCreateOptions struct {
 	ServiceProvider *ServiceProviderType `jsonapi:"attr,service-provider"`
 }
 
-func (o OAuthClientCreateOptions) valid() error {
-	if !validString(o.APIURL) {
-		return errors.New("API URL is required")
-	}
-	if !validString(o.HTTPURL) {
-		return errors.New("HTTP URL is required")
-	}
-	if !validString(o.OAuthToken) {
-		return errors.New("OAuth token is required")
-	}
-	if o.ServiceProvider == nil {
-		return errors.New("service provider is required")
-	}
-	if validString(o.PrivateKey) && *o.ServiceProvider != *ServiceProvider(ServiceProviderAzureDevOpsServer) {
-		return errors.New("Private Key can only be present with Azure DevOps Server service provider")
-	}
-	return nil
+// Validate checks that the API URL, HTTP URL, OAuth token and service
+// provider are set, and that a private key is only used with Azure DevOps
+// Server.
+func (o OAuthClientCreateOptions) Validate() error {
+	var v validator
+	v.check(validString(o.APIURL), "api-url", "API URL is required")
+	v.check(validString(o.HTTPURL), "http-url", "HTTP URL is required")
+	v.check(validString(o.OAuthToken), "oauth-token-string", "OAuth token is required")
+	v.check(o.ServiceProvider != nil, "service-provider", "service provider is required")
+	if o.ServiceProvider != nil && validString(o.PrivateKey) {
+		v.check(*o.ServiceProvider == ServiceProviderAzureDevOpsServer, "private-key",
+			"Private Key can only be present with Azure DevOps Server service provider")
+	}
+	return v.err()
 }
 
 // Create an OAuth client to connect an organization and a VCS provider.
@@ -151,7 +148,7 @@ func (s *oAuthClients) Create(ctx context.Context, organization string, options
 	if !validStringID(&organization) {
 		return nil, errors.New("invalid value for organization")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -159,7 +156,7 @@ func (s *oAuthClients) Create(ctx context.Context, organization string, options
 	options.ID = ""
 
 	u := fmt.Sprintf("organizations/%s/oauth-clients", url.QueryEscape(organization))
-	req, err := s.client.newRequest("POST", u, &options)
+	req, err := s.client.newRequest("OAuthClients.Create", "POST", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -180,7 +177,7 @@ func (s *oAuthClients) Read(ctx context.Context, oAuthClientID string) (*OAuthCl
 	}
 
 	u := fmt.Sprintf("oauth-clients/%s", url.QueryEscape(oAuthClientID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("OAuthClients.Read", "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -201,7 +198,7 @@ func (s *oAuthClients) Delete(ctx context.Context, oAuthClientID string) error {
 	}
 
 	u := fmt.Sprintf("oauth-clients/%s", url.QueryEscape(oAuthClientID))
-	req, err := s.client.newRequest("DELETE", u, nil)
+	req, err := s.client.newRequest("OAuthClients.Delete", "DELETE", u, nil)
 	if err != nil {
 		return err
 	}
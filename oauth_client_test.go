@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"context"
+	"errors"
 	"os"
 	"testing"
 
@@ -186,7 +187,7 @@ func TestOAuthClientsRead(t *testing.T) {
 	t.Run("when the OAuth client does not exist", func(t *testing.T) {
 		oc, err := client.OAuthClients.Read(ctx, "nonexisting")
 		assert.Nil(t, oc)
-		assert.Equal(t, ErrResourceNotFound, err)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("without a valid OAuth client ID", func(t *testing.T) {
@@ -211,12 +212,12 @@ func TestOAuthClientsDelete(t *testing.T) {
 
 		// Try loading the OAuth client - it should fail.
 		_, err = client.OAuthClients.Read(ctx, ocTest.ID)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("when the OAuth client does not exist", func(t *testing.T) {
 		err := client.OAuthClients.Delete(ctx, ocTest.ID)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("when the OAuth client ID is invalid", func(t *testing.T) {
@@ -234,7 +235,7 @@ func TestOAuthClientsCreateOptionsValid(t *testing.T) {
 			ServiceProvider: ServiceProvider(ServiceProviderGithub),
 		}
 
-		err := options.valid()
+		err := options.Validate()
 		assert.Nil(t, err)
 	})
 
@@ -245,7 +246,7 @@ func TestOAuthClientsCreateOptionsValid(t *testing.T) {
 			ServiceProvider: ServiceProvider(ServiceProviderGithub),
 		}
 
-		err := options.valid()
+		err := options.Validate()
 		assert.EqualError(t, err, "API URL is required")
 	})
 
@@ -256,7 +257,7 @@ func TestOAuthClientsCreateOptionsValid(t *testing.T) {
 			ServiceProvider: ServiceProvider(ServiceProviderGithub),
 		}
 
-		err := options.valid()
+		err := options.Validate()
 		assert.EqualError(t, err, "HTTP URL is required")
 	})
 
@@ -267,7 +268,7 @@ func TestOAuthClientsCreateOptionsValid(t *testing.T) {
 			ServiceProvider: ServiceProvider(ServiceProviderGithub),
 		}
 
-		err := options.valid()
+		err := options.Validate()
 		assert.EqualError(t, err, "OAuth token is required")
 	})
 
@@ -278,7 +279,7 @@ func TestOAuthClientsCreateOptionsValid(t *testing.T) {
 			OAuthToken: String("NOTHING"),
 		}
 
-		err := options.valid()
+		err := options.Validate()
 		assert.EqualError(t, err, "service provider is required")
 	})
 
@@ -290,7 +291,7 @@ func TestOAuthClientsCreateOptionsValid(t *testing.T) {
 			ServiceProvider: ServiceProvider(ServiceProviderGitlabEE),
 		}
 
-		err := options.valid()
+		err := options.Validate()
 		assert.Nil(t, err)
 	})
 
@@ -303,7 +304,7 @@ func TestOAuthClientsCreateOptionsValid(t *testing.T) {
 			PrivateKey:      String(""),
 		}
 
-		err := options.valid()
+		err := options.Validate()
 		assert.Nil(t, err)
 	})
 
@@ -316,7 +317,7 @@ func TestOAuthClientsCreateOptionsValid(t *testing.T) {
 			PrivateKey:      String("NOTHING"),
 		}
 
-		err := options.valid()
+		err := options.Validate()
 		assert.EqualError(t, err, "Private Key can only be present with Azure DevOps Server service provider")
 	})
 
@@ -329,7 +330,7 @@ func TestOAuthClientsCreateOptionsValid(t *testing.T) {
 			PrivateKey:      String("NOTHING"),
 		}
 
-		err := options.valid()
+		err := options.Validate()
 		assert.Nil(t, err)
 	})
 }
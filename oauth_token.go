@@ -66,7 +66,7 @@ func (s *oAuthTokens) List(ctx context.Context, organization string, options OAu
 	}
 
 	u := fmt.Sprintf("organizations/%s/oauth-tokens", url.QueryEscape(organization))
-	req, err := s.client.newRequest("GET", u, &options)
+	req, err := s.client.newRequest("OAuthTokens.List", "GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +87,7 @@ func (s *oAuthTokens) Read(ctx context.Context, oAuthTokenID string) (*OAuthToke
 	}
 
 	u := fmt.Sprintf("oauth-tokens/%s", url.QueryEscape(oAuthTokenID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("OAuthTokens.Read", "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -110,17 +110,28 @@ type OAuthTokenUpdateOptions struct {
 	PrivateSSHKey *string `jsonapi:"attr,ssh-key"`
 }
 
+// Validate checks that the private SSH key is not empty when set.
+func (o OAuthTokenUpdateOptions) Validate() error {
+	var v validator
+	v.check(o.PrivateSSHKey == nil || *o.PrivateSSHKey != "", "ssh-key", "invalid value for private SSH key")
+	return v.err()
+}
+
 // Update an existing OAuth token.
 func (s *oAuthTokens) Update(ctx context.Context, oAuthTokenID string, options OAuthTokenUpdateOptions) (*OAuthToken, error) {
 	if !validStringID(&oAuthTokenID) {
 		return nil, errors.New("invalid value for OAuth token ID")
 	}
 
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Make sure we don't send a user provided ID.
 	options.ID = ""
 
 	u := fmt.Sprintf("oauth-tokens/%s", url.QueryEscape(oAuthTokenID))
-	req, err := s.client.newRequest("PATCH", u, &options)
+	req, err := s.client.newRequest("OAuthTokens.Update", "PATCH", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -141,7 +152,7 @@ func (s *oAuthTokens) Delete(ctx context.Context, oAuthTokenID string) error {
 	}
 
 	u := fmt.Sprintf("oauth-tokens/%s", url.QueryEscape(oAuthTokenID))
-	req, err := s.client.newRequest("DELETE", u, nil)
+	req, err := s.client.newRequest("OAuthTokens.Delete", "DELETE", u, nil)
 	if err != nil {
 		return err
 	}
@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -91,7 +92,7 @@ func TestOAuthTokensRead(t *testing.T) {
 	t.Run("when the OAuth token does not exist", func(t *testing.T) {
 		ot, err := client.OAuthTokens.Read(ctx, "nonexisting")
 		assert.Nil(t, ot)
-		assert.Equal(t, ErrResourceNotFound, err)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("without a valid OAuth token ID", func(t *testing.T) {
@@ -174,12 +175,12 @@ func TestOAuthTokensDelete(t *testing.T) {
 
 		// Try loading the OAuth token - it should fail.
 		_, err = client.OAuthTokens.Read(ctx, otTest.ID)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("when the OAuth token does not exist", func(t *testing.T) {
 		err := client.OAuthTokens.Delete(ctx, otTest.ID)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("when the OAuth token ID is invalid", func(t *testing.T) {
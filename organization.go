@@ -135,7 +135,7 @@ type OrganizationListOptions struct {
 
 // List all the organizations visible to the current user.
 func (s *organizations) List(ctx context.Context, options OrganizationListOptions) (*OrganizationList, error) {
-	req, err := s.client.newRequest("GET", "organizations", &options)
+	req, err := s.client.newRequest("Organizations.List", "GET", "organizations", &options)
 	if err != nil {
 		return nil, err
 	}
@@ -176,29 +176,26 @@ type OrganizationCreateOptions struct {
 	OwnersTeamSAMLRoleID *string `jsonapi:"attr,owners-team-saml-role-id,omitempty"`
 }
 
-func (o OrganizationCreateOptions) valid() error {
-	if !validString(o.Name) {
-		return errors.New("name is required")
-	}
-	if !validStringID(o.Name) {
-		return errors.New("invalid value for name")
-	}
-	if !validString(o.Email) {
-		return errors.New("email is required")
-	}
-	return nil
+// Validate checks that the name is a valid organization name and that the
+// email is set.
+func (o OrganizationCreateOptions) Validate() error {
+	var v validator
+	v.check(validString(o.Name), "name", "name is required")
+	v.check(validStringID(o.Name), "name", "invalid value for name")
+	v.check(validString(o.Email), "email", "email is required")
+	return v.err()
 }
 
 // Create a new organization with the given options.
 func (s *organizations) Create(ctx context.Context, options OrganizationCreateOptions) (*Organization, error) {
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
 	// Make sure we don't send a user provided ID.
 	options.ID = ""
 
-	req, err := s.client.newRequest("POST", "organizations", &options)
+	req, err := s.client.newRequest("Organizations.Create", "POST", "organizations", &options)
 	if err != nil {
 		return nil, err
 	}
@@ -219,7 +216,7 @@ func (s *organizations) Read(ctx context.Context, organization string) (*Organiz
 	}
 
 	u := fmt.Sprintf("organizations/%s", url.QueryEscape(organization))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("Organizations.Read", "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -260,17 +257,29 @@ type OrganizationUpdateOptions struct {
 	OwnersTeamSAMLRoleID *string `jsonapi:"attr,owners-team-saml-role-id,omitempty"`
 }
 
+// Validate checks that the name and email are valid when set.
+func (o OrganizationUpdateOptions) Validate() error {
+	var v validator
+	v.check(o.Name == nil || validStringID(o.Name), "name", "invalid value for name")
+	v.check(o.Email == nil || *o.Email != "", "email", "invalid value for email")
+	return v.err()
+}
+
 // Update attributes of an existing organization.
 func (s *organizations) Update(ctx context.Context, organization string, options OrganizationUpdateOptions) (*Organization, error) {
 	if !validStringID(&organization) {
 		return nil, errors.New("invalid value for organization")
 	}
 
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Make sure we don't send a user provided ID.
 	options.ID = ""
 
 	u := fmt.Sprintf("organizations/%s", url.QueryEscape(organization))
-	req, err := s.client.newRequest("PATCH", u, &options)
+	req, err := s.client.newRequest("Organizations.Update", "PATCH", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -291,7 +300,7 @@ func (s *organizations) Delete(ctx context.Context, organization string) error {
 	}
 
 	u := fmt.Sprintf("organizations/%s", url.QueryEscape(organization))
-	req, err := s.client.newRequest("DELETE", u, nil)
+	req, err := s.client.newRequest("Organizations.Delete", "DELETE", u, nil)
 	if err != nil {
 		return err
 	}
@@ -306,7 +315,7 @@ func (s *organizations) Capacity(ctx context.Context, organization string) (*Cap
 	}
 
 	u := fmt.Sprintf("organizations/%s/capacity", url.QueryEscape(organization))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("Organizations.Capacity", "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -327,7 +336,7 @@ func (s *organizations) Entitlements(ctx context.Context, organization string) (
 	}
 
 	u := fmt.Sprintf("organizations/%s/entitlement-set", url.QueryEscape(organization))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("Organizations.Entitlements", "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -353,7 +362,7 @@ func (s *organizations) RunQueue(ctx context.Context, organization string, optio
 	}
 
 	u := fmt.Sprintf("organizations/%s/runs/queue", url.QueryEscape(organization))
-	req, err := s.client.newRequest("GET", u, &options)
+	req, err := s.client.newRequest("Organizations.RunQueue", "GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -78,7 +78,7 @@ func (s *organizationMemberships) List(ctx context.Context, organization string,
 	}
 
 	u := fmt.Sprintf("organizations/%s/organization-memberships", url.QueryEscape(organization))
-	req, err := s.client.newRequest("GET", u, &options)
+	req, err := s.client.newRequest("OrganizationMemberships.List", "GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -101,11 +101,11 @@ type OrganizationMembershipCreateOptions struct {
 	Email *string `jsonapi:"attr,email"`
 }
 
-func (o OrganizationMembershipCreateOptions) valid() error {
-	if o.Email == nil {
-		return errors.New("email is required")
-	}
-	return nil
+// Validate checks that the email is set.
+func (o OrganizationMembershipCreateOptions) Validate() error {
+	var v validator
+	v.check(o.Email != nil, "email", "email is required")
+	return v.err()
 }
 
 // Create an organization membership with the given options.
@@ -113,14 +113,14 @@ func (s *organizationMemberships) Create(ctx context.Context, organization strin
 	if !validStringID(&organization) {
 		return nil, errors.New("invalid value for organization")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
 	options.ID = ""
 
 	u := fmt.Sprintf("organizations/%s/organization-memberships", url.QueryEscape(organization))
-	req, err := s.client.newRequest("POST", u, &options)
+	req, err := s.client.newRequest("OrganizationMemberships.Create", "POST", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -136,7 +136,7 @@ func (s *organizationMemberships) Create(ctx context.Context, organization strin
 
 // Read an organization membership by its ID.
 func (s *organizationMemberships) Read(ctx context.Context, organizationMembershipID string) (*OrganizationMembership, error) {
-	return s.ReadWithOptions(ctx, organizationMembershipID, OrganizationMembershipReadOptions{})
+	return s.readWithOptions(ctx, "OrganizationMemberships.Read", organizationMembershipID, OrganizationMembershipReadOptions{})
 }
 
 // OrganizationMembershipReadOptions represents the options for reading organization memberships.
@@ -146,12 +146,18 @@ type OrganizationMembershipReadOptions struct {
 
 // Read an organization membership by ID with options
 func (s *organizationMemberships) ReadWithOptions(ctx context.Context, organizationMembershipID string, options OrganizationMembershipReadOptions) (*OrganizationMembership, error) {
+	return s.readWithOptions(ctx, "OrganizationMemberships.ReadWithOptions", organizationMembershipID, options)
+}
+
+// readWithOptions implements ReadWithOptions, naming the request after the
+// given operation.
+func (s *organizationMemberships) readWithOptions(ctx context.Context, operation, organizationMembershipID string, options OrganizationMembershipReadOptions) (*OrganizationMembership, error) {
 	if !validStringID(&organizationMembershipID) {
 		return nil, errors.New("invalid value for membership")
 	}
 
 	u := fmt.Sprintf("organization-memberships/%s", url.QueryEscape(organizationMembershipID))
-	req, err := s.client.newRequest("GET", u, &options)
+	req, err := s.client.newRequest(operation, "GET", u, &options)
 
 	mem := &OrganizationMembership{}
 	err = s.client.do(ctx, req, mem)
@@ -169,7 +175,7 @@ func (s *organizationMemberships) Delete(ctx context.Context, organizationMember
 	}
 
 	u := fmt.Sprintf("organization-memberships/%s", url.QueryEscape(organizationMembershipID))
-	req, err := s.client.newRequest("DELETE", u, nil)
+	req, err := s.client.newRequest("OrganizationMemberships.Delete", "DELETE", u, nil)
 	if err != nil {
 		return err
 	}
@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -148,7 +149,7 @@ func TestOrganizationMembershipsRead(t *testing.T) {
 	t.Run("when the membership does not exist", func(t *testing.T) {
 		mem, err := client.OrganizationMemberships.Read(ctx, "nonexisting")
 		assert.Nil(t, mem)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("with invalid membership id", func(t *testing.T) {
@@ -179,7 +180,7 @@ func TestOrganizationMembershipsReadWithOptions(t *testing.T) {
 	t.Run("when the membership does not exist", func(t *testing.T) {
 		mem, err := client.OrganizationMemberships.ReadWithOptions(ctx, "nonexisting", options)
 		assert.Nil(t, mem)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("with invalid membership id", func(t *testing.T) {
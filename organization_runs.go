@@ -0,0 +1,156 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// OrganizationRunListOptions represents the options for listing the runs of
+// all workspaces of an organization.
+type OrganizationRunListOptions struct {
+	// The filters applied to the runs of every workspace. The page and sort
+	// options are ignored, as runs are always listed newest first.
+	Runs RunListOptions
+
+	// The workspaces to list the runs of. All workspaces of the organization
+	// are used when left empty. The page options are ignored.
+	Workspaces WorkspaceListOptions
+
+	// The maximum number of runs to return. All matching runs are returned
+	// when left empty.
+	MaxItems int
+
+	// The maximum number of workspaces whose runs are listed at the same
+	// time. The DefaultBatchConcurrency is used when left empty.
+	Concurrency int
+}
+
+func (o OrganizationRunListOptions) valid() error {
+	var v validator
+	v.check(o.MaxItems >= 0, "max-items", "max items must not be negative")
+	v.check(o.Concurrency >= 0, "concurrency", "concurrency must not be negative")
+	if err := v.err(); err != nil {
+		return err
+	}
+	return o.Runs.valid()
+}
+
+// ListOrganizationRuns lists the runs of all workspaces of an organization,
+// newest first. As the API only lists runs per workspace, the runs of every
+// workspace are listed concurrently and merged, so finding all errored runs
+// of the last day looks like:
+//
+//	runs, err := client.ListOrganizationRuns(ctx, "my-org", tfe.OrganizationRunListOptions{
+//		Runs: tfe.RunListOptions{
+//			Status:       []tfe.RunStatus{tfe.RunErrored},
+//			CreatedAfter: time.Now().Add(-24 * time.Hour),
+//		},
+//	})
+//
+// Listing the runs of a workspace stops at the first page holding runs
+// created before CreatedAfter, or once MaxItems runs were found, so
+// narrowing the time range keeps the number of requests low.
+func (c *Client) ListOrganizationRuns(ctx context.Context, organization string, options OrganizationRunListOptions) ([]*Run, error) {
+	if !validStringID(&organization) {
+		return nil, errors.New("invalid value for organization")
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	it := NewIterator(ctx, func(ctx context.Context, lo ListOptions) (interface{}, error) {
+		wo := options.Workspaces
+		wo.ListOptions = lo
+		return c.Workspaces.List(ctx, organization, wo)
+	}, IteratorOptions{PageSize: 100})
+	defer it.Close()
+
+	var workspaces []*Workspace
+	if err := it.Collect(&workspaces); err != nil {
+		return nil, err
+	}
+
+	ops := make([]BatchOperation, len(workspaces))
+	for i, ws := range workspaces {
+		workspaceID := ws.ID
+		ops[i] = BatchOperation{
+			Name: workspaceID,
+			Fn: func(ctx context.Context) (interface{}, error) {
+				return c.listWorkspaceRuns(ctx, workspaceID, options)
+			},
+		}
+	}
+
+	results, err := RunBatch(ctx, ops, BatchOptions{
+		Concurrency: options.Concurrency,
+		StopOnError: true,
+	})
+	if berr, ok := err.(*BatchError); ok && len(berr.Failed) > 0 {
+		first := berr.Failed[0]
+		return nil, fmt.Errorf("error listing runs of workspace %s: %w", first.Name, first.Err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var runs []*Run
+	for _, r := range results {
+		runs = append(runs, r.Value.([]*Run)...)
+	}
+
+	sort.SliceStable(runs, func(i, j int) bool {
+		if !runs[i].CreatedAt.Equal(runs[j].CreatedAt) {
+			return runs[i].CreatedAt.After(runs[j].CreatedAt)
+		}
+		return runs[i].ID > runs[j].ID
+	})
+	if options.MaxItems > 0 && len(runs) > options.MaxItems {
+		runs = runs[:options.MaxItems]
+	}
+
+	return runs, nil
+}
+
+// listWorkspaceRuns lists the runs of a workspace matching the options,
+// newest first, stopping once no more matching runs can follow.
+func (c *Client) listWorkspaceRuns(ctx context.Context, workspaceID string, options OrganizationRunListOptions) ([]*Run, error) {
+	// The time range is applied here, so the pages can be inspected for
+	// runs that are too old.
+	ro := options.Runs
+	ro.Sort = nil
+	ro.CreatedAfter = time.Time{}
+	ro.CreatedBefore = time.Time{}
+	ro.PageSize = 100
+	if options.MaxItems > 0 && options.MaxItems < ro.PageSize {
+		ro.PageSize = options.MaxItems
+	}
+
+	var runs []*Run
+	for ro.PageNumber = 1; ; ro.PageNumber++ {
+		rl, err := c.Runs.List(ctx, workspaceID, ro)
+		if err != nil {
+			return nil, err
+		}
+
+		tooOld := false
+		for _, r := range rl.Items {
+			if !options.Runs.CreatedAfter.IsZero() && r.CreatedAt.Before(options.Runs.CreatedAfter) {
+				tooOld = true
+				break
+			}
+			if options.Runs.createdWithin(r) {
+				runs = append(runs, r)
+			}
+		}
+
+		if options.MaxItems > 0 && len(runs) >= options.MaxItems {
+			return runs[:options.MaxItems], nil
+		}
+		if tooOld || rl.Pagination == nil || rl.NextPage == 0 {
+			return runs, nil
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testOrganizationRunsWorkspaces struct {
+	Workspaces
+	items []*Workspace
+}
+
+func (s *testOrganizationRunsWorkspaces) List(ctx context.Context, organization string, options WorkspaceListOptions) (*WorkspaceList, error) {
+	return &WorkspaceList{
+		Pagination: &Pagination{CurrentPage: 1, TotalPages: 1, TotalCount: len(s.items)},
+		Items:      s.items,
+	}, nil
+}
+
+// testOrganizationRuns lists the runs of every workspace, newest first, and
+// records the requested pages.
+type testOrganizationRuns struct {
+	Runs
+	runs map[string][]*Run
+
+	mu    sync.Mutex
+	pages map[string]int
+}
+
+func (s *testOrganizationRuns) List(ctx context.Context, workspaceID string, options RunListOptions) (*RunList, error) {
+	runs, ok := s.runs[workspaceID]
+	if !ok {
+		return nil, ErrResourceNotFound
+	}
+
+	s.mu.Lock()
+	s.pages[workspaceID]++
+	s.mu.Unlock()
+
+	start := (options.PageNumber - 1) * options.PageSize
+	end := start + options.PageSize
+	if end > len(runs) {
+		end = len(runs)
+	}
+
+	rl := &RunList{
+		Pagination: &Pagination{CurrentPage: options.PageNumber},
+		Items:      runs[start:end],
+	}
+	if end < len(runs) {
+		rl.NextPage = options.PageNumber + 1
+	}
+	return rl, nil
+}
+
+func testOrganizationRunsClient(runs map[string][]*Run) (*Client, *testOrganizationRuns) {
+	workspaces := &testOrganizationRunsWorkspaces{}
+	for _, id := range []string{"ws-1", "ws-2", "ws-3"} {
+		if _, ok := runs[id]; ok {
+			workspaces.items = append(workspaces.items, &Workspace{ID: id})
+		}
+	}
+
+	rs := &testOrganizationRuns{runs: runs, pages: make(map[string]int)}
+	return &Client{Workspaces: workspaces, Runs: rs}, rs
+}
+
+// testRunsCreatedAt returns runs of the workspace created at the given
+// hours of the first day of 2020, in the given order.
+func testRunsCreatedAt(workspaceID string, hours ...int) []*Run {
+	runs := make([]*Run, len(hours))
+	for i, h := range hours {
+		runs[i] = &Run{
+			ID:        fmt.Sprintf("run-%s-%02d", workspaceID[3:], h),
+			CreatedAt: time.Date(2020, 1, 1, h, 0, 0, 0, time.UTC),
+			Workspace: &Workspace{ID: workspaceID},
+		}
+	}
+	return runs
+}
+
+func testRunIDs(runs []*Run) []string {
+	ids := make([]string, len(runs))
+	for i, r := range runs {
+		ids[i] = r.ID
+	}
+	return ids
+}
+
+func TestListOrganizationRuns(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("merging runs newest first", func(t *testing.T) {
+		client, _ := testOrganizationRunsClient(map[string][]*Run{
+			"ws-1": testRunsCreatedAt("ws-1", 20, 10, 2),
+			"ws-2": testRunsCreatedAt("ws-2", 15, 5),
+			"ws-3": nil,
+		})
+
+		runs, err := client.ListOrganizationRuns(ctx, "my-org", OrganizationRunListOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"run-1-20", "run-2-15", "run-1-10", "run-2-05", "run-1-02"}, testRunIDs(runs))
+	})
+
+	t.Run("with max items", func(t *testing.T) {
+		client, rs := testOrganizationRunsClient(map[string][]*Run{
+			"ws-1": testRunsCreatedAt("ws-1", 23, 22, 21, 20, 19, 18),
+			"ws-2": testRunsCreatedAt("ws-2", 15, 5),
+		})
+
+		runs, err := client.ListOrganizationRuns(ctx, "my-org", OrganizationRunListOptions{MaxItems: 3})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"run-1-23", "run-1-22", "run-1-21"}, testRunIDs(runs))
+		assert.Equal(t, 1, rs.pages["ws-1"])
+	})
+
+	t.Run("with a time range", func(t *testing.T) {
+		client, rs := testOrganizationRunsClient(map[string][]*Run{
+			"ws-1": append(testRunsCreatedAt("ws-1", 23, 12, 11), testRunsCreatedAt("ws-1", make([]int, 300)...)...),
+			"ws-2": testRunsCreatedAt("ws-2", 15, 5),
+		})
+
+		runs, err := client.ListOrganizationRuns(ctx, "my-org", OrganizationRunListOptions{
+			Runs: RunListOptions{
+				CreatedAfter:  time.Date(2020, 1, 1, 5, 0, 0, 0, time.UTC),
+				CreatedBefore: time.Date(2020, 1, 1, 20, 0, 0, 0, time.UTC),
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"run-2-15", "run-1-12", "run-1-11", "run-2-05"}, testRunIDs(runs))
+
+		// Listing stops at the first page holding runs that are too old.
+		assert.Equal(t, 1, rs.pages["ws-1"])
+	})
+
+	t.Run("when listing the runs of a workspace fails", func(t *testing.T) {
+		client, _ := testOrganizationRunsClient(map[string][]*Run{
+			"ws-1": testRunsCreatedAt("ws-1", 1),
+		})
+		client.Workspaces.(*testOrganizationRunsWorkspaces).items = append(
+			client.Workspaces.(*testOrganizationRunsWorkspaces).items,
+			&Workspace{ID: "ws-missing"},
+		)
+
+		runs, err := client.ListOrganizationRuns(ctx, "my-org", OrganizationRunListOptions{})
+		assert.Nil(t, runs)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
+		assert.EqualError(t, err, "error listing runs of workspace ws-missing: resource not found")
+	})
+
+	t.Run("with invalid options", func(t *testing.T) {
+		client, _ := testOrganizationRunsClient(nil)
+
+		_, err := client.ListOrganizationRuns(ctx, "my-org", OrganizationRunListOptions{MaxItems: -1})
+		assert.EqualError(t, err, "max items must not be negative")
+
+		_, err = client.ListOrganizationRuns(ctx, "", OrganizationRunListOptions{})
+		assert.EqualError(t, err, "invalid value for organization")
+	})
+}
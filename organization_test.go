@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -194,7 +195,7 @@ func TestOrganizationsDelete(t *testing.T) {
 
 		// Try fetching the org again - it should error.
 		_, err = client.Organizations.Read(ctx, orgTest.Name)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("with invalid name", func(t *testing.T) {
@@ -277,7 +278,7 @@ func TestOrganizationsEntitlements(t *testing.T) {
 
 	t.Run("when the org does not exist", func(t *testing.T) {
 		_, err := client.Organizations.Entitlements(ctx, randomString(t))
-		assert.Equal(t, ErrResourceNotFound, err)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 }
 
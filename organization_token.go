@@ -48,7 +48,7 @@ func (s *organizationTokens) Generate(ctx context.Context, organization string)
 	}
 
 	u := fmt.Sprintf("organizations/%s/authentication-token", url.QueryEscape(organization))
-	req, err := s.client.newRequest("POST", u, nil)
+	req, err := s.client.newRequest("OrganizationTokens.Generate", "POST", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +69,7 @@ func (s *organizationTokens) Read(ctx context.Context, organization string) (*Or
 	}
 
 	u := fmt.Sprintf("organizations/%s/authentication-token", url.QueryEscape(organization))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("OrganizationTokens.Read", "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +90,7 @@ func (s *organizationTokens) Delete(ctx context.Context, organization string) er
 	}
 
 	u := fmt.Sprintf("organizations/%s/authentication-token", url.QueryEscape(organization))
-	req, err := s.client.newRequest("DELETE", u, nil)
+	req, err := s.client.newRequest("OrganizationTokens.Delete", "DELETE", u, nil)
 	if err != nil {
 		return err
 	}
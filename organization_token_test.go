@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -56,7 +57,7 @@ func TestOrganizationTokensRead(t *testing.T) {
 
 	t.Run("when a token doesn't exists", func(t *testing.T) {
 		ot, err := client.OrganizationTokens.Read(ctx, orgTest.Name)
-		assert.Equal(t, ErrResourceNotFound, err)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 		assert.Nil(t, ot)
 	})
 
@@ -83,7 +84,7 @@ func TestOrganizationTokensDelete(t *testing.T) {
 
 	t.Run("when a token does not exist", func(t *testing.T) {
 		err := client.OrganizationTokens.Delete(ctx, orgTest.Name)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("without valid organization", func(t *testing.T) {
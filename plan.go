@@ -77,7 +77,7 @@ func (s *plans) Read(ctx context.Context, planID string) (*Plan, error) {
 	}
 
 	u := fmt.Sprintf("plans/%s", url.QueryEscape(planID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("Plans.Read", "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
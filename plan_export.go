@@ -85,25 +85,27 @@ type PlanExportCreateOptions struct {
 	DataType *PlanExportDataType `jsonapi:"attr,data-type"`
 }
 
-func (o PlanExportCreateOptions) valid() error {
-	if o.Plan == nil {
-		return errors.New("plan is required")
-	}
-	if o.DataType == nil {
-		return errors.New("data type is required")
-	}
-	return nil
+// Validate checks that the plan and data type are set.
+func (o PlanExportCreateOptions) Validate() error {
+	var v validator
+	v.check(o.Plan != nil, "plan", "plan is required")
+	v.check(o.DataType != nil, "data-type", "data type is required")
+	return v.err()
 }
 
 func (s *planExports) Create(ctx context.Context, options PlanExportCreateOptions) (*PlanExport, error) {
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
 	// Make sure we don't send a user provided ID.
 	options.ID = ""
 
-	req, err := s.client.newRequest("POST", "plan-exports", &options)
+	if err := s.client.requireFeature(ctx, FeaturePlanExports); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.newRequest("PlanExports.Create", "POST", "plan-exports", &options)
 	if err != nil {
 		return nil, err
 	}
@@ -123,8 +125,12 @@ func (s *planExports) Read(ctx context.Context, planExportID string) (*PlanExpor
 		return nil, errors.New("invalid value for plan export ID")
 	}
 
+	if err := s.client.requireFeature(ctx, FeaturePlanExports); err != nil {
+		return nil, err
+	}
+
 	u := fmt.Sprintf("plan-exports/%s", url.QueryEscape(planExportID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("PlanExports.Read", "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -144,8 +150,12 @@ func (s *planExports) Delete(ctx context.Context, planExportID string) error {
 		return errors.New("invalid value for plan export ID")
 	}
 
+	if err := s.client.requireFeature(ctx, FeaturePlanExports); err != nil {
+		return err
+	}
+
 	u := fmt.Sprintf("plan-exports/%s", url.QueryEscape(planExportID))
-	req, err := s.client.newRequest("DELETE", u, nil)
+	req, err := s.client.newRequest("PlanExports.Delete", "DELETE", u, nil)
 	if err != nil {
 		return err
 	}
@@ -159,8 +169,12 @@ func (s *planExports) Download(ctx context.Context, planExportID string) ([]byte
 		return nil, errors.New("invalid value for plan export ID")
 	}
 
+	if err := s.client.requireFeature(ctx, FeaturePlanExports); err != nil {
+		return nil, err
+	}
+
 	u := fmt.Sprintf("plan-exports/%s/download", url.QueryEscape(planExportID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("PlanExports.Download", "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -88,7 +89,7 @@ func TestPlanExportsDelete(t *testing.T) {
 
 	t.Run("when the export does not exist", func(t *testing.T) {
 		err := client.Policies.Delete(ctx, "pe-doesntexist")
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("without a valid ID", func(t *testing.T) {
@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"context"
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -28,7 +29,7 @@ func TestPlansRead(t *testing.T) {
 	t.Run("when the plan does not exist", func(t *testing.T) {
 		p, err := client.Plans.Read(ctx, "nonexisting")
 		assert.Nil(t, p)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("with invalid plan ID", func(t *testing.T) {
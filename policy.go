@@ -54,6 +54,21 @@ const (
 	EnforcementSoft     EnforcementLevel = "soft-mandatory"
 )
 
+// PolicySortKey represents a key policies can be sorted by when listing
+// them.
+type PolicySortKey string
+
+// List all available policy sort keys.
+const (
+	PolicySortName      PolicySortKey = "name"
+	PolicySortUpdatedAt PolicySortKey = "updated-at"
+)
+
+// Desc returns the key for sorting in descending order.
+func (k PolicySortKey) Desc() PolicySortKey {
+	return "-" + k
+}
+
 // PolicyList represents a list of policies..
 type PolicyList struct {
 	*Pagination
@@ -85,6 +100,15 @@ type PolicyListOptions struct {
 
 	// A search string (partial policy name) used to filter the results.
 	Search *string `url:"search[name],omitempty"`
+
+	// The attributes to return per resource type.
+	Fields Fields `url:"fields,omitempty"`
+
+	// The keys to sort the results by, in order of precedence.
+	Sort []PolicySortKey `url:"sort,omitempty,comma"`
+
+	// Additional server-side filters.
+	Filter Filter `url:"filter,omitempty"`
 }
 
 // List all the policies for a given organization
@@ -94,7 +118,7 @@ func (s *policies) List(ctx context.Context, organization string, options Policy
 	}
 
 	u := fmt.Sprintf("organizations/%s/policies", url.QueryEscape(organization))
-	req, err := s.client.newRequest("GET", u, &options)
+	req, err := s.client.newRequest("Policies.List", "GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -129,25 +153,24 @@ type EnforcementOptions struct {
 	Mode *EnforcementLevel `json:"mode"`
 }
 
-func (o PolicyCreateOptions) valid() error {
-	if !validString(o.Name) {
-		return errors.New("name is required")
-	}
-	if !validStringID(o.Name) {
-		return errors.New("invalid value for name")
-	}
-	if o.Enforce == nil {
-		return errors.New("enforce is required")
-	}
-	for _, e := range o.Enforce {
-		if !validString(e.Path) {
-			return errors.New("enforcement path is required")
-		}
-		if e.Mode == nil {
-			return errors.New("enforcement mode is required")
-		}
+// Validate checks that the name is a valid policy name and that every
+// enforcement option has a path and mode.
+func (o PolicyCreateOptions) Validate() error {
+	var v validator
+	v.check(validString(o.Name), "name", "name is required")
+	v.check(validStringID(o.Name), "name", "invalid value for name")
+	v.check(o.Enforce != nil, "enforce", "enforce is required")
+	validEnforcementOptions(&v, o.Enforce)
+	return v.err()
+}
+
+// validEnforcementOptions checks the path and mode of every enforcement
+// option.
+func validEnforcementOptions(v *validator, enforce []*EnforcementOptions) {
+	for _, e := range enforce {
+		v.check(validString(e.Path), "enforce.path", "enforcement path is required")
+		v.check(e.Mode != nil, "enforce.mode", "enforcement mode is required")
 	}
-	return nil
 }
 
 // Create a policy and associate it with an organization.
@@ -155,7 +178,7 @@ func (s *policies) Create(ctx context.Context, organization string, options Poli
 	if !validStringID(&organization) {
 		return nil, errors.New("invalid value for organization")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -163,7 +186,7 @@ func (s *policies) Create(ctx context.Context, organization string, options Poli
 	options.ID = ""
 
 	u := fmt.Sprintf("organizations/%s/policies", url.QueryEscape(organization))
-	req, err := s.client.newRequest("POST", u, &options)
+	req, err := s.client.newRequest("Policies.Create", "POST", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -184,7 +207,7 @@ func (s *policies) Read(ctx context.Context, policyID string) (*Policy, error) {
 	}
 
 	u := fmt.Sprintf("policies/%s", url.QueryEscape(policyID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("Policies.Read", "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -210,17 +233,28 @@ type PolicyUpdateOptions struct {
 	Enforce []*EnforcementOptions `jsonapi:"attr,enforce,omitempty"`
 }
 
+// Validate checks that every enforcement option has a path and mode.
+func (o PolicyUpdateOptions) Validate() error {
+	var v validator
+	validEnforcementOptions(&v, o.Enforce)
+	return v.err()
+}
+
 // Update an existing policy.
 func (s *policies) Update(ctx context.Context, policyID string, options PolicyUpdateOptions) (*Policy, error) {
 	if !validStringID(&policyID) {
 		return nil, errors.New("invalid value for policy ID")
 	}
 
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Make sure we don't send a user provided ID.
 	options.ID = ""
 
 	u := fmt.Sprintf("policies/%s", url.QueryEscape(policyID))
-	req, err := s.client.newRequest("PATCH", u, &options)
+	req, err := s.client.newRequest("Policies.Update", "PATCH", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -241,7 +275,7 @@ func (s *policies) Delete(ctx context.Context, policyID string) error {
 	}
 
 	u := fmt.Sprintf("policies/%s", url.QueryEscape(policyID))
-	req, err := s.client.newRequest("DELETE", u, nil)
+	req, err := s.client.newRequest("Policies.Delete", "DELETE", u, nil)
 	if err != nil {
 		return err
 	}
@@ -256,7 +290,7 @@ func (s *policies) Upload(ctx context.Context, policyID string, content []byte)
 	}
 
 	u := fmt.Sprintf("policies/%s/upload", url.QueryEscape(policyID))
-	req, err := s.client.newRequest("PUT", u, content)
+	req, err := s.client.newRequest("Policies.Upload", "PUT", u, content)
 	if err != nil {
 		return err
 	}
@@ -271,7 +305,7 @@ func (s *policies) Download(ctx context.Context, policyID string) ([]byte, error
 	}
 
 	u := fmt.Sprintf("policies/%s/download", url.QueryEscape(policyID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("Policies.Download", "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
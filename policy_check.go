@@ -123,7 +123,7 @@ func (s *policyChecks) List(ctx context.Context, runID string, options PolicyChe
 	}
 
 	u := fmt.Sprintf("runs/%s/policy-checks", url.QueryEscape(runID))
-	req, err := s.client.newRequest("GET", u, &options)
+	req, err := s.client.newRequest("PolicyChecks.List", "GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -144,7 +144,7 @@ func (s *policyChecks) Read(ctx context.Context, policyCheckID string) (*PolicyC
 	}
 
 	u := fmt.Sprintf("policy-checks/%s", url.QueryEscape(policyCheckID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("PolicyChecks.Read", "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -165,7 +165,7 @@ func (s *policyChecks) Override(ctx context.Context, policyCheckID string) (*Pol
 	}
 
 	u := fmt.Sprintf("policy-checks/%s/actions/override", url.QueryEscape(policyCheckID))
-	req, err := s.client.newRequest("POST", u, nil)
+	req, err := s.client.newRequest("PolicyChecks.Override", "POST", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -205,7 +205,7 @@ func (s *policyChecks) Logs(ctx context.Context, policyCheckID string) (io.Reade
 		}
 
 		u := fmt.Sprintf("policy-checks/%s/output", url.QueryEscape(policyCheckID))
-		req, err := s.client.newRequest("GET", u, nil)
+		req, err := s.client.newRequest("PolicyChecks.Logs", "GET", u, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"context"
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -104,7 +105,7 @@ func TestPolicyChecksRead(t *testing.T) {
 	t.Run("when the policy check does not exist", func(t *testing.T) {
 		pc, err := client.PolicyChecks.Read(ctx, "nonexisting")
 		assert.Nil(t, pc)
-		assert.Equal(t, ErrResourceNotFound, err)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("without a valid policy check ID", func(t *testing.T) {
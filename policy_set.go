@@ -25,6 +25,9 @@ type PolicySets interface {
 	// Read a policy set by its ID.
 	Read(ctx context.Context, policySetID string) (*PolicySet, error)
 
+	// ReadWithOptions reads a policy set by its ID with the given options.
+	ReadWithOptions(ctx context.Context, policySetID string, options PolicySetReadOptions) (*PolicySet, error)
+
 	// Update an existing policy set.
 	Update(ctx context.Context, policySetID string, options PolicySetUpdateOptions) (*PolicySet, error)
 
@@ -51,6 +54,31 @@ type policySets struct {
 	client *Client
 }
 
+// PolicySetSortKey represents a key policy sets can be sorted by when
+// listing them.
+type PolicySetSortKey string
+
+// List all available policy set sort keys.
+const (
+	PolicySetSortName      PolicySetSortKey = "name"
+	PolicySetSortUpdatedAt PolicySetSortKey = "updated-at"
+)
+
+// Desc returns the key for sorting in descending order.
+func (k PolicySetSortKey) Desc() PolicySetSortKey {
+	return "-" + k
+}
+
+// PolicySetIncludeOpt represents a related resource that can be included
+// when reading or listing policy sets.
+type PolicySetIncludeOpt string
+
+// List all available policy set includes.
+const (
+	PolicySetIncludeCurrentVersion PolicySetIncludeOpt = "current_version"
+	PolicySetIncludeNewestVersion  PolicySetIncludeOpt = "newest_version"
+)
+
 // PolicySetList represents a list of policy sets.
 type PolicySetList struct {
 	*Pagination
@@ -71,9 +99,22 @@ type PolicySet struct {
 	UpdatedAt      time.Time `jsonapi:"attr,updated-at,iso8601"`
 
 	// Relations
-	Organization *Organization `jsonapi:"relation,organization"`
-	Policies     []*Policy     `jsonapi:"relation,policies"`
-	Workspaces   []*Workspace  `jsonapi:"relation,workspaces"`
+	Organization   *Organization     `jsonapi:"relation,organization"`
+	Policies       []*Policy         `jsonapi:"relation,policies"`
+	Workspaces     []*Workspace      `jsonapi:"relation,workspaces"`
+	CurrentVersion *PolicySetVersion `jsonapi:"relation,current-version"`
+	NewestVersion  *PolicySetVersion `jsonapi:"relation,newest-version"`
+}
+
+// PolicySetVersion represents a version of the policies of a policy set,
+// as ingressed from its VCS repository.
+type PolicySetVersion struct {
+	ID        string    `jsonapi:"primary,policy-set-versions"`
+	Error     string    `jsonapi:"attr,error"`
+	Source    string    `jsonapi:"attr,source"`
+	Status    string    `jsonapi:"attr,status"`
+	CreatedAt time.Time `jsonapi:"attr,created-at,iso8601"`
+	UpdatedAt time.Time `jsonapi:"attr,updated-at,iso8601"`
 }
 
 // PolicySetListOptions represents the options for listing policy sets.
@@ -82,6 +123,18 @@ type PolicySetListOptions struct {
 
 	// A search string (partial policy set name) used to filter the results.
 	Search *string `url:"search[name],omitempty"`
+
+	// The attributes to return per resource type.
+	Fields Fields `url:"fields,omitempty"`
+
+	// The keys to sort the results by, in order of precedence.
+	Sort []PolicySetSortKey `url:"sort,omitempty,comma"`
+
+	// Additional server-side filters.
+	Filter Filter `url:"filter,omitempty"`
+
+	// The related resources to include in the response.
+	Include []PolicySetIncludeOpt `url:"include,omitempty,comma"`
 }
 
 // List all the policies for a given organization.
@@ -91,7 +144,7 @@ func (s *policySets) List(ctx context.Context, organization string, options Poli
 	}
 
 	u := fmt.Sprintf("organizations/%s/policy-sets", url.QueryEscape(organization))
-	req, err := s.client.newRequest("GET", u, &options)
+	req, err := s.client.newRequest("PolicySets.List", "GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -138,14 +191,12 @@ type PolicySetCreateOptions struct {
 	Workspaces []*Workspace `jsonapi:"relation,workspaces,omitempty"`
 }
 
-func (o PolicySetCreateOptions) valid() error {
-	if !validString(o.Name) {
-		return errors.New("name is required")
-	}
-	if !validStringID(o.Name) {
-		return errors.New("invalid value for name")
-	}
-	return nil
+// Validate checks that the name is a valid policy set name.
+func (o PolicySetCreateOptions) Validate() error {
+	var v validator
+	v.check(validString(o.Name), "name", "name is required")
+	v.check(validStringID(o.Name), "name", "invalid value for name")
+	return v.err()
 }
 
 // Create a policy set and associate it with an organization.
@@ -153,7 +204,7 @@ func (s *policySets) Create(ctx context.Context, organization string, options Po
 	if !validStringID(&organization) {
 		return nil, errors.New("invalid value for organization")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -161,7 +212,7 @@ func (s *policySets) Create(ctx context.Context, organization string, options Po
 	options.ID = ""
 
 	u := fmt.Sprintf("organizations/%s/policy-sets", url.QueryEscape(organization))
-	req, err := s.client.newRequest("POST", u, &options)
+	req, err := s.client.newRequest("PolicySets.Create", "POST", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -177,12 +228,29 @@ func (s *policySets) Create(ctx context.Context, organization string, options Po
 
 // Read a policy set by its ID.
 func (s *policySets) Read(ctx context.Context, policySetID string) (*PolicySet, error) {
+	return s.readWithOptions(ctx, "PolicySets.Read", policySetID, PolicySetReadOptions{})
+}
+
+// PolicySetReadOptions represents the options for reading a policy set.
+type PolicySetReadOptions struct {
+	// The related resources to include in the response.
+	Include []PolicySetIncludeOpt `url:"include,omitempty,comma"`
+}
+
+// ReadWithOptions reads a policy set by its ID with the given options.
+func (s *policySets) ReadWithOptions(ctx context.Context, policySetID string, options PolicySetReadOptions) (*PolicySet, error) {
+	return s.readWithOptions(ctx, "PolicySets.ReadWithOptions", policySetID, options)
+}
+
+// readWithOptions implements ReadWithOptions, naming the request after the
+// given operation.
+func (s *policySets) readWithOptions(ctx context.Context, operation, policySetID string, options PolicySetReadOptions) (*PolicySet, error) {
 	if !validStringID(&policySetID) {
 		return nil, errors.New("invalid value for policy set ID")
 	}
 
 	u := fmt.Sprintf("policy-sets/%s", url.QueryEscape(policySetID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest(operation, "GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -224,11 +292,11 @@ type PolicySetUpdateOptions struct {
 	VCSRepo *VCSRepoOptions `jsonapi:"attr,vcs-repo,omitempty"`
 }
 
-func (o PolicySetUpdateOptions) valid() error {
-	if o.Name != nil && !validStringID(o.Name) {
-		return errors.New("invalid value for name")
-	}
-	return nil
+// Validate checks that the name is a valid policy set name when set.
+func (o PolicySetUpdateOptions) Validate() error {
+	var v validator
+	v.check(o.Name == nil || validStringID(o.Name), "name", "invalid value for name")
+	return v.err()
 }
 
 // Update an existing policy set.
@@ -236,7 +304,7 @@ func (s *policySets) Update(ctx context.Context, policySetID string, options Pol
 	if !validStringID(&policySetID) {
 		return nil, errors.New("invalid value for policy set ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -244,7 +312,7 @@ func (s *policySets) Update(ctx context.Context, policySetID string, options Pol
 	options.ID = ""
 
 	u := fmt.Sprintf("policy-sets/%s", url.QueryEscape(policySetID))
-	req, err := s.client.newRequest("PATCH", u, &options)
+	req, err := s.client.newRequest("PolicySets.Update", "PATCH", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -265,14 +333,12 @@ type PolicySetAddPoliciesOptions struct {
 	Policies []*Policy
 }
 
-func (o PolicySetAddPoliciesOptions) valid() error {
-	if o.Policies == nil {
-		return errors.New("policies is required")
-	}
-	if len(o.Policies) == 0 {
-		return errors.New("must provide at least one policy")
-	}
-	return nil
+// Validate checks that at least one policy is given.
+func (o PolicySetAddPoliciesOptions) Validate() error {
+	var v validator
+	v.check(o.Policies != nil, "policies", "policies is required")
+	v.check(len(o.Policies) > 0, "policies", "must provide at least one policy")
+	return v.err()
 }
 
 // Add policies to a policy set
@@ -280,12 +346,12 @@ func (s *policySets) AddPolicies(ctx context.Context, policySetID string, option
 	if !validStringID(&policySetID) {
 		return errors.New("invalid value for policy set ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return err
 	}
 
 	u := fmt.Sprintf("policy-sets/%s/relationships/policies", url.QueryEscape(policySetID))
-	req, err := s.client.newRequest("POST", u, options.Policies)
+	req, err := s.client.newRequest("PolicySets.AddPolicies", "POST", u, options.Policies)
 	if err != nil {
 		return err
 	}
@@ -300,14 +366,12 @@ type PolicySetRemovePoliciesOptions struct {
 	Policies []*Policy
 }
 
-func (o PolicySetRemovePoliciesOptions) valid() error {
-	if o.Policies == nil {
-		return errors.New("policies is required")
-	}
-	if len(o.Policies) == 0 {
-		return errors.New("must provide at least one policy")
-	}
-	return nil
+// Validate checks that at least one policy is given.
+func (o PolicySetRemovePoliciesOptions) Validate() error {
+	var v validator
+	v.check(o.Policies != nil, "policies", "policies is required")
+	v.check(len(o.Policies) > 0, "policies", "must provide at least one policy")
+	return v.err()
 }
 
 // Remove policies from a policy set
@@ -315,12 +379,12 @@ func (s *policySets) RemovePolicies(ctx context.Context, policySetID string, opt
 	if !validStringID(&policySetID) {
 		return errors.New("invalid value for policy set ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return err
 	}
 
 	u := fmt.Sprintf("policy-sets/%s/relationships/policies", url.QueryEscape(policySetID))
-	req, err := s.client.newRequest("DELETE", u, options.Policies)
+	req, err := s.client.newRequest("PolicySets.RemovePolicies", "DELETE", u, options.Policies)
 	if err != nil {
 		return err
 	}
@@ -335,14 +399,12 @@ type PolicySetAddWorkspacesOptions struct {
 	Workspaces []*Workspace
 }
 
-func (o PolicySetAddWorkspacesOptions) valid() error {
-	if o.Workspaces == nil {
-		return errors.New("workspaces is required")
-	}
-	if len(o.Workspaces) == 0 {
-		return errors.New("must provide at least one workspace")
-	}
-	return nil
+// Validate checks that at least one workspace is given.
+func (o PolicySetAddWorkspacesOptions) Validate() error {
+	var v validator
+	v.check(o.Workspaces != nil, "workspaces", "workspaces is required")
+	v.check(len(o.Workspaces) > 0, "workspaces", "must provide at least one workspace")
+	return v.err()
 }
 
 // Add workspaces to a policy set.
@@ -350,12 +412,12 @@ func (s *policySets) AddWorkspaces(ctx context.Context, policySetID string, opti
 	if !validStringID(&policySetID) {
 		return errors.New("invalid value for policy set ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return err
 	}
 
 	u := fmt.Sprintf("policy-sets/%s/relationships/workspaces", url.QueryEscape(policySetID))
-	req, err := s.client.newRequest("POST", u, options.Workspaces)
+	req, err := s.client.newRequest("PolicySets.AddWorkspaces", "POST", u, options.Workspaces)
 	if err != nil {
 		return err
 	}
@@ -370,14 +432,12 @@ type PolicySetRemoveWorkspacesOptions struct {
 	Workspaces []*Workspace
 }
 
-func (o PolicySetRemoveWorkspacesOptions) valid() error {
-	if o.Workspaces == nil {
-		return errors.New("workspaces is required")
-	}
-	if len(o.Workspaces) == 0 {
-		return errors.New("must provide at least one workspace")
-	}
-	return nil
+// Validate checks that at least one workspace is given.
+func (o PolicySetRemoveWorkspacesOptions) Validate() error {
+	var v validator
+	v.check(o.Workspaces != nil, "workspaces", "workspaces is required")
+	v.check(len(o.Workspaces) > 0, "workspaces", "must provide at least one workspace")
+	return v.err()
 }
 
 // Remove workspaces from a policy set.
@@ -385,12 +445,12 @@ func (s *policySets) RemoveWorkspaces(ctx context.Context, policySetID string, o
 	if !validStringID(&policySetID) {
 		return errors.New("invalid value for policy set ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return err
 	}
 
 	u := fmt.Sprintf("policy-sets/%s/relationships/workspaces", url.QueryEscape(policySetID))
-	req, err := s.client.newRequest("DELETE", u, options.Workspaces)
+	req, err := s.client.newRequest("PolicySets.RemoveWorkspaces", "DELETE", u, options.Workspaces)
 	if err != nil {
 		return err
 	}
@@ -405,7 +465,7 @@ func (s *policySets) Delete(ctx context.Context, policySetID string) error {
 	}
 
 	u := fmt.Sprintf("policy-sets/%s", url.QueryEscape(policySetID))
-	req, err := s.client.newRequest("DELETE", u, nil)
+	req, err := s.client.newRequest("PolicySets.Delete", "DELETE", u, nil)
 	if err != nil {
 		return err
 	}
@@ -73,7 +73,7 @@ func (s *policySetParameters) List(ctx context.Context, policySetID string, opti
 	}
 
 	u := fmt.Sprintf("policy-sets/%s/parameters", policySetID)
-	req, err := s.client.newRequest("GET", u, &options)
+	req, err := s.client.newRequest("PolicySetParameters.List", "GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -105,17 +105,13 @@ type PolicySetParameterCreateOptions struct {
 	Sensitive *bool `jsonapi:"attr,sensitive,omitempty"`
 }
 
-func (o PolicySetParameterCreateOptions) valid() error {
-	if !validString(o.Key) {
-		return errors.New("key is required")
-	}
-	if o.Category == nil {
-		return errors.New("category is required")
-	}
-	if *o.Category != CategoryPolicySet {
-		return errors.New("category must be policy-set")
-	}
-	return nil
+// Validate checks that the key is set and that the category is policy-set.
+func (o PolicySetParameterCreateOptions) Validate() error {
+	var v validator
+	v.check(validString(o.Key), "key", "key is required")
+	v.check(o.Category != nil, "category", "category is required")
+	v.check(o.Category == nil || *o.Category == CategoryPolicySet, "category", "category must be policy-set")
+	return v.err()
 }
 
 // Create is used to create a new parameter.
@@ -123,7 +119,7 @@ func (s *policySetParameters) Create(ctx context.Context, policySetID string, op
 	if !validStringID(&policySetID) {
 		return nil, errors.New("invalid value for policy set ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -131,7 +127,7 @@ func (s *policySetParameters) Create(ctx context.Context, policySetID string, op
 	options.ID = ""
 
 	u := fmt.Sprintf("policy-sets/%s/parameters", url.QueryEscape(policySetID))
-	req, err := s.client.newRequest("POST", u, &options)
+	req, err := s.client.newRequest("PolicySetParameters.Create", "POST", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -155,7 +151,7 @@ func (s *policySetParameters) Read(ctx context.Context, policySetID string, para
 	}
 
 	u := fmt.Sprintf("policy-sets/%s/parameters/%s", url.QueryEscape(policySetID), url.QueryEscape(parameterID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("PolicySetParameters.Read", "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -184,6 +180,13 @@ type PolicySetParameterUpdateOptions struct {
 	Sensitive *bool `jsonapi:"attr,sensitive,omitempty"`
 }
 
+// Validate checks that the key is not empty when set.
+func (o PolicySetParameterUpdateOptions) Validate() error {
+	var v validator
+	v.check(o.Key == nil || *o.Key != "", "key", "invalid value for key")
+	return v.err()
+}
+
 // Update values of an existing parameter.
 func (s *policySetParameters) Update(ctx context.Context, policySetID string, parameterID string, options PolicySetParameterUpdateOptions) (*PolicySetParameter, error) {
 	if !validStringID(&policySetID) {
@@ -193,11 +196,15 @@ func (s *policySetParameters) Update(ctx context.Context, policySetID string, pa
 		return nil, errors.New("invalid value for parameter ID")
 	}
 
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Make sure we don't send a user provided ID.
 	options.ID = parameterID
 
 	u := fmt.Sprintf("policy-sets/%s/parameters/%s", url.QueryEscape(policySetID), url.QueryEscape(parameterID))
-	req, err := s.client.newRequest("PATCH", u, &options)
+	req, err := s.client.newRequest("PolicySetParameters.Update", "PATCH", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -221,7 +228,7 @@ func (s *policySetParameters) Delete(ctx context.Context, policySetID string, pa
 	}
 
 	u := fmt.Sprintf("policy-sets/%s/parameters/%s", url.QueryEscape(policySetID), url.QueryEscape(parameterID))
-	req, err := s.client.newRequest("DELETE", u, nil)
+	req, err := s.client.newRequest("PolicySetParameters.Delete", "DELETE", u, nil)
 	if err != nil {
 		return err
 	}
@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -176,7 +177,7 @@ func TestPolicySetParametersRead(t *testing.T) {
 	t.Run("when the parameter does not exist", func(t *testing.T) {
 		p, err := client.PolicySetParameters.Read(ctx, pTest.PolicySet.ID, "nonexisting")
 		assert.Nil(t, p)
-		assert.Equal(t, ErrResourceNotFound, err)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("without a valid policy set ID", func(t *testing.T) {
@@ -272,7 +273,7 @@ func TestParametersDelete(t *testing.T) {
 
 	t.Run("with non existing parameter ID", func(t *testing.T) {
 		err := client.PolicySetParameters.Delete(ctx, psTest.ID, "nonexisting")
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("with invalid policy set ID", func(t *testing.T) {
@@ -2,6 +2,9 @@ package tfe
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"net/url"
 	"os"
 	"testing"
 
@@ -242,6 +245,50 @@ func TestPolicySetsRead(t *testing.T) {
 	})
 }
 
+func TestPolicySetsReadWithOptions(t *testing.T) {
+	ctx := context.Background()
+
+	var query url.Values
+	client, done := testMiddlewareClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.Write([]byte(`{
+			"data": {
+				"id": "polset-123",
+				"type": "policy-sets",
+				"attributes": {"name": "policies"},
+				"relationships": {
+					"current-version": {"data": {"id": "polsetver-1", "type": "policy-set-versions"}},
+					"newest-version": {"data": {"id": "polsetver-2", "type": "policy-set-versions"}}
+				}
+			},
+			"included": [
+				{"id": "polsetver-1", "type": "policy-set-versions", "attributes": {"source": "github", "status": "ready"}},
+				{"id": "polsetver-2", "type": "policy-set-versions", "attributes": {"source": "github", "status": "errored", "error": "invalid sentinel.hcl"}}
+			]
+		}`))
+	})
+	defer done()
+
+	t.Run("with includes", func(t *testing.T) {
+		ps, err := client.PolicySets.ReadWithOptions(ctx, "polset-123", PolicySetReadOptions{
+			Include: []PolicySetIncludeOpt{PolicySetIncludeCurrentVersion, PolicySetIncludeNewestVersion},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "current_version,newest_version", query.Get("include"))
+		require.NotNil(t, ps.CurrentVersion)
+		assert.Equal(t, "ready", ps.CurrentVersion.Status)
+		require.NotNil(t, ps.NewestVersion)
+		assert.Equal(t, "invalid sentinel.hcl", ps.NewestVersion.Error)
+	})
+
+	t.Run("with invalid policy set ID", func(t *testing.T) {
+		ps, err := client.PolicySets.ReadWithOptions(ctx, badIdentifier, PolicySetReadOptions{})
+		assert.Nil(t, ps)
+		assert.EqualError(t, err, "invalid value for policy set ID")
+	})
+}
+
 func TestPolicySetsUpdate(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -514,12 +561,12 @@ func TestPolicySetsDelete(t *testing.T) {
 
 		// Try loading the policy - it should fail.
 		_, err = client.PolicySets.Read(ctx, psTest.ID)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("when the policy does not exist", func(t *testing.T) {
 		err := client.PolicySets.Delete(ctx, psTest.ID)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("when the policy ID is invalid", func(t *testing.T) {
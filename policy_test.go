@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -219,7 +220,7 @@ func TestPoliciesRead(t *testing.T) {
 	t.Run("when the policy does not exist", func(t *testing.T) {
 		p, err := client.Policies.Read(ctx, "nonexisting")
 		assert.Nil(t, p)
-		assert.Equal(t, ErrResourceNotFound, err)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("without a valid policy ID", func(t *testing.T) {
@@ -319,12 +320,12 @@ func TestPoliciesDelete(t *testing.T) {
 
 		// Try loading the policy - it should fail.
 		_, err = client.Policies.Read(ctx, pTest.ID)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("when the policy does not exist", func(t *testing.T) {
 		err := client.Policies.Delete(ctx, pTest.ID)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("when the policy ID is invalid", func(t *testing.T) {
@@ -372,7 +373,7 @@ func TestPoliciesDownload(t *testing.T) {
 
 	t.Run("without existing content", func(t *testing.T) {
 		content, err := client.Policies.Download(ctx, pTest.ID)
-		assert.Equal(t, ErrResourceNotFound, err)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 		assert.Nil(t, content)
 	})
 
@@ -0,0 +1,71 @@
+package tfe
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Fields selects the attributes and relationships that are returned for
+// each resource type, using JSON:API sparse fieldsets. The keys are resource
+// types like "workspaces" and the values are attribute or relationship names
+// like "name" or "current-run":
+//
+//	Fields: tfe.Fields{"workspaces": {"name", "locked"}}
+//
+// Fields that are not returned by the server keep their zero value.
+type Fields map[string][]string
+
+// EncodeValues encodes the fields as fields[type]=name,... query parameters.
+func (f Fields) EncodeValues(key string, v *url.Values) error {
+	for _, typ := range sortedKeys(f) {
+		v.Set(fmt.Sprintf("%s[%s]", key, typ), strings.Join(f[typ], ","))
+	}
+	return nil
+}
+
+// Filter holds server-side filters for list calls. The keys are dotted field
+// paths, like "workspace.name", which are encoded as filter[workspace][name].
+// A Filter is best built using NewFilter:
+//
+//	Filter: tfe.NewFilter().Add("workspace.name", "my-workspace")
+type Filter map[string][]string
+
+// NewFilter returns a new, empty Filter.
+func NewFilter() Filter {
+	return Filter{}
+}
+
+// Add adds values for the given field path and returns the filter, so calls
+// can be chained. Multiple values are matched as alternatives.
+func (f Filter) Add(path string, values ...string) Filter {
+	if f == nil {
+		f = Filter{}
+	}
+	f[path] = append(f[path], values...)
+	return f
+}
+
+// EncodeValues encodes the filter as filter[field]=value,... query parameters.
+func (f Filter) EncodeValues(key string, v *url.Values) error {
+	for _, path := range sortedKeys(f) {
+		if path == "" {
+			return fmt.Errorf("invalid filter path %q", path)
+		}
+		param := key + "[" + strings.Replace(path, ".", "][", -1) + "]"
+		v.Set(param, strings.Join(f[path], ","))
+	}
+	return nil
+}
+
+// sortedKeys returns the keys of m in sorted order, so the encoded query
+// parameters are stable.
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
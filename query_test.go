@@ -0,0 +1,85 @@
+package tfe
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListOptions_encode(t *testing.T) {
+	t.Run("with fields, sort and filter", func(t *testing.T) {
+		v, err := query.Values(WorkspaceListOptions{
+			Fields: Fields{
+				"workspaces": {"name", "locked"},
+				"runs":       {"status"},
+			},
+			Sort:   []WorkspaceSortKey{WorkspaceSortCurrentRunCreatedAt.Desc(), WorkspaceSortName},
+			Filter: NewFilter().Add("current-run.status", "errored", "canceled").Add("locked", "true"),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, url.Values{
+			"fields[workspaces]":          {"name,locked"},
+			"fields[runs]":                {"status"},
+			"sort":                        {"-current-run.created-at,name"},
+			"filter[current-run][status]": {"errored,canceled"},
+			"filter[locked]":              {"true"},
+		}, v)
+	})
+
+	t.Run("without fields, sort and filter", func(t *testing.T) {
+		v, err := query.Values(RunListOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, v)
+	})
+
+	t.Run("with a nil filter", func(t *testing.T) {
+		var f Filter
+		v, err := query.Values(PolicyListOptions{
+			Filter: f.Add("name", "my-policy"),
+			Sort:   []PolicySortKey{PolicySortUpdatedAt.Desc()},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "my-policy", v.Get("filter[name]"))
+		assert.Equal(t, "-updated-at", v.Get("sort"))
+	})
+
+	t.Run("with an empty filter path", func(t *testing.T) {
+		_, err := query.Values(RunListOptions{Filter: Filter{"": {"x"}}})
+		assert.EqualError(t, err, `invalid filter path ""`)
+	})
+}
+
+func TestWorkspacesList_sparseFieldsets(t *testing.T) {
+	var q url.Values
+	client, done := testMiddlewareClient(t, func(w http.ResponseWriter, r *http.Request) {
+		q = r.URL.Query()
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.Write([]byte(`{
+			"data": [
+				{"id": "ws-1", "type": "workspaces", "attributes": {"name": "one"}},
+				{"id": "ws-2", "type": "workspaces", "attributes": {}},
+				{"id": "ws-3", "type": "workspaces"}
+			],
+			"meta": {"pagination": {"current-page": 1, "total-pages": 1, "total-count": 3}}
+		}`))
+	})
+	defer done()
+
+	wl, err := client.Workspaces.List(context.Background(), "my-org", WorkspaceListOptions{
+		Fields: Fields{"workspaces": {"name"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "name", q.Get("fields[workspaces]"))
+
+	require.Len(t, wl.Items, 3)
+	assert.Equal(t, &Workspace{ID: "ws-1", Name: "one"}, wl.Items[0])
+	assert.Equal(t, &Workspace{ID: "ws-2"}, wl.Items[1])
+	assert.Equal(t, &Workspace{ID: "ws-3"}, wl.Items[2])
+	assert.Equal(t, 3, wl.TotalCount)
+}
@@ -117,20 +117,14 @@ type RegistryModuleCreateOptions struct {
 	Provider *string `jsonapi:"attr,provider"`
 }
 
-func (o RegistryModuleCreateOptions) valid() error {
-	if !validString(o.Name) {
-		return errors.New("name is required")
-	}
-	if !validStringID(o.Name) {
-		return errors.New("invalid value for name")
-	}
-	if !validString(o.Provider) {
-		return errors.New("provider is required")
-	}
-	if !validStringID(o.Provider) {
-		return errors.New("invalid value for provider")
-	}
-	return nil
+// Validate checks that the name and provider are valid.
+func (o RegistryModuleCreateOptions) Validate() error {
+	var v validator
+	v.check(validString(o.Name), "name", "name is required")
+	v.check(validStringID(o.Name), "name", "invalid value for name")
+	v.check(validString(o.Provider), "provider", "provider is required")
+	v.check(validStringID(o.Provider), "provider", "invalid value for provider")
+	return v.err()
 }
 
 // Create a new registry module without a VCS repo
@@ -138,7 +132,7 @@ func (r *registryModules) Create(ctx context.Context, organization string, optio
 	if !validStringID(&organization) {
 		return nil, errors.New("invalid value for organization")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -149,7 +143,7 @@ func (r *registryModules) Create(ctx context.Context, organization string, optio
 		"organizations/%s/registry-modules",
 		url.QueryEscape(organization),
 	)
-	req, err := r.client.newRequest("POST", u, &options)
+	req, err := r.client.newRequest("RegistryModules.Create", "POST", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -171,14 +165,12 @@ type RegistryModuleCreateVersionOptions struct {
 	Version *string `jsonapi:"attr,version"`
 }
 
-func (o RegistryModuleCreateVersionOptions) valid() error {
-	if !validString(o.Version) {
-		return errors.New("version is required")
-	}
-	if !validStringID(o.Version) {
-		return errors.New("invalid value for version")
-	}
-	return nil
+// Validate checks that the version is valid.
+func (o RegistryModuleCreateVersionOptions) Validate() error {
+	var v validator
+	v.check(validString(o.Version), "version", "version is required")
+	v.check(validStringID(o.Version), "version", "invalid value for version")
+	return v.err()
 }
 
 // Create a new registry module version
@@ -198,7 +190,7 @@ func (r *registryModules) CreateVersion(ctx context.Context, organization string
 	if !validStringID(&provider) {
 		return nil, errors.New("invalid value for provider")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -211,7 +203,7 @@ func (r *registryModules) CreateVersion(ctx context.Context, organization string
 		url.QueryEscape(name),
 		url.QueryEscape(provider),
 	)
-	req, err := r.client.newRequest("POST", u, &options)
+	req, err := r.client.newRequest("RegistryModules.CreateVersion", "POST", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -231,11 +223,17 @@ type RegistryModuleCreateWithVCSConnectionOptions struct {
 	VCSRepo *RegistryModuleVCSRepoOptions `jsonapi:"attr,vcs-repo"`
 }
 
-func (o RegistryModuleCreateWithVCSConnectionOptions) valid() error {
-	if o.VCSRepo == nil {
-		return errors.New("vcs repo is required")
-	}
-	return o.VCSRepo.valid()
+// Validate checks that the VCS repository has an identifier, OAuth token ID
+// and display identifier.
+func (o RegistryModuleCreateWithVCSConnectionOptions) Validate() error {
+	var v validator
+	v.check(o.VCSRepo != nil, "vcs-repo", "vcs repo is required")
+	if o.VCSRepo != nil {
+		v.check(validString(o.VCSRepo.Identifier), "vcs-repo.identifier", "identifier is required")
+		v.check(validString(o.VCSRepo.OAuthTokenID), "vcs-repo.oauth-token-id", "oauth token ID is required")
+		v.check(validString(o.VCSRepo.DisplayIdentifier), "vcs-repo.display-identifier", "display identifier is required")
+	}
+	return v.err()
 }
 
 type RegistryModuleVCSRepoOptions struct {
@@ -244,26 +242,13 @@ type RegistryModuleVCSRepoOptions struct {
 	DisplayIdentifier *string `json:"display-identifier"`
 }
 
-func (o RegistryModuleVCSRepoOptions) valid() error {
-	if !validString(o.Identifier) {
-		return errors.New("identifier is required")
-	}
-	if !validString(o.OAuthTokenID) {
-		return errors.New("oauth token ID is required")
-	}
-	if !validString(o.DisplayIdentifier) {
-		return errors.New("display identifier is required")
-	}
-	return nil
-}
-
 // CreateWithVCSConnection is used to create and publish a new registry module with a VCS repo
 func (r *registryModules) CreateWithVCSConnection(ctx context.Context, options RegistryModuleCreateWithVCSConnectionOptions) (*RegistryModule, error) {
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
-	req, err := r.client.newRequest("POST", "registry-modules", &options)
+	req, err := r.client.newRequest("RegistryModules.CreateWithVCSConnection", "POST", "registry-modules", &options)
 	if err != nil {
 		return nil, err
 	}
@@ -301,7 +286,7 @@ func (r *registryModules) Read(ctx context.Context, organization string, name st
 		url.QueryEscape(name),
 		url.QueryEscape(provider),
 	)
-	req, err := r.client.newRequest("GET", u, nil)
+	req, err := r.client.newRequest("RegistryModules.Read", "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -332,7 +317,7 @@ func (r *registryModules) Delete(ctx context.Context, organization string, name
 		url.QueryEscape(organization),
 		url.QueryEscape(name),
 	)
-	req, err := r.client.newRequest("POST", u, nil)
+	req, err := r.client.newRequest("RegistryModules.Delete", "POST", u, nil)
 	if err != nil {
 		return err
 	}
@@ -364,7 +349,7 @@ func (r *registryModules) DeleteProvider(ctx context.Context, organization strin
 		url.QueryEscape(name),
 		url.QueryEscape(provider),
 	)
-	req, err := r.client.newRequest("POST", u, nil)
+	req, err := r.client.newRequest("RegistryModules.DeleteProvider", "POST", u, nil)
 	if err != nil {
 		return err
 	}
@@ -403,7 +388,7 @@ func (r *registryModules) DeleteVersion(ctx context.Context, organization string
 		url.QueryEscape(provider),
 		url.QueryEscape(version),
 	)
-	req, err := r.client.newRequest("POST", u, nil)
+	req, err := r.client.newRequest("RegistryModules.DeleteVersion", "POST", u, nil)
 	if err != nil {
 		return err
 	}
@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"context"
+	"errors"
 	"os"
 	"strings"
 
@@ -396,7 +397,7 @@ func TestRegistryModulesDelete(t *testing.T) {
 	t.Run("when the registry module does not exist", func(t *testing.T) {
 		err := client.RegistryModules.Delete(ctx, orgTest.Name, "nonexisting")
 		assert.Error(t, err)
-		assert.Equal(t, ErrResourceNotFound, err)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 }
 
@@ -447,7 +448,7 @@ func TestRegistryModulesDeleteProvider(t *testing.T) {
 	t.Run("when the registry module name and provider do not exist", func(t *testing.T) {
 		err := client.RegistryModules.DeleteProvider(ctx, orgTest.Name, "nonexisting", "nonexisting")
 		assert.Error(t, err)
-		assert.Equal(t, ErrResourceNotFound, err)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 }
 
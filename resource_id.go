@@ -0,0 +1,68 @@
+package tfe
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ResourceID is implemented by the typed IDs of all resources, like
+// WorkspaceID and RunID. The services returned by Client.Typed take typed
+// IDs, which makes it impossible to pass the ID of one resource where the ID
+// of another one is expected.
+type ResourceID interface {
+	fmt.Stringer
+
+	// Prefix returns the prefix all IDs of the resource start with, like
+	// "ws-" for workspaces.
+	Prefix() string
+
+	// Validate returns an error if the ID is not a valid ID of the
+	// resource.
+	Validate() error
+}
+
+//go:generate go run ./internal/resourceidgen
+
+// resourceIDKind describes the IDs of a resource type. The kinds of all
+// resource types and their typed IDs are generated into resource_id_gen.go
+// from the table in internal/resourceidgen.
+type resourceIDKind struct {
+	prefix string
+	name   string
+	parse  func(string) ResourceID
+}
+
+// resourceIDKindOf returns the kind of resource the ID belongs to, based on
+// its prefix.
+func resourceIDKindOf(id string) (resourceIDKind, bool) {
+	for _, k := range resourceIDKinds {
+		if strings.HasPrefix(id, k.prefix) && len(id) > len(k.prefix) {
+			return k, true
+		}
+	}
+	return resourceIDKind{}, false
+}
+
+// ParseResourceID returns the typed ID for the given ID, based on its
+// prefix. An error is returned when the prefix is unknown.
+func ParseResourceID(id string) (ResourceID, error) {
+	k, ok := resourceIDKindOf(id)
+	if !ok || !validStringID(&id) {
+		return nil, fmt.Errorf("invalid resource ID %q", id)
+	}
+	return k.parse(id), nil
+}
+
+// validateResourceID checks that id is a valid ID starting with prefix.
+func validateResourceID(id, prefix, name string) error {
+	if id == "" {
+		return fmt.Errorf("%s ID is required", name)
+	}
+	if strings.HasPrefix(id, prefix) && len(id) > len(prefix) && validStringID(&id) {
+		return nil
+	}
+	if k, ok := resourceIDKindOf(id); ok {
+		return fmt.Errorf("invalid value for %s ID: got %s ID %q", name, k.name, id)
+	}
+	return fmt.Errorf("invalid value for %s ID: %q does not start with %q", name, id, prefix)
+}
@@ -0,0 +1,553 @@
+// Code generated by resourceidgen. DO NOT EDIT.
+
+package tfe
+
+// resourceIDKinds lists the IDs of all resource types.
+var resourceIDKinds = []resourceIDKind{
+	{"ws-", "workspace", func(s string) ResourceID { return WorkspaceID(s) }},
+	{"run-", "run", func(s string) ResourceID { return RunID(s) }},
+	{"cv-", "configuration version", func(s string) ResourceID { return ConfigurationVersionID(s) }},
+	{"sv-", "state version", func(s string) ResourceID { return StateVersionID(s) }},
+	{"apply-", "apply", func(s string) ResourceID { return ApplyID(s) }},
+	{"plan-", "plan", func(s string) ResourceID { return PlanID(s) }},
+	{"pe-", "plan export", func(s string) ResourceID { return PlanExportID(s) }},
+	{"ce-", "cost estimate", func(s string) ResourceID { return CostEstimateID(s) }},
+	{"pol-", "policy", func(s string) ResourceID { return PolicyID(s) }},
+	{"polset-", "policy set", func(s string) ResourceID { return PolicySetID(s) }},
+	{"polchk-", "policy check", func(s string) ResourceID { return PolicyCheckID(s) }},
+	{"var-", "variable", func(s string) ResourceID { return VariableID(s) }},
+	{"team-", "team", func(s string) ResourceID { return TeamID(s) }},
+	{"tws-", "team access", func(s string) ResourceID { return TeamAccessID(s) }},
+	{"nc-", "notification configuration", func(s string) ResourceID { return NotificationConfigurationID(s) }},
+	{"oc-", "OAuth client", func(s string) ResourceID { return OAuthClientID(s) }},
+	{"ot-", "OAuth token", func(s string) ResourceID { return OAuthTokenID(s) }},
+	{"sshkey-", "SSH key", func(s string) ResourceID { return SSHKeyID(s) }},
+	{"rt-", "run trigger", func(s string) ResourceID { return RunTriggerID(s) }},
+	{"ou-", "organization membership", func(s string) ResourceID { return OrganizationMembershipID(s) }},
+	{"user-", "user", func(s string) ResourceID { return UserID(s) }},
+}
+
+// WorkspaceID is the ID of a workspace, like "ws-abc123".
+type WorkspaceID string
+
+var _ ResourceID = WorkspaceID("")
+
+// ParseWorkspaceID parses and validates the ID of a workspace.
+func ParseWorkspaceID(s string) (WorkspaceID, error) {
+	id := WorkspaceID(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// String implements ResourceID.
+func (id WorkspaceID) String() string { return string(id) }
+
+// Prefix implements ResourceID.
+func (id WorkspaceID) Prefix() string { return "ws-" }
+
+// Validate implements ResourceID.
+func (id WorkspaceID) Validate() error {
+	return validateResourceID(string(id), id.Prefix(), "workspace")
+}
+
+// RunID is the ID of a run, like "run-abc123".
+type RunID string
+
+var _ ResourceID = RunID("")
+
+// ParseRunID parses and validates the ID of a run.
+func ParseRunID(s string) (RunID, error) {
+	id := RunID(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// String implements ResourceID.
+func (id RunID) String() string { return string(id) }
+
+// Prefix implements ResourceID.
+func (id RunID) Prefix() string { return "run-" }
+
+// Validate implements ResourceID.
+func (id RunID) Validate() error {
+	return validateResourceID(string(id), id.Prefix(), "run")
+}
+
+// ConfigurationVersionID is the ID of a configuration version, like "cv-abc123".
+type ConfigurationVersionID string
+
+var _ ResourceID = ConfigurationVersionID("")
+
+// ParseConfigurationVersionID parses and validates the ID of a configuration version.
+func ParseConfigurationVersionID(s string) (ConfigurationVersionID, error) {
+	id := ConfigurationVersionID(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// String implements ResourceID.
+func (id ConfigurationVersionID) String() string { return string(id) }
+
+// Prefix implements ResourceID.
+func (id ConfigurationVersionID) Prefix() string { return "cv-" }
+
+// Validate implements ResourceID.
+func (id ConfigurationVersionID) Validate() error {
+	return validateResourceID(string(id), id.Prefix(), "configuration version")
+}
+
+// StateVersionID is the ID of a state version, like "sv-abc123".
+type StateVersionID string
+
+var _ ResourceID = StateVersionID("")
+
+// ParseStateVersionID parses and validates the ID of a state version.
+func ParseStateVersionID(s string) (StateVersionID, error) {
+	id := StateVersionID(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// String implements ResourceID.
+func (id StateVersionID) String() string { return string(id) }
+
+// Prefix implements ResourceID.
+func (id StateVersionID) Prefix() string { return "sv-" }
+
+// Validate implements ResourceID.
+func (id StateVersionID) Validate() error {
+	return validateResourceID(string(id), id.Prefix(), "state version")
+}
+
+// ApplyID is the ID of an apply, like "apply-abc123".
+type ApplyID string
+
+var _ ResourceID = ApplyID("")
+
+// ParseApplyID parses and validates the ID of an apply.
+func ParseApplyID(s string) (ApplyID, error) {
+	id := ApplyID(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// String implements ResourceID.
+func (id ApplyID) String() string { return string(id) }
+
+// Prefix implements ResourceID.
+func (id ApplyID) Prefix() string { return "apply-" }
+
+// Validate implements ResourceID.
+func (id ApplyID) Validate() error {
+	return validateResourceID(string(id), id.Prefix(), "apply")
+}
+
+// PlanID is the ID of a plan, like "plan-abc123".
+type PlanID string
+
+var _ ResourceID = PlanID("")
+
+// ParsePlanID parses and validates the ID of a plan.
+func ParsePlanID(s string) (PlanID, error) {
+	id := PlanID(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// String implements ResourceID.
+func (id PlanID) String() string { return string(id) }
+
+// Prefix implements ResourceID.
+func (id PlanID) Prefix() string { return "plan-" }
+
+// Validate implements ResourceID.
+func (id PlanID) Validate() error {
+	return validateResourceID(string(id), id.Prefix(), "plan")
+}
+
+// PlanExportID is the ID of a plan export, like "pe-abc123".
+type PlanExportID string
+
+var _ ResourceID = PlanExportID("")
+
+// ParsePlanExportID parses and validates the ID of a plan export.
+func ParsePlanExportID(s string) (PlanExportID, error) {
+	id := PlanExportID(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// String implements ResourceID.
+func (id PlanExportID) String() string { return string(id) }
+
+// Prefix implements ResourceID.
+func (id PlanExportID) Prefix() string { return "pe-" }
+
+// Validate implements ResourceID.
+func (id PlanExportID) Validate() error {
+	return validateResourceID(string(id), id.Prefix(), "plan export")
+}
+
+// CostEstimateID is the ID of a cost estimate, like "ce-abc123".
+type CostEstimateID string
+
+var _ ResourceID = CostEstimateID("")
+
+// ParseCostEstimateID parses and validates the ID of a cost estimate.
+func ParseCostEstimateID(s string) (CostEstimateID, error) {
+	id := CostEstimateID(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// String implements ResourceID.
+func (id CostEstimateID) String() string { return string(id) }
+
+// Prefix implements ResourceID.
+func (id CostEstimateID) Prefix() string { return "ce-" }
+
+// Validate implements ResourceID.
+func (id CostEstimateID) Validate() error {
+	return validateResourceID(string(id), id.Prefix(), "cost estimate")
+}
+
+// PolicyID is the ID of a policy, like "pol-abc123".
+type PolicyID string
+
+var _ ResourceID = PolicyID("")
+
+// ParsePolicyID parses and validates the ID of a policy.
+func ParsePolicyID(s string) (PolicyID, error) {
+	id := PolicyID(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// String implements ResourceID.
+func (id PolicyID) String() string { return string(id) }
+
+// Prefix implements ResourceID.
+func (id PolicyID) Prefix() string { return "pol-" }
+
+// Validate implements ResourceID.
+func (id PolicyID) Validate() error {
+	return validateResourceID(string(id), id.Prefix(), "policy")
+}
+
+// PolicySetID is the ID of a policy set, like "polset-abc123".
+type PolicySetID string
+
+var _ ResourceID = PolicySetID("")
+
+// ParsePolicySetID parses and validates the ID of a policy set.
+func ParsePolicySetID(s string) (PolicySetID, error) {
+	id := PolicySetID(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// String implements ResourceID.
+func (id PolicySetID) String() string { return string(id) }
+
+// Prefix implements ResourceID.
+func (id PolicySetID) Prefix() string { return "polset-" }
+
+// Validate implements ResourceID.
+func (id PolicySetID) Validate() error {
+	return validateResourceID(string(id), id.Prefix(), "policy set")
+}
+
+// PolicyCheckID is the ID of a policy check, like "polchk-abc123".
+type PolicyCheckID string
+
+var _ ResourceID = PolicyCheckID("")
+
+// ParsePolicyCheckID parses and validates the ID of a policy check.
+func ParsePolicyCheckID(s string) (PolicyCheckID, error) {
+	id := PolicyCheckID(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// String implements ResourceID.
+func (id PolicyCheckID) String() string { return string(id) }
+
+// Prefix implements ResourceID.
+func (id PolicyCheckID) Prefix() string { return "polchk-" }
+
+// Validate implements ResourceID.
+func (id PolicyCheckID) Validate() error {
+	return validateResourceID(string(id), id.Prefix(), "policy check")
+}
+
+// VariableID is the ID of a variable, like "var-abc123".
+type VariableID string
+
+var _ ResourceID = VariableID("")
+
+// ParseVariableID parses and validates the ID of a variable.
+func ParseVariableID(s string) (VariableID, error) {
+	id := VariableID(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// String implements ResourceID.
+func (id VariableID) String() string { return string(id) }
+
+// Prefix implements ResourceID.
+func (id VariableID) Prefix() string { return "var-" }
+
+// Validate implements ResourceID.
+func (id VariableID) Validate() error {
+	return validateResourceID(string(id), id.Prefix(), "variable")
+}
+
+// TeamID is the ID of a team, like "team-abc123".
+type TeamID string
+
+var _ ResourceID = TeamID("")
+
+// ParseTeamID parses and validates the ID of a team.
+func ParseTeamID(s string) (TeamID, error) {
+	id := TeamID(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// String implements ResourceID.
+func (id TeamID) String() string { return string(id) }
+
+// Prefix implements ResourceID.
+func (id TeamID) Prefix() string { return "team-" }
+
+// Validate implements ResourceID.
+func (id TeamID) Validate() error {
+	return validateResourceID(string(id), id.Prefix(), "team")
+}
+
+// TeamAccessID is the ID of a team access, like "tws-abc123".
+type TeamAccessID string
+
+var _ ResourceID = TeamAccessID("")
+
+// ParseTeamAccessID parses and validates the ID of a team access.
+func ParseTeamAccessID(s string) (TeamAccessID, error) {
+	id := TeamAccessID(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// String implements ResourceID.
+func (id TeamAccessID) String() string { return string(id) }
+
+// Prefix implements ResourceID.
+func (id TeamAccessID) Prefix() string { return "tws-" }
+
+// Validate implements ResourceID.
+func (id TeamAccessID) Validate() error {
+	return validateResourceID(string(id), id.Prefix(), "team access")
+}
+
+// NotificationConfigurationID is the ID of a notification configuration, like "nc-abc123".
+type NotificationConfigurationID string
+
+var _ ResourceID = NotificationConfigurationID("")
+
+// ParseNotificationConfigurationID parses and validates the ID of a notification configuration.
+func ParseNotificationConfigurationID(s string) (NotificationConfigurationID, error) {
+	id := NotificationConfigurationID(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// String implements ResourceID.
+func (id NotificationConfigurationID) String() string { return string(id) }
+
+// Prefix implements ResourceID.
+func (id NotificationConfigurationID) Prefix() string { return "nc-" }
+
+// Validate implements ResourceID.
+func (id NotificationConfigurationID) Validate() error {
+	return validateResourceID(string(id), id.Prefix(), "notification configuration")
+}
+
+// OAuthClientID is the ID of an OAuth client, like "oc-abc123".
+type OAuthClientID string
+
+var _ ResourceID = OAuthClientID("")
+
+// ParseOAuthClientID parses and validates the ID of an OAuth client.
+func ParseOAuthClientID(s string) (OAuthClientID, error) {
+	id := OAuthClientID(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// String implements ResourceID.
+func (id OAuthClientID) String() string { return string(id) }
+
+// Prefix implements ResourceID.
+func (id OAuthClientID) Prefix() string { return "oc-" }
+
+// Validate implements ResourceID.
+func (id OAuthClientID) Validate() error {
+	return validateResourceID(string(id), id.Prefix(), "OAuth client")
+}
+
+// OAuthTokenID is the ID of an OAuth token, like "ot-abc123".
+type OAuthTokenID string
+
+var _ ResourceID = OAuthTokenID("")
+
+// ParseOAuthTokenID parses and validates the ID of an OAuth token.
+func ParseOAuthTokenID(s string) (OAuthTokenID, error) {
+	id := OAuthTokenID(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// String implements ResourceID.
+func (id OAuthTokenID) String() string { return string(id) }
+
+// Prefix implements ResourceID.
+func (id OAuthTokenID) Prefix() string { return "ot-" }
+
+// Validate implements ResourceID.
+func (id OAuthTokenID) Validate() error {
+	return validateResourceID(string(id), id.Prefix(), "OAuth token")
+}
+
+// SSHKeyID is the ID of an SSH key, like "sshkey-abc123".
+type SSHKeyID string
+
+var _ ResourceID = SSHKeyID("")
+
+// ParseSSHKeyID parses and validates the ID of an SSH key.
+func ParseSSHKeyID(s string) (SSHKeyID, error) {
+	id := SSHKeyID(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// String implements ResourceID.
+func (id SSHKeyID) String() string { return string(id) }
+
+// Prefix implements ResourceID.
+func (id SSHKeyID) Prefix() string { return "sshkey-" }
+
+// Validate implements ResourceID.
+func (id SSHKeyID) Validate() error {
+	return validateResourceID(string(id), id.Prefix(), "SSH key")
+}
+
+// RunTriggerID is the ID of a run trigger, like "rt-abc123".
+type RunTriggerID string
+
+var _ ResourceID = RunTriggerID("")
+
+// ParseRunTriggerID parses and validates the ID of a run trigger.
+func ParseRunTriggerID(s string) (RunTriggerID, error) {
+	id := RunTriggerID(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// String implements ResourceID.
+func (id RunTriggerID) String() string { return string(id) }
+
+// Prefix implements ResourceID.
+func (id RunTriggerID) Prefix() string { return "rt-" }
+
+// Validate implements ResourceID.
+func (id RunTriggerID) Validate() error {
+	return validateResourceID(string(id), id.Prefix(), "run trigger")
+}
+
+// OrganizationMembershipID is the ID of an organization membership, like "ou-abc123".
+type OrganizationMembershipID string
+
+var _ ResourceID = OrganizationMembershipID("")
+
+// ParseOrganizationMembershipID parses and validates the ID of an organization membership.
+func ParseOrganizationMembershipID(s string) (OrganizationMembershipID, error) {
+	id := OrganizationMembershipID(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// String implements ResourceID.
+func (id OrganizationMembershipID) String() string { return string(id) }
+
+// Prefix implements ResourceID.
+func (id OrganizationMembershipID) Prefix() string { return "ou-" }
+
+// Validate implements ResourceID.
+func (id OrganizationMembershipID) Validate() error {
+	return validateResourceID(string(id), id.Prefix(), "organization membership")
+}
+
+// UserID is the ID of a user, like "user-abc123".
+type UserID string
+
+var _ ResourceID = UserID("")
+
+// ParseUserID parses and validates the ID of a user.
+func ParseUserID(s string) (UserID, error) {
+	id := UserID(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// String implements ResourceID.
+func (id UserID) String() string { return string(id) }
+
+// Prefix implements ResourceID.
+func (id UserID) Prefix() string { return "user-" }
+
+// Validate implements ResourceID.
+func (id UserID) Validate() error {
+	return validateResourceID(string(id), id.Prefix(), "user")
+}
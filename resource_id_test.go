@@ -0,0 +1,129 @@
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceID(t *testing.T) {
+	t.Run("parsing valid IDs", func(t *testing.T) {
+		ws, err := ParseWorkspaceID("ws-abc123")
+		require.NoError(t, err)
+		assert.Equal(t, WorkspaceID("ws-abc123"), ws)
+		assert.Equal(t, "ws-abc123", ws.String())
+
+		ps, err := ParsePolicySetID("polset-abc123")
+		require.NoError(t, err)
+		assert.Equal(t, "polset-", ps.Prefix())
+	})
+
+	t.Run("parsing invalid IDs", func(t *testing.T) {
+		_, err := ParseWorkspaceID("")
+		assert.EqualError(t, err, "workspace ID is required")
+
+		_, err = ParseWorkspaceID("run-abc123")
+		assert.EqualError(t, err, `invalid value for workspace ID: got run ID "run-abc123"`)
+
+		_, err = ParsePolicyID("polset-abc123")
+		assert.EqualError(t, err, `invalid value for policy ID: got policy set ID "polset-abc123"`)
+
+		_, err = ParseRunID("abc123")
+		assert.EqualError(t, err, `invalid value for run ID: "abc123" does not start with "run-"`)
+
+		_, err = ParseRunID("run-")
+		assert.Error(t, err)
+
+		_, err = ParseRunID("run-" + badIdentifier)
+		assert.Error(t, err)
+	})
+
+	t.Run("with the prefixes of all resources", func(t *testing.T) {
+		for _, k := range resourceIDKinds {
+			id := k.parse(k.prefix + "abc123")
+			assert.Equal(t, k.prefix, id.Prefix())
+			assert.NoError(t, id.Validate())
+
+			for _, other := range resourceIDKinds {
+				if other.prefix != k.prefix {
+					assert.False(t, strings.HasPrefix(k.prefix, other.prefix), "%s starts with %s", k.prefix, other.prefix)
+				}
+			}
+		}
+	})
+
+	t.Run("parsing IDs of any resource", func(t *testing.T) {
+		id, err := ParseResourceID("cv-abc123")
+		require.NoError(t, err)
+		assert.Equal(t, ConfigurationVersionID("cv-abc123"), id)
+
+		_, err = ParseResourceID("foo-abc123")
+		assert.EqualError(t, err, `invalid resource ID "foo-abc123"`)
+	})
+
+	t.Run("with typed services", func(t *testing.T) {
+		var requests int
+		client, done := testMiddlewareClient(t, func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			testWorkspaceHandler(w, r)
+		})
+		defer done()
+		ctx := context.Background()
+
+		ws, err := client.Typed().Workspaces.ReadByID(ctx, WorkspaceID("ws-123"))
+		require.NoError(t, err)
+		assert.Equal(t, "ws-123", ws.ID)
+
+		// Typed IDs are validated before making a request.
+		_, err = client.Typed().Workspaces.ReadByID(ctx, WorkspaceID("run-abc123"))
+		assert.EqualError(t, err, `invalid value for workspace ID: got run ID "run-abc123"`)
+		assert.Equal(t, 1, requests)
+
+		// Methods without IDs are those of the wrapped service.
+		_, err = client.Typed().Workspaces.Read(ctx, "my-org", "my-workspace")
+		require.NoError(t, err)
+		assert.Equal(t, 2, requests)
+	})
+
+	t.Run("passing the ID of another resource as a string", func(t *testing.T) {
+		var requests int
+		client, done := testMiddlewareClient(t, func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			testWorkspaceHandler(w, r)
+		})
+		defer done()
+
+		// The prefix of string IDs is left to the server.
+		_, err := client.Workspaces.ReadByID(context.Background(), "run-abc123")
+		require.NoError(t, err)
+		assert.Equal(t, 1, requests)
+	})
+}
+
+func TestResourceID_generated(t *testing.T) {
+	dir, err := ioutil.TempDir("", "resourceidgen")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	out, err := exec.Command("go", "run", "./internal/resourceidgen", "-out", dir).CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	for _, name := range []string{"resource_id_gen.go", "typed_services_gen.go"} {
+		want, err := ioutil.ReadFile(filepath.Join(dir, name))
+		require.NoError(t, err)
+		current, err := ioutil.ReadFile(name)
+		require.NoError(t, err)
+		if !bytes.Equal(want, current) {
+			t.Errorf("generated file %s is out of date, run go generate", name)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package tfe
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// BackoffStrategy determines how long to wait before retrying a request.
+type BackoffStrategy int
+
+// List of available backoff strategies.
+const (
+	// BackoffExponential waits a random duration between zero and
+	// MinWait * 2^attempt, capped at MaxWait ("full jitter").
+	BackoffExponential BackoffStrategy = iota
+
+	// BackoffLinear waits MinWait * (attempt + 1), capped at MaxWait.
+	BackoffLinear
+
+	// BackoffConstant always waits MinWait.
+	BackoffConstant
+)
+
+// RetryRule determines which requests are retried.
+type RetryRule int
+
+// List of available retry rules.
+const (
+	// RetryNever never retries the request.
+	RetryNever RetryRule = iota
+
+	// RetryIdempotent only retries idempotent requests, so requests that
+	// create resources or trigger actions are never sent twice.
+	RetryIdempotent
+
+	// RetryAlways retries all requests. This is only safe when the failure
+	// guarantees the request was not processed, like a 429 response.
+	RetryAlways
+)
+
+// RetryPolicy configures how failed requests are retried.
+type RetryPolicy struct {
+	// The maximum number of attempts, including the first one. Defaults to
+	// 5 when left empty. Use 1 to disable retries.
+	MaxAttempts int
+
+	// The backoff strategy used between attempts.
+	Backoff BackoffStrategy
+
+	// The minimum and maximum wait used by the backoff strategy. They
+	// default to 100 milliseconds and 30 seconds.
+	MinWait time.Duration
+	MaxWait time.Duration
+
+	// The retry rule per status code. Status codes without a rule are not
+	// retried. Defaults to DefaultRetryStatusRules when left empty.
+	StatusRules map[int]RetryRule
+
+	// The retry rule for requests that failed without a response, for
+	// example because the connection was reset.
+	ConnectionErrors RetryRule
+
+	// The total time an API call can spend on retries, including the time
+	// spent waiting between attempts. There is no limit when left empty.
+	Budget time.Duration
+}
+
+// DefaultRetryStatusRules returns the status rules used when a retry
+// policy does not specify any.
+func DefaultRetryStatusRules() map[int]RetryRule {
+	return map[int]RetryRule{
+		http.StatusTooManyRequests:     RetryAlways,
+		http.StatusInternalServerError: RetryIdempotent,
+		http.StatusBadGateway:          RetryIdempotent,
+		http.StatusServiceUnavailable:  RetryIdempotent,
+		http.StatusGatewayTimeout:      RetryIdempotent,
+	}
+}
+
+// withDefaults returns a copy of the policy with defaults for empty values.
+func (p RetryPolicy) withDefaults() *RetryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = 5
+	}
+	if p.MinWait <= 0 {
+		p.MinWait = 100 * time.Millisecond
+	}
+	if p.MaxWait <= 0 {
+		p.MaxWait = 30 * time.Second
+	}
+	if p.MaxWait < p.MinWait {
+		p.MaxWait = p.MinWait
+	}
+	if p.StatusRules == nil {
+		p.StatusRules = DefaultRetryStatusRules()
+	}
+	return &p
+}
+
+// retryState tracks the retries of a single API call.
+type retryState struct {
+	method   string
+	start    time.Time
+	attempts int
+}
+
+// retryStateContextKey is the context key used to attach the retry state
+// to the requests of an API call.
+type retryStateContextKey struct{}
+
+// withRetryState attaches a new retry state for an API call to ctx.
+func withRetryState(ctx context.Context, method string) context.Context {
+	return context.WithValue(ctx, retryStateContextKey{}, &retryState{
+		method: method,
+		start:  time.Now(),
+	})
+}
+
+// shouldRetry reports whether the attempt that resulted in resp and err
+// should be retried.
+func (p *RetryPolicy) shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	state, ok := ctx.Value(retryStateContextKey{}).(*retryState)
+	if !ok {
+		return false
+	}
+	state.attempts++
+
+	rule := p.ConnectionErrors
+	if err == nil {
+		rule = p.StatusRules[resp.StatusCode]
+	}
+
+	switch rule {
+	case RetryAlways:
+	case RetryIdempotent:
+		if !isIdempotent(state.method) {
+			return false
+		}
+	default:
+		return false
+	}
+
+	if state.attempts >= p.MaxAttempts {
+		return false
+	}
+
+	// Stop when the longest possible wait would exceed the budget.
+	if p.Budget > 0 && time.Since(state.start)+p.maxWait(state.attempts-1, resp) > p.Budget {
+		return false
+	}
+
+	return true
+}
+
+// wait returns how long to wait before retrying attempt attemptNum, which
+// starts at zero for the first retry.
+func (p *RetryPolicy) wait(attemptNum int, resp *http.Response) time.Duration {
+	if wait, ok := serverWait(resp); ok {
+		return wait
+	}
+
+	switch p.Backoff {
+	case BackoffLinear:
+		return p.maxWait(attemptNum, nil)
+	case BackoffConstant:
+		return p.MinWait
+	default:
+		return time.Duration(rand.Int63n(int64(p.maxWait(attemptNum, nil)) + 1))
+	}
+}
+
+// maxWait returns the longest wait before retrying attempt attemptNum.
+func (p *RetryPolicy) maxWait(attemptNum int, resp *http.Response) time.Duration {
+	if wait, ok := serverWait(resp); ok {
+		return wait
+	}
+
+	var wait float64
+	switch p.Backoff {
+	case BackoffLinear:
+		wait = float64(p.MinWait) * float64(attemptNum+1)
+	case BackoffConstant:
+		wait = float64(p.MinWait)
+	default:
+		wait = float64(p.MinWait) * math.Pow(2, float64(attemptNum))
+	}
+
+	if wait > float64(p.MaxWait) {
+		return p.MaxWait
+	}
+	return time.Duration(wait)
+}
+
+// serverWait returns the wait requested by the server using either the
+// Retry-After or the X-RateLimit-Reset header.
+func serverWait(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	if v := resp.Header.Get(headerRetryAfter); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			if wait := time.Until(t); wait > 0 {
+				return wait, true
+			}
+			return 0, true
+		}
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if v := resp.Header.Get(headerRateReset); v != "" {
+			if reset, _ := strconv.ParseFloat(v, 64); reset > 0 {
+				return time.Duration(reset * 1e9), true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// isIdempotent reports whether requests using method can safely be sent
+// more than once.
+func isIdempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
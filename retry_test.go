@@ -0,0 +1,188 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicy_withDefaults(t *testing.T) {
+	p := RetryPolicy{}.withDefaults()
+	assert.Equal(t, 5, p.MaxAttempts)
+	assert.Equal(t, BackoffExponential, p.Backoff)
+	assert.Equal(t, 100*time.Millisecond, p.MinWait)
+	assert.Equal(t, 30*time.Second, p.MaxWait)
+	assert.Equal(t, DefaultRetryStatusRules(), p.StatusRules)
+	assert.Equal(t, RetryNever, p.ConnectionErrors)
+}
+
+func TestRetryPolicy_wait(t *testing.T) {
+	t.Run("with exponential backoff", func(t *testing.T) {
+		p := RetryPolicy{MinWait: time.Second, MaxWait: 10 * time.Second}.withDefaults()
+		assert.Equal(t, time.Second, p.maxWait(0, nil))
+		assert.Equal(t, 4*time.Second, p.maxWait(2, nil))
+		assert.Equal(t, 10*time.Second, p.maxWait(8, nil))
+
+		for i := 0; i < 10; i++ {
+			wait := p.wait(2, nil)
+			assert.True(t, wait >= 0 && wait <= 4*time.Second, "unexpected wait: %s", wait)
+		}
+	})
+
+	t.Run("with linear backoff", func(t *testing.T) {
+		p := RetryPolicy{Backoff: BackoffLinear, MinWait: time.Second, MaxWait: 10 * time.Second}.withDefaults()
+		assert.Equal(t, time.Second, p.wait(0, nil))
+		assert.Equal(t, 3*time.Second, p.wait(2, nil))
+		assert.Equal(t, 10*time.Second, p.wait(20, nil))
+	})
+
+	t.Run("with constant backoff", func(t *testing.T) {
+		p := RetryPolicy{Backoff: BackoffConstant, MinWait: time.Second}.withDefaults()
+		assert.Equal(t, time.Second, p.wait(0, nil))
+		assert.Equal(t, time.Second, p.wait(20, nil))
+	})
+
+	t.Run("with a Retry-After header in seconds", func(t *testing.T) {
+		p := RetryPolicy{}.withDefaults()
+		resp := &http.Response{StatusCode: 503, Header: http.Header{"Retry-After": []string{"7"}}}
+		assert.Equal(t, 7*time.Second, p.wait(0, resp))
+	})
+
+	t.Run("with a Retry-After header as a date", func(t *testing.T) {
+		p := RetryPolicy{}.withDefaults()
+		date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+		resp := &http.Response{StatusCode: 503, Header: http.Header{"Retry-After": []string{date}}}
+
+		wait := p.wait(0, resp)
+		assert.True(t, wait > 55*time.Second && wait <= time.Minute, "unexpected wait: %s", wait)
+	})
+
+	t.Run("with a rate limit reset header", func(t *testing.T) {
+		p := RetryPolicy{}.withDefaults()
+		resp := &http.Response{StatusCode: 429, Header: http.Header{"X-Ratelimit-Reset": []string{"0.5"}}}
+		assert.Equal(t, 500*time.Millisecond, p.wait(0, resp))
+	})
+}
+
+func TestRetryPolicy_shouldRetry(t *testing.T) {
+	connErr := errors.New("connection error")
+
+	cases := map[string]struct {
+		policy RetryPolicy
+		method string
+		status int
+		err    error
+		retry  bool
+	}{
+		"rate limited GET": {
+			method: "GET", status: 429, retry: true,
+		},
+		"rate limited POST": {
+			method: "POST", status: 429, retry: true,
+		},
+		"server error GET": {
+			method: "GET", status: 503, retry: true,
+		},
+		"server error POST": {
+			method: "POST", status: 503, retry: false,
+		},
+		"client error GET": {
+			method: "GET", status: 400, retry: false,
+		},
+		"custom status rule": {
+			policy: RetryPolicy{StatusRules: map[int]RetryRule{409: RetryAlways}},
+			method: "POST", status: 409, retry: true,
+		},
+		"connection error without rule": {
+			method: "GET", err: connErr, retry: false,
+		},
+		"connection error with rule": {
+			policy: RetryPolicy{ConnectionErrors: RetryIdempotent},
+			method: "GET", err: connErr, retry: true,
+		},
+		"connection error with rule POST": {
+			policy: RetryPolicy{ConnectionErrors: RetryIdempotent},
+			method: "POST", err: connErr, retry: false,
+		},
+		"without retries": {
+			policy: RetryPolicy{MaxAttempts: 1},
+			method: "GET", status: 429, retry: false,
+		},
+		"exceeding the budget": {
+			policy: RetryPolicy{MinWait: time.Second, Budget: 500 * time.Millisecond},
+			method: "GET", status: 503, retry: false,
+		},
+	}
+
+	for name, tc := range cases {
+		p := tc.policy.withDefaults()
+		ctx := withRetryState(context.Background(), tc.method)
+
+		var resp *http.Response
+		if tc.err == nil {
+			resp = &http.Response{StatusCode: tc.status, Header: http.Header{}}
+		}
+
+		assert.Equal(t, tc.retry, p.shouldRetry(ctx, resp, tc.err), name)
+	}
+
+	t.Run("stops after max attempts", func(t *testing.T) {
+		p := RetryPolicy{MaxAttempts: 3}.withDefaults()
+		ctx := withRetryState(context.Background(), "GET")
+		resp := &http.Response{StatusCode: 429, Header: http.Header{}}
+
+		assert.True(t, p.shouldRetry(ctx, resp, nil))
+		assert.True(t, p.shouldRetry(ctx, resp, nil))
+		assert.False(t, p.shouldRetry(ctx, resp, nil))
+	})
+}
+
+func TestRetryPolicy_client(t *testing.T) {
+	requests := map[string]int{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == DefaultBasePath+PingEndpoint {
+			w.WriteHeader(204)
+			return
+		}
+
+		requests[r.Method]++
+		if requests[r.Method] < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(503)
+			return
+		}
+		testWorkspaceHandler(w, r)
+	}))
+	defer ts.Close()
+
+	var retries int
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		RetryPolicy: &RetryPolicy{
+			MaxAttempts: 3,
+		},
+		RetryLogHook: func(attemptNum int, resp *http.Response) {
+			retries++
+		},
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = client.Workspaces.ReadByID(ctx, "ws-123")
+	require.NoError(t, err)
+	assert.Equal(t, 3, requests["GET"])
+	assert.Equal(t, 2, retries)
+
+	_, err = client.Workspaces.Create(ctx, "my-org", WorkspaceCreateOptions{Name: String("foo")})
+	require.Error(t, err)
+	assert.Equal(t, 1, requests["POST"])
+	assert.Equal(t, 2, retries)
+}
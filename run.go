@@ -16,7 +16,8 @@ var _ Runs = (*runs)(nil)
 //
 // TFE API docs: https://www.terraform.io/docs/enterprise/api/run.html
 type Runs interface {
-	// List all the runs of the given workspace.
+	// List all the runs of the given workspace. A time range set in the
+	// options is applied by the client to the retrieved page only.
 	List(ctx context.Context, workspaceID string, options RunListOptions) (*RunList, error)
 
 	// Create a new run with the given options.
@@ -25,6 +26,9 @@ type Runs interface {
 	// Read a run by its ID.
 	Read(ctx context.Context, runID string) (*Run, error)
 
+	// ReadWithOptions reads a run by its ID with the given options.
+	ReadWithOptions(ctx context.Context, runID string, options RunReadOptions) (*Run, error)
+
 	// Apply a run by its ID.
 	Apply(ctx context.Context, runID string, options RunApplyOptions) error
 
@@ -68,6 +72,29 @@ const (
 	RunPolicySoftFailed   RunStatus = "policy_soft_failed"
 )
 
+// IsFinal reports whether the status is final, so the run will not change
+// anymore. Speculative and plan-only runs end with the policy soft failed
+// status when policy checks soft fail, as they can not be overridden.
+func (s RunStatus) IsFinal() bool {
+	switch s {
+	case RunApplied, RunCanceled, RunDiscarded, RunErrored, RunPlannedAndFinished, RunPolicySoftFailed:
+		return true
+	}
+	return false
+}
+
+// IsConfirmable reports whether a run with the status is paused until it
+// is confirmed, overridden or discarded. Runs of workspaces using auto-apply
+// pass the planned, cost estimated and policy checked statuses without
+// pausing, so the Actions of a run tell whether it can actually be confirmed.
+func (s RunStatus) IsConfirmable() bool {
+	switch s {
+	case RunPlanned, RunCostEstimated, RunPolicyChecked, RunPolicyOverride:
+		return true
+	}
+	return false
+}
+
 // RunSource represents a source type of a run.
 type RunSource string
 
@@ -78,6 +105,45 @@ const (
 	RunSourceUI                   RunSource = "tfe-ui"
 )
 
+// RunOperation represents the kind of operation a run performs.
+type RunOperation string
+
+// List all available run operations.
+const (
+	RunOperationDestroy      RunOperation = "destroy"
+	RunOperationEmptyApply   RunOperation = "empty_apply"
+	RunOperationPlanAndApply RunOperation = "plan_and_apply"
+	RunOperationPlanOnly     RunOperation = "plan_only"
+	RunOperationRefreshOnly  RunOperation = "refresh_only"
+)
+
+// RunIncludeOpt represents a related resource that can be included when
+// reading or listing runs.
+type RunIncludeOpt string
+
+// List all available run includes.
+const (
+	RunIncludeApply                RunIncludeOpt = "apply"
+	RunIncludeConfigurationVersion RunIncludeOpt = "configuration_version"
+	RunIncludeCostEstimate         RunIncludeOpt = "cost_estimate"
+	RunIncludePlan                 RunIncludeOpt = "plan"
+	RunIncludePolicyChecks         RunIncludeOpt = "policy_checks"
+	RunIncludeWorkspace            RunIncludeOpt = "workspace"
+)
+
+// RunSortKey represents a key runs can be sorted by when listing them.
+type RunSortKey string
+
+// List all available run sort keys.
+const (
+	RunSortCreatedAt RunSortKey = "created-at"
+)
+
+// Desc returns the key for sorting in descending order.
+func (k RunSortKey) Desc() RunSortKey {
+	return "-" + k
+}
+
 // RunList represents a list of runs.
 type RunList struct {
 	*Pagination
@@ -88,17 +154,24 @@ type RunList struct {
 type Run struct {
 	ID                     string               `jsonapi:"primary,runs"`
 	Actions                *RunActions          `jsonapi:"attr,actions"`
+	AllowEmptyApply        bool                 `jsonapi:"attr,allow-empty-apply"`
+	AutoApply              bool                 `jsonapi:"attr,auto-apply"`
 	CreatedAt              time.Time            `jsonapi:"attr,created-at,iso8601"`
 	ForceCancelAvailableAt time.Time            `jsonapi:"attr,force-cancel-available-at,iso8601"`
 	HasChanges             bool                 `jsonapi:"attr,has-changes"`
 	IsDestroy              bool                 `jsonapi:"attr,is-destroy"`
 	Message                string               `jsonapi:"attr,message"`
 	Permissions            *RunPermissions      `jsonapi:"attr,permissions"`
+	PlanOnly               bool                 `jsonapi:"attr,plan-only"`
 	PositionInQueue        int                  `jsonapi:"attr,position-in-queue"`
+	Refresh                bool                 `jsonapi:"attr,refresh"`
+	RefreshOnly            bool                 `jsonapi:"attr,refresh-only"`
+	ReplaceAddrs           []string             `jsonapi:"attr,replace-addrs,omitempty"`
 	Source                 RunSource            `jsonapi:"attr,source"`
 	Status                 RunStatus            `jsonapi:"attr,status"`
 	StatusTimestamps       *RunStatusTimestamps `jsonapi:"attr,status-timestamps"`
 	TargetAddrs            []string             `jsonapi:"attr,target-addrs,omitempty"`
+	Variables              []*RunVariable       `jsonapi:"attr,variables,omitempty"`
 
 	// Relations
 	Apply                *Apply                `jsonapi:"relation,apply"`
@@ -126,6 +199,16 @@ type RunPermissions struct {
 	CanForceExecute bool `json:"can-force-execute"`
 }
 
+// RunVariable represents a variable that is set for a single run, taking
+// precedence over the variables of its workspace.
+type RunVariable struct {
+	Key string `json:"key"`
+
+	// The value of the variable as an HCL expression, so string values must
+	// be quoted, like `"us-east-1"`.
+	Value string `json:"value"`
+}
+
 // RunStatusTimestamps holds the timestamps for individual run statuses.
 type RunStatusTimestamps struct {
 	ErroredAt            time.Time `json:"errored-at"`
@@ -138,11 +221,70 @@ type RunStatusTimestamps struct {
 	PlannedAt            time.Time `json:"planned-at"`
 	PlannedAndFinishedAt time.Time `json:"planned-and-finished-at"`
 	PlanQueuabledAt      time.Time `json:"plan-queueable-at"`
+	PolicySoftFailedAt   time.Time `json:"policy-soft-failed-at"`
 }
 
 // RunListOptions represents the options for listing runs.
 type RunListOptions struct {
 	ListOptions
+
+	// The related resources to include in the response.
+	Include []RunIncludeOpt `url:"include,omitempty,comma"`
+
+	// The attributes to return per resource type.
+	Fields Fields `url:"fields,omitempty"`
+
+	// The keys to sort the results by, in order of precedence.
+	Sort []RunSortKey `url:"sort,omitempty,comma"`
+
+	// Only return runs with one of the given statuses.
+	Status []RunStatus `url:"filter[status],omitempty,comma"`
+
+	// Only return runs started from one of the given sources.
+	Source []RunSource `url:"filter[source],omitempty,comma"`
+
+	// Only return runs performing one of the given operations.
+	Operation []RunOperation `url:"filter[operation],omitempty,comma"`
+
+	// Only return runs of configuration versions created from the given
+	// commit SHA.
+	Commit *string `url:"search[commit],omitempty"`
+
+	// Only return runs created at or after CreatedAfter and before
+	// CreatedBefore. As the API cannot filter runs by creation time, the
+	// time range is applied by the client to the retrieved page: the page
+	// can hold fewer runs than PageSize, or none at all, while the
+	// pagination still describes the unfiltered pages of the server.
+	CreatedAfter  time.Time `url:"-"`
+	CreatedBefore time.Time `url:"-"`
+
+	// Additional server-side filters. Filters on status, source and
+	// operation cannot be combined with the options of the same name, as
+	// they are encoded as the same query parameters.
+	Filter Filter `url:"filter,omitempty"`
+}
+
+func (o RunListOptions) valid() error {
+	var v validator
+	v.check(o.Commit == nil || *o.Commit != "", "commit", "invalid value for commit")
+	v.check(o.CreatedAfter.IsZero() || o.CreatedBefore.IsZero() || o.CreatedAfter.Before(o.CreatedBefore),
+		"created-before", "created before must be after created after")
+	v.check(len(o.Status) == 0 || o.Filter["status"] == nil, "filter[status]", "status cannot be combined with a filter on status")
+	v.check(len(o.Source) == 0 || o.Filter["source"] == nil, "filter[source]", "source cannot be combined with a filter on source")
+	v.check(len(o.Operation) == 0 || o.Filter["operation"] == nil, "filter[operation]", "operation cannot be combined with a filter on operation")
+	return v.err()
+}
+
+// createdWithin reports whether the run was created within the time range
+// of the options.
+func (o RunListOptions) createdWithin(r *Run) bool {
+	if !o.CreatedAfter.IsZero() && r.CreatedAt.Before(o.CreatedAfter) {
+		return false
+	}
+	if !o.CreatedBefore.IsZero() && !r.CreatedAt.Before(o.CreatedBefore) {
+		return false
+	}
+	return true
 }
 
 // List all the runs of the given workspace.
@@ -151,8 +293,12 @@ func (s *runs) List(ctx context.Context, workspaceID string, options RunListOpti
 		return nil, errors.New("invalid value for workspace ID")
 	}
 
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
 	u := fmt.Sprintf("workspaces/%s/runs", url.QueryEscape(workspaceID))
-	req, err := s.client.newRequest("GET", u, &options)
+	req, err := s.client.newRequest("Runs.List", "GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -163,6 +309,16 @@ func (s *runs) List(ctx context.Context, workspaceID string, options RunListOpti
 		return nil, err
 	}
 
+	if !options.CreatedAfter.IsZero() || !options.CreatedBefore.IsZero() {
+		items := rl.Items[:0]
+		for _, r := range rl.Items {
+			if options.createdWithin(r) {
+				items = append(items, r)
+			}
+		}
+		rl.Items = items
+	}
+
 	return rl, nil
 }
 
@@ -198,25 +354,84 @@ type RunCreateOptions struct {
 	// of routine workflow and Terraform will emit warnings reminding about
 	// this whenever this property is set.
 	TargetAddrs []string `jsonapi:"attr,target-addrs,omitempty"`
+
+	// If non-empty, requests that Terraform should replace the given objects
+	// (specified using resource address syntax) instead of updating them,
+	// like running terraform apply with -replace.
+	ReplaceAddrs []string `jsonapi:"attr,replace-addrs,omitempty"`
+
+	// Specifies if this is a speculative run, which can only be planned and
+	// is never applied. The run does not lock the workspace.
+	PlanOnly *bool `jsonapi:"attr,plan-only,omitempty"`
+
+	// Specifies if Terraform should refresh the state before planning.
+	// Defaults to true.
+	Refresh *bool `jsonapi:"attr,refresh,omitempty"`
+
+	// Specifies if this is a refresh-only run, which only updates the state
+	// to match the real infrastructure without proposing any changes.
+	RefreshOnly *bool `jsonapi:"attr,refresh-only,omitempty"`
+
+	// Overrides the auto-apply setting of the workspace for this run.
+	AutoApply *bool `jsonapi:"attr,auto-apply,omitempty"`
+
+	// Specifies if the run can be applied when its plan has no changes,
+	// which is used to update the outputs of the state.
+	AllowEmptyApply *bool `jsonapi:"attr,allow-empty-apply,omitempty"`
+
+	// Variables set for this run only, taking precedence over the
+	// variables of the workspace.
+	Variables []*RunVariable `jsonapi:"attr,variables,omitempty"`
 }
 
-func (o RunCreateOptions) valid() error {
-	if o.Workspace == nil {
-		return errors.New("workspace is required")
+// Validate checks that the workspace is set, that the requested kinds of run
+// can be combined, that no address is empty and that every variable has a
+// unique key.
+func (o RunCreateOptions) Validate() error {
+	var v validator
+	v.check(o.Workspace != nil, "workspace", "workspace is required")
+
+	isDestroy := o.IsDestroy != nil && *o.IsDestroy
+	refreshOnly := o.RefreshOnly != nil && *o.RefreshOnly
+	planOnly := o.PlanOnly != nil && *o.PlanOnly
+
+	v.check(!refreshOnly || !isDestroy, "refresh-only", "refresh-only runs can not be destroy runs")
+	v.check(!refreshOnly || o.Refresh == nil || *o.Refresh, "refresh-only", "refresh-only runs require refresh")
+	v.check(!refreshOnly || len(o.ReplaceAddrs) == 0, "replace-addrs", "refresh-only runs can not replace resources")
+	v.check(!isDestroy || len(o.ReplaceAddrs) == 0, "replace-addrs", "destroy runs can not replace resources")
+	v.check(!planOnly || o.AutoApply == nil || !*o.AutoApply, "auto-apply", "plan-only runs can not be applied")
+
+	for _, addr := range o.TargetAddrs {
+		v.check(addr != "", "target-addrs", "target addresses must not be empty")
 	}
-	return nil
+	for _, addr := range o.ReplaceAddrs {
+		v.check(addr != "", "replace-addrs", "replace addresses must not be empty")
+	}
+
+	keys := make(map[string]bool, len(o.Variables))
+	for _, rv := range o.Variables {
+		if rv == nil {
+			v.check(false, "variables", "variables must not be nil")
+			continue
+		}
+		v.check(rv.Key != "", "variables.key", "variable key is required")
+		v.check(!keys[rv.Key], "variables.key", fmt.Sprintf("duplicate variable key %q", rv.Key))
+		keys[rv.Key] = true
+	}
+
+	return v.err()
 }
 
 // Create a new run with the given options.
 func (s *runs) Create(ctx context.Context, options RunCreateOptions) (*Run, error) {
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
 	// Make sure we don't send a user provided ID.
 	options.ID = ""
 
-	req, err := s.client.newRequest("POST", "runs", &options)
+	req, err := s.client.newRequest("Runs.Create", "POST", "runs", &options)
 	if err != nil {
 		return nil, err
 	}
@@ -232,12 +447,29 @@ func (s *runs) Create(ctx context.Context, options RunCreateOptions) (*Run, erro
 
 // Read a run by its ID.
 func (s *runs) Read(ctx context.Context, runID string) (*Run, error) {
+	return s.readWithOptions(ctx, "Runs.Read", runID, RunReadOptions{})
+}
+
+// RunReadOptions represents the options for reading a run.
+type RunReadOptions struct {
+	// The related resources to include in the response.
+	Include []RunIncludeOpt `url:"include,omitempty,comma"`
+}
+
+// ReadWithOptions reads a run by its ID with the given options.
+func (s *runs) ReadWithOptions(ctx context.Context, runID string, options RunReadOptions) (*Run, error) {
+	return s.readWithOptions(ctx, "Runs.ReadWithOptions", runID, options)
+}
+
+// readWithOptions implements ReadWithOptions, naming the request after the
+// given operation.
+func (s *runs) readWithOptions(ctx context.Context, operation, runID string, options RunReadOptions) (*Run, error) {
 	if !validStringID(&runID) {
 		return nil, errors.New("invalid value for run ID")
 	}
 
 	u := fmt.Sprintf("runs/%s", url.QueryEscape(runID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest(operation, "GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -264,7 +496,7 @@ func (s *runs) Apply(ctx context.Context, runID string, options RunApplyOptions)
 	}
 
 	u := fmt.Sprintf("runs/%s/actions/apply", url.QueryEscape(runID))
-	req, err := s.client.newRequest("POST", u, &options)
+	req, err := s.client.newRequest("Runs.Apply", "POST", u, &options)
 	if err != nil {
 		return err
 	}
@@ -285,7 +517,7 @@ func (s *runs) Cancel(ctx context.Context, runID string, options RunCancelOption
 	}
 
 	u := fmt.Sprintf("runs/%s/actions/cancel", url.QueryEscape(runID))
-	req, err := s.client.newRequest("POST", u, &options)
+	req, err := s.client.newRequest("Runs.Cancel", "POST", u, &options)
 	if err != nil {
 		return err
 	}
@@ -306,7 +538,7 @@ func (s *runs) ForceCancel(ctx context.Context, runID string, options RunForceCa
 	}
 
 	u := fmt.Sprintf("runs/%s/actions/force-cancel", url.QueryEscape(runID))
-	req, err := s.client.newRequest("POST", u, &options)
+	req, err := s.client.newRequest("Runs.ForceCancel", "POST", u, &options)
 	if err != nil {
 		return err
 	}
@@ -327,7 +559,7 @@ func (s *runs) Discard(ctx context.Context, runID string, options RunDiscardOpti
 	}
 
 	u := fmt.Sprintf("runs/%s/actions/discard", url.QueryEscape(runID))
-	req, err := s.client.newRequest("POST", u, &options)
+	req, err := s.client.newRequest("Runs.Discard", "POST", u, &options)
 	if err != nil {
 		return err
 	}
@@ -1,7 +1,13 @@
 package tfe
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
 	"testing"
 	"time"
 
@@ -106,6 +112,161 @@ func TestRunsCreate(t *testing.T) {
 		assert.Equal(t, *options.Message, r.Message)
 		assert.Equal(t, options.TargetAddrs, r.TargetAddrs)
 	})
+
+	t.Run("with a plan-only run", func(t *testing.T) {
+		options := RunCreateOptions{
+			Workspace:    wTest,
+			PlanOnly:     Bool(true),
+			Refresh:      Bool(false),
+			ReplaceAddrs: []string{"null_resource.example"},
+			Variables:    []*RunVariable{{Key: "region", Value: `"us-east-1"`}},
+		}
+
+		r, err := client.Runs.Create(ctx, options)
+		require.NoError(t, err)
+		assert.True(t, r.PlanOnly)
+		assert.False(t, r.Refresh)
+		assert.Equal(t, options.ReplaceAddrs, r.ReplaceAddrs)
+		assert.Equal(t, options.Variables, r.Variables)
+	})
+}
+
+func TestRunsCreate_options(t *testing.T) {
+	ctx := context.Background()
+
+	var body []byte
+	client, done := testMiddlewareClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ = ioutil.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write(bytes.Replace(body, []byte(`"type":"runs"`), []byte(`"id":"run-123","type":"runs"`), 1))
+	})
+	defer done()
+
+	r, err := client.Runs.Create(ctx, RunCreateOptions{
+		Workspace:       &Workspace{ID: "ws-123"},
+		RefreshOnly:     Bool(true),
+		AutoApply:       Bool(true),
+		AllowEmptyApply: Bool(true),
+		TargetAddrs:     []string{"null_resource.foo"},
+		Variables: []*RunVariable{
+			{Key: "region", Value: `"us-east-1"`},
+			{Key: "count", Value: "2"},
+		},
+	})
+	require.NoError(t, err)
+
+	var doc struct {
+		Data struct {
+			Attributes map[string]interface{} `json:"attributes"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(body, &doc))
+	assert.Equal(t, map[string]interface{}{
+		"refresh-only":      true,
+		"auto-apply":        true,
+		"allow-empty-apply": true,
+		"target-addrs":      []interface{}{"null_resource.foo"},
+		"variables": []interface{}{
+			map[string]interface{}{"key": "region", "value": `"us-east-1"`},
+			map[string]interface{}{"key": "count", "value": "2"},
+		},
+	}, doc.Data.Attributes)
+
+	assert.Equal(t, "run-123", r.ID)
+	assert.True(t, r.RefreshOnly)
+	assert.True(t, r.AutoApply)
+	assert.True(t, r.AllowEmptyApply)
+	assert.False(t, r.PlanOnly)
+	assert.Equal(t, []*RunVariable{
+		{Key: "region", Value: `"us-east-1"`},
+		{Key: "count", Value: "2"},
+	}, r.Variables)
+}
+
+func TestRunCreateOptions_Validate(t *testing.T) {
+	ws := &Workspace{ID: "ws-123"}
+
+	t.Run("with valid options", func(t *testing.T) {
+		err := RunCreateOptions{
+			Workspace:    ws,
+			PlanOnly:     Bool(true),
+			Refresh:      Bool(false),
+			ReplaceAddrs: []string{"null_resource.foo"},
+			Variables:    []*RunVariable{{Key: "region", Value: `"us-east-1"`}},
+		}.Validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("with a destroying refresh-only run", func(t *testing.T) {
+		err := RunCreateOptions{
+			Workspace:   ws,
+			IsDestroy:   Bool(true),
+			RefreshOnly: Bool(true),
+		}.Validate()
+		assert.EqualError(t, err, "refresh-only runs can not be destroy runs")
+	})
+
+	t.Run("with a refresh-only run without refresh", func(t *testing.T) {
+		err := RunCreateOptions{
+			Workspace:   ws,
+			Refresh:     Bool(false),
+			RefreshOnly: Bool(true),
+		}.Validate()
+		assert.EqualError(t, err, "refresh-only runs require refresh")
+	})
+
+	t.Run("with replace addresses", func(t *testing.T) {
+		err := RunCreateOptions{
+			Workspace:    ws,
+			RefreshOnly:  Bool(true),
+			ReplaceAddrs: []string{"null_resource.foo"},
+		}.Validate()
+		assert.EqualError(t, err, "refresh-only runs can not replace resources")
+
+		err = RunCreateOptions{
+			Workspace:    ws,
+			IsDestroy:    Bool(true),
+			ReplaceAddrs: []string{"null_resource.foo"},
+		}.Validate()
+		assert.EqualError(t, err, "destroy runs can not replace resources")
+
+		err = RunCreateOptions{
+			Workspace:    ws,
+			ReplaceAddrs: []string{""},
+		}.Validate()
+		assert.EqualError(t, err, "replace addresses must not be empty")
+	})
+
+	t.Run("with an applied plan-only run", func(t *testing.T) {
+		err := RunCreateOptions{
+			Workspace: ws,
+			PlanOnly:  Bool(true),
+			AutoApply: Bool(true),
+		}.Validate()
+		assert.EqualError(t, err, "plan-only runs can not be applied")
+	})
+
+	t.Run("with invalid variables", func(t *testing.T) {
+		err := RunCreateOptions{
+			Variables: []*RunVariable{
+				{Key: "region", Value: `"us-east-1"`},
+				{Key: "region", Value: `"eu-west-1"`},
+			},
+		}.Validate()
+
+		var verr *ValidationError
+		require.True(t, errors.As(err, &verr))
+		require.Len(t, verr.Fields, 2)
+		assert.Equal(t, "workspace is required", verr.Field("workspace").Reason)
+		assert.Equal(t, `duplicate variable key "region"`, verr.Field("variables.key").Reason)
+
+		err = RunCreateOptions{
+			Workspace: ws,
+			Variables: []*RunVariable{{Value: "1"}},
+		}.Validate()
+		assert.EqualError(t, err, "variable key is required")
+	})
 }
 
 func TestRunsRead(t *testing.T) {
@@ -124,7 +285,7 @@ func TestRunsRead(t *testing.T) {
 	t.Run("when the run does not exist", func(t *testing.T) {
 		r, err := client.Runs.Read(ctx, "nonexisting")
 		assert.Nil(t, r)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("with invalid run ID", func(t *testing.T) {
@@ -134,6 +295,177 @@ func TestRunsRead(t *testing.T) {
 	})
 }
 
+func TestRunsReadWithOptions(t *testing.T) {
+	ctx := context.Background()
+
+	var query url.Values
+	client, done := testMiddlewareClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.Write([]byte(`{
+			"data": {
+				"id": "run-123",
+				"type": "runs",
+				"attributes": {"status": "planned"},
+				"relationships": {
+					"apply": {"data": {"id": "apply-123", "type": "applies"}},
+					"cost-estimate": {"data": {"id": "ce-123", "type": "cost-estimates"}},
+					"plan": {"data": {"id": "plan-123", "type": "plans"}},
+					"policy-checks": {"data": [{"id": "polchk-123", "type": "policy-checks"}]}
+				}
+			},
+			"included": [
+				{"id": "apply-123", "type": "applies", "attributes": {"status": "pending"}},
+				{"id": "ce-123", "type": "cost-estimates", "attributes": {"status": "finished"}},
+				{"id": "plan-123", "type": "plans", "attributes": {"has-changes": true, "resource-additions": 2, "status": "finished"}},
+				{"id": "polchk-123", "type": "policy-checks", "attributes": {"status": "soft_failed"}}
+			]
+		}`))
+	})
+	defer done()
+
+	t.Run("with includes", func(t *testing.T) {
+		r, err := client.Runs.ReadWithOptions(ctx, "run-123", RunReadOptions{
+			Include: []RunIncludeOpt{RunIncludePlan, RunIncludeApply, RunIncludeCostEstimate, RunIncludePolicyChecks},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "plan,apply,cost_estimate,policy_checks", query.Get("include"))
+
+		require.NotNil(t, r.Plan)
+		assert.Equal(t, PlanFinished, r.Plan.Status)
+		assert.True(t, r.Plan.HasChanges)
+		assert.Equal(t, 2, r.Plan.ResourceAdditions)
+
+		require.NotNil(t, r.Apply)
+		assert.Equal(t, ApplyPending, r.Apply.Status)
+
+		require.NotNil(t, r.CostEstimate)
+		assert.Equal(t, CostEstimateFinished, r.CostEstimate.Status)
+
+		require.Len(t, r.PolicyChecks, 1)
+		assert.Equal(t, PolicySoftFailed, r.PolicyChecks[0].Status)
+	})
+
+	t.Run("without includes", func(t *testing.T) {
+		_, err := client.Runs.Read(ctx, "run-123")
+		require.NoError(t, err)
+		_, ok := query["include"]
+		assert.False(t, ok)
+	})
+
+	t.Run("with invalid run ID", func(t *testing.T) {
+		r, err := client.Runs.ReadWithOptions(ctx, badIdentifier, RunReadOptions{})
+		assert.Nil(t, r)
+		assert.EqualError(t, err, "invalid value for run ID")
+	})
+}
+
+func TestRunsList_include(t *testing.T) {
+	ctx := context.Background()
+
+	var query url.Values
+	client, done := testMiddlewareClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.Write([]byte(`{
+			"data": [
+				{"id": "run-1", "type": "runs", "relationships": {"plan": {"data": {"id": "plan-1", "type": "plans"}}}},
+				{"id": "run-2", "type": "runs", "relationships": {"plan": {"data": {"id": "plan-2", "type": "plans"}}}}
+			],
+			"included": [
+				{"id": "plan-1", "type": "plans", "attributes": {"status": "finished"}},
+				{"id": "plan-2", "type": "plans", "attributes": {"status": "errored"}}
+			]
+		}`))
+	})
+	defer done()
+
+	rl, err := client.Runs.List(ctx, "ws-123", RunListOptions{
+		Include: []RunIncludeOpt{RunIncludePlan},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "plan", query.Get("include"))
+
+	require.Len(t, rl.Items, 2)
+	assert.Equal(t, PlanFinished, rl.Items[0].Plan.Status)
+	assert.Equal(t, PlanErrored, rl.Items[1].Plan.Status)
+}
+
+func TestRunsList_filters(t *testing.T) {
+	ctx := context.Background()
+
+	var query url.Values
+	client, done := testMiddlewareClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.Write([]byte(`{
+			"data": [
+				{"id": "run-3", "type": "runs", "attributes": {"created-at": "2020-01-03T00:00:00Z"}},
+				{"id": "run-2", "type": "runs", "attributes": {"created-at": "2020-01-02T00:00:00Z"}},
+				{"id": "run-1", "type": "runs", "attributes": {"created-at": "2020-01-01T00:00:00Z"}}
+			]
+		}`))
+	})
+	defer done()
+
+	t.Run("with server-side filters", func(t *testing.T) {
+		rl, err := client.Runs.List(ctx, "ws-123", RunListOptions{
+			Status:    []RunStatus{RunErrored, RunCanceled},
+			Source:    []RunSource{RunSourceAPI},
+			Operation: []RunOperation{RunOperationPlanOnly},
+			Commit:    String("abc123"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "errored,canceled", query.Get("filter[status]"))
+		assert.Equal(t, "tfe-api", query.Get("filter[source]"))
+		assert.Equal(t, "plan_only", query.Get("filter[operation]"))
+		assert.Equal(t, "abc123", query.Get("search[commit]"))
+		assert.Len(t, rl.Items, 3)
+	})
+
+	t.Run("with a time range", func(t *testing.T) {
+		rl, err := client.Runs.List(ctx, "ws-123", RunListOptions{
+			CreatedAfter:  time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+			CreatedBefore: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
+		})
+		require.NoError(t, err)
+		require.Len(t, rl.Items, 1)
+		assert.Equal(t, "run-2", rl.Items[0].ID)
+	})
+
+	t.Run("with an invalid time range", func(t *testing.T) {
+		rl, err := client.Runs.List(ctx, "ws-123", RunListOptions{
+			CreatedAfter:  time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
+			CreatedBefore: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+		})
+		assert.Nil(t, rl)
+		assert.EqualError(t, err, "created before must be after created after")
+	})
+
+	t.Run("with a filter on a field of the same name", func(t *testing.T) {
+		query = nil
+		rl, err := client.Runs.List(ctx, "ws-123", RunListOptions{
+			Status: []RunStatus{RunErrored},
+			Source: []RunSource{RunSourceAPI},
+			Filter: NewFilter().Add("status", "canceled").Add("source", "tfe-ui"),
+		})
+		assert.Nil(t, rl)
+		assert.Nil(t, query)
+		assert.EqualError(t, err, "status cannot be combined with a filter on status; "+
+			"source cannot be combined with a filter on source")
+	})
+
+	t.Run("with filters on other fields", func(t *testing.T) {
+		_, err := client.Runs.List(ctx, "ws-123", RunListOptions{
+			Status: []RunStatus{RunErrored},
+			Filter: NewFilter().Add("workspace.name", "prod"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "errored", query.Get("filter[status]"))
+		assert.Equal(t, "prod", query.Get("filter[workspace][name]"))
+	})
+}
+
 func TestRunsApply(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -148,7 +480,7 @@ func TestRunsApply(t *testing.T) {
 
 	t.Run("when the run does not exist", func(t *testing.T) {
 		err := client.Runs.Apply(ctx, "nonexisting", RunApplyOptions{})
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("with invalid run ID", func(t *testing.T) {
@@ -178,7 +510,7 @@ func TestRunsCancel(t *testing.T) {
 
 	t.Run("when the run does not exist", func(t *testing.T) {
 		err := client.Runs.Cancel(ctx, "nonexisting", RunCancelOptions{})
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("with invalid run ID", func(t *testing.T) {
@@ -228,7 +560,7 @@ func TestRunsForceCancel(t *testing.T) {
 				t.Fatal("Timeout waiting for run to be canceled")
 			}
 
-			time.Sleep(time.Second)
+			testSleep(t, time.Second)
 		}
 
 		t.Run("force-cancel-available-at timestamp is present", func(t *testing.T) {
@@ -246,7 +578,7 @@ func TestRunsForceCancel(t *testing.T) {
 
 	t.Run("when the run does not exist", func(t *testing.T) {
 		err := client.Runs.ForceCancel(ctx, "nonexisting", RunForceCancelOptions{})
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("with invalid run ID", func(t *testing.T) {
@@ -269,7 +601,7 @@ func TestRunsDiscard(t *testing.T) {
 
 	t.Run("when the run does not exist", func(t *testing.T) {
 		err := client.Runs.Discard(ctx, "nonexisting", RunDiscardOptions{})
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("with invalid run ID", func(t *testing.T) {
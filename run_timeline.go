@@ -0,0 +1,460 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// RunPhase represents a phase of the lifecycle of a run.
+type RunPhase string
+
+// List all available run phases, in the order a run passes them.
+const (
+	RunPhaseQueued         RunPhase = "queued"
+	RunPhasePlanning       RunPhase = "planning"
+	RunPhaseCostEstimating RunPhase = "cost_estimating"
+	RunPhasePolicyChecking RunPhase = "policy_checking"
+	RunPhaseConfirming     RunPhase = "confirming"
+	RunPhaseApplying       RunPhase = "applying"
+)
+
+// RunPhaseTiming holds the start, end and duration of a single phase.
+type RunPhaseTiming struct {
+	Phase RunPhase
+	Start time.Time
+
+	// The end of the phase, which is zero while the phase is in progress.
+	End time.Time
+
+	// The duration of the phase. Phases in progress last until the time the
+	// timeline was created.
+	Duration time.Duration
+}
+
+// RunTimeline correlates the status timestamps of a run and its plan, cost
+// estimate, policy checks and apply into the phases the run went through.
+type RunTimeline struct {
+	// The run the timeline was created for.
+	Run *Run
+
+	// The phases the run went through, in order. Phases the run skipped,
+	// like cost estimating when cost estimation is disabled, are left out.
+	Phases []*RunPhaseTiming
+
+	// The time the run waited for a worker, before planning and before
+	// applying.
+	QueueWait time.Duration
+
+	// The time from the creation of the run until it finished, or until the
+	// timeline was created when it did not finish yet.
+	Total time.Duration
+}
+
+// NewRunTimeline creates the timeline of a run, which must include its
+// plan, apply and cost estimate to account for their phases. The policy
+// checks are listed separately, using PolicyChecks.List.
+func NewRunTimeline(r *Run, policyChecks []*PolicyCheck) *RunTimeline {
+	return newRunTimeline(r, policyChecks, time.Now())
+}
+
+func newRunTimeline(r *Run, policyChecks []*PolicyCheck, now time.Time) *RunTimeline {
+	t := &RunTimeline{Run: r}
+
+	rts := r.StatusTimestamps
+	if rts == nil {
+		rts = &RunStatusTimestamps{}
+	}
+
+	add := func(phase RunPhase, start, end time.Time) *RunPhaseTiming {
+		if start.IsZero() {
+			return nil
+		}
+		p := &RunPhaseTiming{Phase: phase, Start: start, End: end}
+		if end.IsZero() {
+			end = now
+		}
+		if end.After(start) {
+			p.Duration = end.Sub(start)
+		}
+		t.Phases = append(t.Phases, p)
+		return p
+	}
+
+	var planStart, planEnd time.Time
+	if r.Plan != nil && r.Plan.StatusTimestamps != nil {
+		pts := r.Plan.StatusTimestamps
+		planStart = pts.StartedAt
+		planEnd = firstTime(pts.FinishedAt, pts.ErroredAt, pts.CanceledAt, pts.ForceCanceledAt)
+	}
+	if planStart.IsZero() {
+		planStart = rts.PlanningAt
+		planEnd = firstTime(rts.PlannedAt, rts.PlannedAndFinishedAt, rts.ErroredAt)
+	}
+
+	if queued := add(RunPhaseQueued, r.CreatedAt, planStart); queued != nil {
+		t.QueueWait += queued.Duration
+	}
+
+	// The phases before confirmation, of which the last one to end starts
+	// the confirmation.
+	var planned time.Time
+	if p := add(RunPhasePlanning, planStart, planEnd); p != nil {
+		planned = p.End
+	}
+
+	if r.CostEstimate != nil && r.CostEstimate.StatusTimestamps != nil {
+		cts := r.CostEstimate.StatusTimestamps
+		end := firstTime(cts.FinishedAt, cts.ErroredAt, cts.CanceledAt, cts.SkippedDueToTargetingAt)
+		if p := add(RunPhaseCostEstimating, firstTime(cts.QueuedAt, cts.PendingAt), end); p != nil {
+			planned = latestTime(planned, p.End)
+		}
+	}
+
+	var checkStart, checkEnd time.Time
+	checking := false
+	for _, pc := range policyChecks {
+		if pc.StatusTimestamps == nil {
+			continue
+		}
+		pts := pc.StatusTimestamps
+		checkStart = earliestTime(checkStart, pts.QueuedAt)
+		end := firstTime(pts.PassedAt, pts.SoftFailedAt, pts.HardFailedAt, pts.ErroredAt)
+		if end.IsZero() {
+			checking = true
+		}
+		checkEnd = latestTime(checkEnd, end)
+	}
+	if checking {
+		checkEnd = time.Time{}
+	}
+	if p := add(RunPhasePolicyChecking, checkStart, checkEnd); p != nil {
+		planned = latestTime(planned, p.End)
+	}
+
+	var apply *ApplyStatusTimestamps
+	if r.Apply != nil {
+		apply = r.Apply.StatusTimestamps
+	}
+	if apply == nil {
+		apply = &ApplyStatusTimestamps{}
+	}
+
+	// Runs applied automatically do not wait for confirmation.
+	switch {
+	case r.AutoApply:
+	case !apply.QueuedAt.IsZero():
+		add(RunPhaseConfirming, planned, apply.QueuedAt)
+	case r.Status.IsConfirmable():
+		add(RunPhaseConfirming, planned, time.Time{})
+	case r.Status == RunDiscarded:
+		add(RunPhaseConfirming, planned, rts.FinishedAt)
+	}
+
+	if !apply.QueuedAt.IsZero() && !apply.StartedAt.IsZero() && apply.StartedAt.After(apply.QueuedAt) {
+		t.QueueWait += apply.StartedAt.Sub(apply.QueuedAt)
+	}
+	applyStart := firstTime(apply.StartedAt, rts.ApplyingAt)
+	applyEnd := firstTime(apply.FinishedAt, apply.ErroredAt, apply.CanceledAt, apply.ForceCanceledAt, rts.AppliedAt)
+	add(RunPhaseApplying, applyStart, applyEnd)
+
+	end := firstTime(rts.FinishedAt, rts.AppliedAt, rts.PlannedAndFinishedAt, rts.PolicySoftFailedAt, rts.ErroredAt)
+	if end.IsZero() || !r.Status.IsFinal() {
+		end = now
+	}
+	if !r.CreatedAt.IsZero() && end.After(r.CreatedAt) {
+		t.Total = end.Sub(r.CreatedAt)
+	}
+
+	return t
+}
+
+// Phase returns the timing of the given phase, or nil if the run did not
+// go through it.
+func (t *RunTimeline) Phase(phase RunPhase) *RunPhaseTiming {
+	for _, p := range t.Phases {
+		if p.Phase == phase {
+			return p
+		}
+	}
+	return nil
+}
+
+// firstTime returns the first of the times that is set.
+func firstTime(times ...time.Time) time.Time {
+	for _, t := range times {
+		if !t.IsZero() {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// earliestTime returns the earlier of two times, ignoring unset times.
+func earliestTime(a, b time.Time) time.Time {
+	if a.IsZero() || (!b.IsZero() && b.Before(a)) {
+		return b
+	}
+	return a
+}
+
+// latestTime returns the later of two times, ignoring unset times.
+func latestTime(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+// DurationStats summarizes a set of durations.
+type DurationStats struct {
+	// The number of durations.
+	Count int
+
+	Min  time.Duration
+	Max  time.Duration
+	Mean time.Duration
+
+	// The median and 95th percentile, using the nearest-rank method.
+	P50 time.Duration
+	P95 time.Duration
+}
+
+// newDurationStats summarizes the durations, which are sorted in place.
+func newDurationStats(durations []time.Duration) DurationStats {
+	if len(durations) == 0 {
+		return DurationStats{}
+	}
+
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+
+	var sum time.Duration
+	for _, d := range durations {
+		sum += d
+	}
+
+	return DurationStats{
+		Count: len(durations),
+		Min:   durations[0],
+		Max:   durations[len(durations)-1],
+		Mean:  sum / time.Duration(len(durations)),
+		P50:   percentile(durations, 50),
+		P95:   percentile(durations, 95),
+	}
+}
+
+// percentile returns the p-th percentile of the sorted durations.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// RunStatistics holds aggregate statistics about the phases of a set of
+// runs.
+type RunStatistics struct {
+	// The timelines of the analyzed runs, newest first.
+	Timelines []*RunTimeline
+
+	// The durations of every phase. Only completed phases are counted, and
+	// only runs that went through a phase are counted for it.
+	Phases map[RunPhase]DurationStats
+
+	// The time the runs waited for a worker.
+	QueueWait DurationStats
+
+	// The total duration of the finished runs.
+	Total DurationStats
+}
+
+// NewRunStatistics computes statistics about the given timelines.
+func NewRunStatistics(timelines []*RunTimeline) *RunStatistics {
+	s := &RunStatistics{
+		Timelines: timelines,
+		Phases:    make(map[RunPhase]DurationStats),
+	}
+
+	phases := make(map[RunPhase][]time.Duration)
+	var queueWaits, totals []time.Duration
+	for _, t := range timelines {
+		for _, p := range t.Phases {
+			if !p.End.IsZero() {
+				phases[p.Phase] = append(phases[p.Phase], p.Duration)
+			}
+		}
+		queueWaits = append(queueWaits, t.QueueWait)
+		if t.Run.Status.IsFinal() {
+			totals = append(totals, t.Total)
+		}
+	}
+
+	for phase, durations := range phases {
+		s.Phases[phase] = newDurationStats(durations)
+	}
+	s.QueueWait = newDurationStats(queueWaits)
+	s.Total = newDurationStats(totals)
+
+	return s
+}
+
+// DefaultRunAnalysisItems is the number of runs analyzed when no maximum is
+// configured.
+const DefaultRunAnalysisItems = 100
+
+// RunAnalysisOptions represents the options for analyzing the runs of a
+// workspace or organization.
+type RunAnalysisOptions struct {
+	// The filters selecting the runs to analyze. The included resources are
+	// set by the analysis.
+	Runs RunListOptions
+
+	// The workspaces whose runs are analyzed, when analyzing the runs of an
+	// organization.
+	Workspaces WorkspaceListOptions
+
+	// The maximum number of runs to analyze, newest first. The
+	// DefaultRunAnalysisItems is used when left empty.
+	MaxItems int
+
+	// The maximum number of requests made at the same time. The
+	// DefaultBatchConcurrency is used when left empty.
+	Concurrency int
+
+	// Do not list the policy checks of the runs, which saves a request per
+	// run but leaves out the policy checking phase.
+	SkipPolicyChecks bool
+}
+
+// listOptions returns the options for listing the runs to analyze.
+func (o RunAnalysisOptions) listOptions() OrganizationRunListOptions {
+	lo := OrganizationRunListOptions{
+		Runs:        o.Runs,
+		Workspaces:  o.Workspaces,
+		MaxItems:    o.MaxItems,
+		Concurrency: o.Concurrency,
+	}
+	lo.Runs.Include = []RunIncludeOpt{RunIncludePlan, RunIncludeApply, RunIncludeCostEstimate}
+	if lo.MaxItems == 0 {
+		lo.MaxItems = DefaultRunAnalysisItems
+	}
+	return lo
+}
+
+// RunTimeline reads a run with its plan, apply, cost estimate and policy
+// checks and returns its timeline.
+func (c *Client) RunTimeline(ctx context.Context, runID string) (*RunTimeline, error) {
+	if !validStringID(&runID) {
+		return nil, errors.New("invalid value for run ID")
+	}
+
+	r, err := c.Runs.ReadWithOptions(ctx, runID, RunReadOptions{
+		Include: []RunIncludeOpt{RunIncludePlan, RunIncludeApply, RunIncludeCostEstimate},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pcl, err := c.PolicyChecks.List(ctx, runID, PolicyCheckListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRunTimeline(r, policyCheckItems(pcl)), nil
+}
+
+// WorkspaceRunStatistics analyzes the most recent runs of a workspace:
+//
+//	stats, err := client.WorkspaceRunStatistics(ctx, "ws-123", tfe.RunAnalysisOptions{
+//		Runs: tfe.RunListOptions{CreatedAfter: time.Now().Add(-7 * 24 * time.Hour)},
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	planning := stats.Phases[tfe.RunPhasePlanning]
+//	fmt.Printf("planning p50 %s, p95 %s\n", planning.P50, planning.P95)
+func (c *Client) WorkspaceRunStatistics(ctx context.Context, workspaceID string, options RunAnalysisOptions) (*RunStatistics, error) {
+	if !validStringID(&workspaceID) {
+		return nil, errors.New("invalid value for workspace ID")
+	}
+
+	lo := options.listOptions()
+	if err := lo.valid(); err != nil {
+		return nil, err
+	}
+
+	runs, err := c.listWorkspaceRuns(ctx, workspaceID, lo)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.runStatistics(ctx, runs, options)
+}
+
+// OrganizationRunStatistics analyzes the most recent runs of all workspaces
+// of an organization, like WorkspaceRunStatistics does for a workspace.
+func (c *Client) OrganizationRunStatistics(ctx context.Context, organization string, options RunAnalysisOptions) (*RunStatistics, error) {
+	runs, err := c.ListOrganizationRuns(ctx, organization, options.listOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.runStatistics(ctx, runs, options)
+}
+
+// runStatistics lists the policy checks of the runs, unless skipped, and
+// computes the statistics of their timelines.
+func (c *Client) runStatistics(ctx context.Context, runs []*Run, options RunAnalysisOptions) (*RunStatistics, error) {
+	timelines := make([]*RunTimeline, len(runs))
+	now := time.Now()
+
+	if options.SkipPolicyChecks {
+		for i, r := range runs {
+			timelines[i] = newRunTimeline(r, nil, now)
+		}
+		return NewRunStatistics(timelines), nil
+	}
+
+	ops := make([]BatchOperation, len(runs))
+	for i, r := range runs {
+		runID := r.ID
+		ops[i] = BatchOperation{
+			Name: runID,
+			Fn: func(ctx context.Context) (interface{}, error) {
+				return c.PolicyChecks.List(ctx, runID, PolicyCheckListOptions{})
+			},
+		}
+	}
+
+	results, err := RunBatch(ctx, ops, BatchOptions{
+		Concurrency: options.Concurrency,
+		StopOnError: true,
+	})
+	if berr, ok := err.(*BatchError); ok && len(berr.Failed) > 0 {
+		first := berr.Failed[0]
+		return nil, fmt.Errorf("error listing policy checks of run %s: %w", first.Name, first.Err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i, r := range runs {
+		pcl, _ := results[i].Value.(*PolicyCheckList)
+		timelines[i] = newRunTimeline(r, policyCheckItems(pcl), now)
+	}
+
+	return NewRunStatistics(timelines), nil
+}
+
+// policyCheckItems returns the policy checks of the list, which may be nil.
+func policyCheckItems(pcl *PolicyCheckList) []*PolicyCheck {
+	if pcl == nil {
+		return nil
+	}
+	return pcl.Items
+}
@@ -0,0 +1,302 @@
+package tfe
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testTimelineStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// testTimelineAt returns the time the given number of seconds after the test start.
+func testTimelineAt(seconds int) time.Time {
+	return testTimelineStart.Add(time.Duration(seconds) * time.Second)
+}
+
+func testTimelineRun() (*Run, []*PolicyCheck) {
+	r := &Run{
+		ID:        "run-123",
+		CreatedAt: testTimelineAt(0),
+		Status:    RunApplied,
+		StatusTimestamps: &RunStatusTimestamps{
+			PlanningAt: testTimelineAt(10),
+			PlannedAt:  testTimelineAt(70),
+			ApplyingAt: testTimelineAt(205),
+			AppliedAt:  testTimelineAt(265),
+			FinishedAt: testTimelineAt(265),
+		},
+		Plan: &Plan{
+			ID: "plan-123",
+			StatusTimestamps: &PlanStatusTimestamps{
+				QueuedAt:   testTimelineAt(1),
+				StartedAt:  testTimelineAt(10),
+				FinishedAt: testTimelineAt(70),
+			},
+		},
+		CostEstimate: &CostEstimate{
+			ID: "ce-123",
+			StatusTimestamps: &CostEstimateStatusTimestamps{
+				QueuedAt:   testTimelineAt(70),
+				FinishedAt: testTimelineAt(75),
+			},
+		},
+		Apply: &Apply{
+			ID: "apply-123",
+			StatusTimestamps: &ApplyStatusTimestamps{
+				QueuedAt:   testTimelineAt(200),
+				StartedAt:  testTimelineAt(205),
+				FinishedAt: testTimelineAt(265),
+			},
+		},
+	}
+	pcs := []*PolicyCheck{
+		{ID: "polchk-1", StatusTimestamps: &PolicyStatusTimestamps{QueuedAt: testTimelineAt(75), PassedAt: testTimelineAt(78)}},
+		{ID: "polchk-2", StatusTimestamps: &PolicyStatusTimestamps{QueuedAt: testTimelineAt(76), SoftFailedAt: testTimelineAt(80)}},
+	}
+	return r, pcs
+}
+
+func TestNewRunTimeline(t *testing.T) {
+	t.Run("with an applied run", func(t *testing.T) {
+		r, pcs := testTimelineRun()
+		tl := newRunTimeline(r, pcs, testTimelineAt(1000))
+
+		var phases []RunPhase
+		durations := make(map[RunPhase]time.Duration)
+		for _, p := range tl.Phases {
+			phases = append(phases, p.Phase)
+			durations[p.Phase] = p.Duration
+			assert.False(t, p.End.IsZero())
+		}
+		assert.Equal(t, []RunPhase{
+			RunPhaseQueued,
+			RunPhasePlanning,
+			RunPhaseCostEstimating,
+			RunPhasePolicyChecking,
+			RunPhaseConfirming,
+			RunPhaseApplying,
+		}, phases)
+		assert.Equal(t, map[RunPhase]time.Duration{
+			RunPhaseQueued:         10 * time.Second,
+			RunPhasePlanning:       60 * time.Second,
+			RunPhaseCostEstimating: 5 * time.Second,
+			RunPhasePolicyChecking: 5 * time.Second,
+			RunPhaseConfirming:     120 * time.Second,
+			RunPhaseApplying:       60 * time.Second,
+		}, durations)
+
+		assert.Equal(t, 15*time.Second, tl.QueueWait)
+		assert.Equal(t, 265*time.Second, tl.Total)
+	})
+
+	t.Run("with a run waiting for confirmation", func(t *testing.T) {
+		r, pcs := testTimelineRun()
+		r.Status = RunPolicyOverride
+		r.StatusTimestamps.ApplyingAt = time.Time{}
+		r.StatusTimestamps.AppliedAt = time.Time{}
+		r.StatusTimestamps.FinishedAt = time.Time{}
+		r.Apply.StatusTimestamps = &ApplyStatusTimestamps{}
+
+		tl := newRunTimeline(r, pcs, testTimelineAt(100))
+
+		confirming := tl.Phase(RunPhaseConfirming)
+		require.NotNil(t, confirming)
+		assert.Equal(t, testTimelineAt(80), confirming.Start)
+		assert.True(t, confirming.End.IsZero())
+		assert.Equal(t, 20*time.Second, confirming.Duration)
+		assert.Nil(t, tl.Phase(RunPhaseApplying))
+		assert.Equal(t, 100*time.Second, tl.Total)
+	})
+
+	t.Run("with a plan-only run soft failing policy checks", func(t *testing.T) {
+		r, pcs := testTimelineRun()
+		r.Status = RunPolicySoftFailed
+		r.PlanOnly = true
+		r.StatusTimestamps.ApplyingAt = time.Time{}
+		r.StatusTimestamps.AppliedAt = time.Time{}
+		r.StatusTimestamps.FinishedAt = time.Time{}
+		r.StatusTimestamps.PolicySoftFailedAt = testTimelineAt(80)
+		r.Apply = nil
+
+		tl := newRunTimeline(r, pcs, testTimelineAt(1000))
+		assert.Nil(t, tl.Phase(RunPhaseConfirming))
+		assert.Nil(t, tl.Phase(RunPhaseApplying))
+		assert.Equal(t, 80*time.Second, tl.Total)
+
+		stats := NewRunStatistics([]*RunTimeline{tl})
+		assert.Equal(t, 1, stats.Total.Count)
+	})
+
+	t.Run("with a run applied automatically", func(t *testing.T) {
+		r, pcs := testTimelineRun()
+		r.AutoApply = true
+
+		tl := newRunTimeline(r, pcs, testTimelineAt(1000))
+		assert.Nil(t, tl.Phase(RunPhaseConfirming))
+		assert.NotNil(t, tl.Phase(RunPhaseApplying))
+	})
+
+	t.Run("without phase details", func(t *testing.T) {
+		r, _ := testTimelineRun()
+		r.Plan = nil
+		r.CostEstimate = nil
+		r.Apply = nil
+
+		tl := newRunTimeline(r, nil, testTimelineAt(1000))
+
+		planning := tl.Phase(RunPhasePlanning)
+		require.NotNil(t, planning)
+		assert.Equal(t, 60*time.Second, planning.Duration)
+		assert.Nil(t, tl.Phase(RunPhaseCostEstimating))
+		assert.Nil(t, tl.Phase(RunPhasePolicyChecking))
+		assert.Nil(t, tl.Phase(RunPhaseConfirming))
+		assert.Equal(t, 60*time.Second, tl.Phase(RunPhaseApplying).Duration)
+	})
+}
+
+// testNilPolicyChecks lists no policy checks, like mocks.PolicyChecks does
+// by default.
+type testNilPolicyChecks struct {
+	PolicyChecks
+}
+
+func (s *testNilPolicyChecks) List(ctx context.Context, runID string, options PolicyCheckListOptions) (*PolicyCheckList, error) {
+	return nil, nil
+}
+
+type testTimelineRuns struct {
+	Runs
+	run *Run
+}
+
+func (s *testTimelineRuns) ReadWithOptions(ctx context.Context, runID string, options RunReadOptions) (*Run, error) {
+	return s.run, nil
+}
+
+func TestRunTimeline(t *testing.T) {
+	ctx := context.Background()
+
+	r, pcs := testTimelineRun()
+	client := &Client{
+		Runs:         &testTimelineRuns{run: r},
+		PolicyChecks: &testWorkflowPolicyChecks{checks: pcs},
+	}
+
+	t.Run("with policy checks", func(t *testing.T) {
+		tl, err := client.RunTimeline(ctx, "run-123")
+		require.NoError(t, err)
+		assert.NotNil(t, tl.Phase(RunPhasePolicyChecking))
+	})
+
+	t.Run("without a policy check list", func(t *testing.T) {
+		client.PolicyChecks = &testNilPolicyChecks{}
+
+		tl, err := client.RunTimeline(ctx, "run-123")
+		require.NoError(t, err)
+		assert.Nil(t, tl.Phase(RunPhasePolicyChecking))
+	})
+}
+
+func TestNewRunStatistics(t *testing.T) {
+	var timelines []*RunTimeline
+	for i := 1; i <= 20; i++ {
+		r, pcs := testTimelineRun()
+		r.Plan.StatusTimestamps.FinishedAt = testTimelineAt(10 + i)
+		timelines = append(timelines, newRunTimeline(r, pcs, testTimelineAt(1000)))
+	}
+
+	// A run still planning is only counted for its queue wait.
+	r := &Run{
+		ID:               "run-planning",
+		CreatedAt:        testTimelineAt(0),
+		Status:           RunPlanning,
+		StatusTimestamps: &RunStatusTimestamps{PlanningAt: testTimelineAt(30)},
+	}
+	timelines = append(timelines, newRunTimeline(r, nil, testTimelineAt(1000)))
+
+	stats := NewRunStatistics(timelines)
+	assert.Len(t, stats.Timelines, 21)
+
+	planning := stats.Phases[RunPhasePlanning]
+	assert.Equal(t, 20, planning.Count)
+	assert.Equal(t, 1*time.Second, planning.Min)
+	assert.Equal(t, 20*time.Second, planning.Max)
+	assert.Equal(t, 10500*time.Millisecond, planning.Mean)
+	assert.Equal(t, 10*time.Second, planning.P50)
+	assert.Equal(t, 19*time.Second, planning.P95)
+
+	assert.Equal(t, 21, stats.QueueWait.Count)
+	assert.Equal(t, 15*time.Second, stats.QueueWait.P50)
+	assert.Equal(t, 30*time.Second, stats.QueueWait.Max)
+	assert.Equal(t, 20, stats.Total.Count)
+
+	assert.Equal(t, DurationStats{}, NewRunStatistics(nil).Total)
+}
+
+func TestWorkspaceRunStatistics(t *testing.T) {
+	ctx := context.Background()
+
+	var runs []*Run
+	for i := 0; i < 3; i++ {
+		r, _ := testTimelineRun()
+		r.ID = "run-" + string(rune('a'+i))
+		r.CreatedAt = r.CreatedAt.Add(-time.Duration(i) * time.Hour)
+		runs = append(runs, r)
+	}
+	_, pcs := testTimelineRun()
+
+	client, rs := testOrganizationRunsClient(map[string][]*Run{"ws-1": runs})
+	client.PolicyChecks = &testWorkflowPolicyChecks{checks: pcs}
+
+	t.Run("with policy checks", func(t *testing.T) {
+		stats, err := client.WorkspaceRunStatistics(ctx, "ws-1", RunAnalysisOptions{MaxItems: 2})
+		require.NoError(t, err)
+		require.Len(t, stats.Timelines, 2)
+		assert.Equal(t, "run-a", stats.Timelines[0].Run.ID)
+		assert.Equal(t, 2, stats.Phases[RunPhasePolicyChecking].Count)
+		assert.Equal(t, 1, rs.pages["ws-1"])
+	})
+
+	t.Run("skipping policy checks", func(t *testing.T) {
+		stats, err := client.WorkspaceRunStatistics(ctx, "ws-1", RunAnalysisOptions{SkipPolicyChecks: true})
+		require.NoError(t, err)
+		require.Len(t, stats.Timelines, 3)
+		assert.Equal(t, 0, stats.Phases[RunPhasePolicyChecking].Count)
+		assert.Equal(t, 3, stats.Phases[RunPhaseApplying].Count)
+	})
+
+	t.Run("without a policy check list", func(t *testing.T) {
+		client, _ := testOrganizationRunsClient(map[string][]*Run{"ws-1": runs})
+		client.PolicyChecks = &testNilPolicyChecks{}
+
+		stats, err := client.WorkspaceRunStatistics(ctx, "ws-1", RunAnalysisOptions{})
+		require.NoError(t, err)
+		require.Len(t, stats.Timelines, 3)
+		assert.Equal(t, 0, stats.Phases[RunPhasePolicyChecking].Count)
+	})
+
+	t.Run("with a time range", func(t *testing.T) {
+		stats, err := client.WorkspaceRunStatistics(ctx, "ws-1", RunAnalysisOptions{
+			Runs:             RunListOptions{CreatedAfter: testTimelineStart.Add(-90 * time.Minute)},
+			SkipPolicyChecks: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, stats.Timelines, 2)
+		assert.Equal(t, "run-b", stats.Timelines[1].Run.ID)
+	})
+
+	t.Run("across an organization", func(t *testing.T) {
+		stats, err := client.OrganizationRunStatistics(ctx, "my-org", RunAnalysisOptions{})
+		require.NoError(t, err)
+		assert.Len(t, stats.Timelines, 3)
+		assert.Equal(t, 60*time.Second, stats.Phases[RunPhaseApplying].P95)
+	})
+
+	t.Run("with an invalid workspace ID", func(t *testing.T) {
+		_, err := client.WorkspaceRunStatistics(ctx, badIdentifier, RunAnalysisOptions{})
+		assert.EqualError(t, err, "invalid value for workspace ID")
+	})
+}
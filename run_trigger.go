@@ -62,13 +62,11 @@ type RunTriggerListOptions struct {
 }
 
 func (o RunTriggerListOptions) valid() error {
-	if !validString(o.RunTriggerType) {
-		return errors.New("run-trigger type is required")
-	}
-	if *o.RunTriggerType != "inbound" && *o.RunTriggerType != "outbound" {
-		return errors.New("invalid value for run-trigger type")
-	}
-	return nil
+	var v validator
+	v.check(validString(o.RunTriggerType), "filter[run-trigger][type]", "run-trigger type is required")
+	v.check(o.RunTriggerType == nil || *o.RunTriggerType == "inbound" || *o.RunTriggerType == "outbound",
+		"filter[run-trigger][type]", "invalid value for run-trigger type")
+	return v.err()
 }
 
 // List all the run triggers associated with a workspace.
@@ -81,8 +79,12 @@ func (s *runTriggers) List(ctx context.Context, workspaceID string, options RunT
 		return nil, err
 	}
 
+	if err := s.client.requireFeature(ctx, FeatureRunTriggers); err != nil {
+		return nil, err
+	}
+
 	u := fmt.Sprintf("workspaces/%s/run-triggers", url.QueryEscape(workspaceID))
-	req, err := s.client.newRequest("GET", u, options)
+	req, err := s.client.newRequest("RunTriggers.List", "GET", u, options)
 	if err != nil {
 		return nil, err
 	}
@@ -106,11 +108,11 @@ type RunTriggerCreateOptions struct {
 	Sourceable *Workspace `jsonapi:"relation,sourceable"`
 }
 
-func (o RunTriggerCreateOptions) valid() error {
-	if o.Sourceable == nil {
-		return errors.New("sourceable is required")
-	}
-	return nil
+// Validate checks that the sourceable workspace is set.
+func (o RunTriggerCreateOptions) Validate() error {
+	var v validator
+	v.check(o.Sourceable != nil, "sourceable", "sourceable is required")
+	return v.err()
 }
 
 // Creates a run trigger with the given options.
@@ -118,15 +120,19 @@ func (s *runTriggers) Create(ctx context.Context, workspaceID string, options Ru
 	if !validStringID(&workspaceID) {
 		return nil, errors.New("invalid value for workspace ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
 	// Make sure we don't send a user provided ID.
 	options.ID = ""
 
+	if err := s.client.requireFeature(ctx, FeatureRunTriggers); err != nil {
+		return nil, err
+	}
+
 	u := fmt.Sprintf("workspaces/%s/run-triggers", url.QueryEscape(workspaceID))
-	req, err := s.client.newRequest("POST", u, &options)
+	req, err := s.client.newRequest("RunTriggers.Create", "POST", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -146,8 +152,12 @@ func (s *runTriggers) Read(ctx context.Context, runTriggerID string) (*RunTrigge
 		return nil, errors.New("invalid value for run trigger ID")
 	}
 
+	if err := s.client.requireFeature(ctx, FeatureRunTriggers); err != nil {
+		return nil, err
+	}
+
 	u := fmt.Sprintf("run-triggers/%s", url.QueryEscape(runTriggerID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("RunTriggers.Read", "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -167,8 +177,12 @@ func (s *runTriggers) Delete(ctx context.Context, runTriggerID string) error {
 		return errors.New("invalid value for run trigger ID")
 	}
 
+	if err := s.client.requireFeature(ctx, FeatureRunTriggers); err != nil {
+		return err
+	}
+
 	u := fmt.Sprintf("run-triggers/%s", url.QueryEscape(runTriggerID))
-	req, err := s.client.newRequest("DELETE", u, nil)
+	req, err := s.client.newRequest("RunTriggers.Delete", "DELETE", u, nil)
 	if err != nil {
 		return err
 	}
@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -172,7 +173,7 @@ func TestRunTriggerRead(t *testing.T) {
 
 	t.Run("when the run trigger does not exist", func(t *testing.T) {
 		_, err := client.RunTriggers.Read(ctx, "nonexisting")
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("when the run trigger ID is invalid", func(t *testing.T) {
@@ -202,12 +203,12 @@ func TestRunTriggerDelete(t *testing.T) {
 		require.NoError(t, err)
 
 		_, err = client.RunTriggers.Read(ctx, rtTest.ID)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("when the run trigger does not exist", func(t *testing.T) {
 		err := client.RunTriggers.Delete(ctx, "nonexisting")
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("when the run trigger ID is invalid", func(t *testing.T) {
@@ -0,0 +1,143 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrRunFinished is returned by RunWatcher.WaitForStatus when the run
+// reached a final status other than the ones waited for.
+var ErrRunFinished = errors.New("run finished")
+
+// RunWatcherOptions represents the options for watching runs.
+type RunWatcherOptions struct {
+	// The time to wait between reads of the run while its status does not
+	// change. The interval starts at MinInterval, grows with every read that
+	// finds the same status up to MaxInterval, and is reset whenever the
+	// status changes. Defaults to 1 and 10 seconds.
+	MinInterval time.Duration
+	MaxInterval time.Duration
+
+	// The related resources to include when reading the run.
+	Include []RunIncludeOpt
+}
+
+// RunEvent describes a change of the status of a run.
+type RunEvent struct {
+	// The status before the change. It is empty for the first event of a
+	// run, which reports its status when watching started.
+	From RunStatus
+
+	// The status after the change.
+	To RunStatus
+
+	// The run as read after the change.
+	Run *Run
+}
+
+// RunWatcher follows the status of runs by reading them repeatedly, as the
+// API does not offer a stream of run events. Statuses a run passes between
+// two reads are not observed, so it is best to wait for statuses a run
+// pauses in, like the final and the confirmable ones.
+type RunWatcher struct {
+	runs    Runs
+	options RunWatcherOptions
+}
+
+// NewRunWatcher creates a new RunWatcher reading runs using the given
+// service, usually client.Runs.
+func NewRunWatcher(runs Runs, options RunWatcherOptions) *RunWatcher {
+	if options.MinInterval <= 0 {
+		options.MinInterval = time.Second
+	}
+	if options.MaxInterval < options.MinInterval {
+		options.MaxInterval = 10 * time.Second
+		if options.MaxInterval < options.MinInterval {
+			options.MaxInterval = options.MinInterval
+		}
+	}
+	return &RunWatcher{runs: runs, options: options}
+}
+
+// Watch calls fn for the current status of the run and for every change of
+// its status, until the run reaches a final status, fn returns an error or
+// ctx is done. It returns the run as last read, along with the error
+// returned by fn, so fn can stop watching early by returning a sentinel
+// error of its own.
+func (w *RunWatcher) Watch(ctx context.Context, runID string, fn func(RunEvent) error) (*Run, error) {
+	var last *Run
+	interval := w.options.MinInterval
+
+	for {
+		r, err := w.runs.ReadWithOptions(ctx, runID, RunReadOptions{Include: w.options.Include})
+		if err != nil {
+			return last, err
+		}
+
+		if last == nil || r.Status != last.Status {
+			var from RunStatus
+			if last != nil {
+				from = last.Status
+			}
+			last = r
+			interval = w.options.MinInterval
+
+			if err := fn(RunEvent{From: from, To: r.Status, Run: r}); err != nil {
+				return r, err
+			}
+		} else {
+			last = r
+			interval = interval * 3 / 2
+			if interval > w.options.MaxInterval {
+				interval = w.options.MaxInterval
+			}
+		}
+
+		if r.Status.IsFinal() {
+			return r, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return r, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// errStatusReached stops watching once a status waited for is reached.
+var errStatusReached = errors.New("status reached")
+
+// WaitForStatus waits until the run has one of the given statuses and
+// returns it. If the run reaches a final status that is not one of them,
+// the run is returned along with an error wrapping ErrRunFinished.
+func (w *RunWatcher) WaitForStatus(ctx context.Context, runID string, statuses ...RunStatus) (*Run, error) {
+	if len(statuses) == 0 {
+		return nil, errors.New("at least one status is required")
+	}
+
+	r, err := w.Watch(ctx, runID, func(e RunEvent) error {
+		for _, s := range statuses {
+			if e.To == s {
+				return errStatusReached
+			}
+		}
+		return nil
+	})
+	if err == errStatusReached {
+		return r, nil
+	}
+	if err != nil {
+		return r, err
+	}
+
+	names := make([]string, len(statuses))
+	for i, s := range statuses {
+		names[i] = string(s)
+	}
+
+	return r, fmt.Errorf("%w with status %s while waiting for %s", ErrRunFinished, r.Status, strings.Join(names, ", "))
+}
@@ -0,0 +1,117 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRunStatuses is a Runs service returning the given statuses, one per
+// read, repeating the last one.
+type testRunStatuses struct {
+	Runs
+	statuses []RunStatus
+	reads    int
+}
+
+func (s *testRunStatuses) ReadWithOptions(ctx context.Context, runID string, options RunReadOptions) (*Run, error) {
+	i := s.reads
+	if i >= len(s.statuses) {
+		i = len(s.statuses) - 1
+	}
+	s.reads++
+	return &Run{ID: runID, Status: s.statuses[i]}, nil
+}
+
+func testRunWatcher(statuses ...RunStatus) (*RunWatcher, *testRunStatuses) {
+	runs := &testRunStatuses{statuses: statuses}
+	return NewRunWatcher(runs, RunWatcherOptions{
+		MinInterval: time.Millisecond,
+		MaxInterval: 2 * time.Millisecond,
+	}), runs
+}
+
+func TestRunStatus(t *testing.T) {
+	assert.True(t, RunApplied.IsFinal())
+	assert.True(t, RunPlannedAndFinished.IsFinal())
+	assert.True(t, RunPolicySoftFailed.IsFinal())
+	assert.False(t, RunPlanned.IsFinal())
+	assert.False(t, RunPolicyOverride.IsFinal())
+	assert.True(t, RunPlanned.IsConfirmable())
+	assert.True(t, RunPolicyOverride.IsConfirmable())
+	assert.False(t, RunPolicySoftFailed.IsConfirmable())
+	assert.False(t, RunApplying.IsConfirmable())
+}
+
+func TestRunWatcher(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("watching status changes", func(t *testing.T) {
+		w, runs := testRunWatcher(RunPending, RunPending, RunPlanning, RunPlanning, RunPlanned, RunApplying, RunApplied)
+
+		var events []RunEvent
+		r, err := w.Watch(ctx, "run-123", func(e RunEvent) error {
+			events = append(events, e)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, RunApplied, r.Status)
+		assert.Equal(t, 7, runs.reads)
+
+		var transitions [][2]RunStatus
+		for _, e := range events {
+			transitions = append(transitions, [2]RunStatus{e.From, e.To})
+		}
+		assert.Equal(t, [][2]RunStatus{
+			{"", RunPending},
+			{RunPending, RunPlanning},
+			{RunPlanning, RunPlanned},
+			{RunPlanned, RunApplying},
+			{RunApplying, RunApplied},
+		}, transitions)
+	})
+
+	t.Run("stopping early", func(t *testing.T) {
+		w, _ := testRunWatcher(RunPending, RunPlanning, RunPlanned)
+		stop := errors.New("stop")
+
+		r, err := w.Watch(ctx, "run-123", func(e RunEvent) error {
+			if e.To == RunPlanning {
+				return stop
+			}
+			return nil
+		})
+		assert.Equal(t, stop, err)
+		assert.Equal(t, RunPlanning, r.Status)
+	})
+
+	t.Run("waiting for a status", func(t *testing.T) {
+		w, _ := testRunWatcher(RunPending, RunPlanning, RunCostEstimated, RunPolicyChecked)
+
+		r, err := w.WaitForStatus(ctx, "run-123", RunPlanned, RunPolicyChecked)
+		require.NoError(t, err)
+		assert.Equal(t, RunPolicyChecked, r.Status)
+	})
+
+	t.Run("waiting for a status the run does not reach", func(t *testing.T) {
+		w, _ := testRunWatcher(RunPending, RunPlanning, RunErrored)
+
+		r, err := w.WaitForStatus(ctx, "run-123", RunPlanned)
+		assert.True(t, errors.Is(err, ErrRunFinished))
+		assert.EqualError(t, err, "run finished with status errored while waiting for planned")
+		assert.Equal(t, RunErrored, r.Status)
+	})
+
+	t.Run("with a canceled context", func(t *testing.T) {
+		w, _ := testRunWatcher(RunPending)
+		ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+
+		_, err := w.WaitForStatus(ctx, "run-123", RunApplied)
+		assert.Equal(t, context.DeadlineExceeded, err)
+	})
+}
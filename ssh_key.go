@@ -61,7 +61,7 @@ func (s *sshKeys) List(ctx context.Context, organization string, options SSHKeyL
 	}
 
 	u := fmt.Sprintf("organizations/%s/ssh-keys", url.QueryEscape(organization))
-	req, err := s.client.newRequest("GET", u, &options)
+	req, err := s.client.newRequest("SSHKeys.List", "GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -87,14 +87,12 @@ type SSHKeyCreateOptions struct {
 	Value *string `jsonapi:"attr,value"`
 }
 
-func (o SSHKeyCreateOptions) valid() error {
-	if !validString(o.Name) {
-		return errors.New("name is required")
-	}
-	if !validString(o.Value) {
-		return errors.New("value is required")
-	}
-	return nil
+// Validate checks that the name and value are set.
+func (o SSHKeyCreateOptions) Validate() error {
+	var v validator
+	v.check(validString(o.Name), "name", "name is required")
+	v.check(validString(o.Value), "value", "value is required")
+	return v.err()
 }
 
 // Create an SSH key and associate it with an organization.
@@ -103,7 +101,7 @@ func (s *sshKeys) Create(ctx context.Context, organization string, options SSHKe
 		return nil, errors.New("invalid value for organization")
 	}
 
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -111,7 +109,7 @@ func (s *sshKeys) Create(ctx context.Context, organization string, options SSHKe
 	options.ID = ""
 
 	u := fmt.Sprintf("organizations/%s/ssh-keys", url.QueryEscape(organization))
-	req, err := s.client.newRequest("POST", u, &options)
+	req, err := s.client.newRequest("SSHKeys.Create", "POST", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -132,7 +130,7 @@ func (s *sshKeys) Read(ctx context.Context, sshKeyID string) (*SSHKey, error) {
 	}
 
 	u := fmt.Sprintf("ssh-keys/%s", url.QueryEscape(sshKeyID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("SSHKeys.Read", "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -158,17 +156,29 @@ type SSHKeyUpdateOptions struct {
 	Value *string `jsonapi:"attr,value,omitempty"`
 }
 
+// Validate checks that the name and value are not empty when set.
+func (o SSHKeyUpdateOptions) Validate() error {
+	var v validator
+	v.check(o.Name == nil || *o.Name != "", "name", "invalid value for name")
+	v.check(o.Value == nil || *o.Value != "", "value", "invalid value for value")
+	return v.err()
+}
+
 // Update an SSH key by its ID.
 func (s *sshKeys) Update(ctx context.Context, sshKeyID string, options SSHKeyUpdateOptions) (*SSHKey, error) {
 	if !validStringID(&sshKeyID) {
 		return nil, errors.New("invalid value for SSH key ID")
 	}
 
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Make sure we don't send a user provided ID.
 	options.ID = ""
 
 	u := fmt.Sprintf("ssh-keys/%s", url.QueryEscape(sshKeyID))
-	req, err := s.client.newRequest("PATCH", u, &options)
+	req, err := s.client.newRequest("SSHKeys.Update", "PATCH", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -189,7 +199,7 @@ func (s *sshKeys) Delete(ctx context.Context, sshKeyID string) error {
 	}
 
 	u := fmt.Sprintf("ssh-keys/%s", url.QueryEscape(sshKeyID))
-	req, err := s.client.newRequest("DELETE", u, nil)
+	req, err := s.client.newRequest("SSHKeys.Delete", "DELETE", u, nil)
 	if err != nil {
 		return err
 	}
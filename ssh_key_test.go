@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -125,7 +126,7 @@ func TestSSHKeysRead(t *testing.T) {
 	t.Run("when the SSH key does not exist", func(t *testing.T) {
 		k, err := client.SSHKeys.Read(ctx, "nonexisting")
 		assert.Nil(t, k)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("without a valid SSH key ID", func(t *testing.T) {
@@ -204,12 +205,12 @@ func TestSSHKeysDelete(t *testing.T) {
 
 		// Try loading the SSH key - it should fail.
 		_, err = client.SSHKeys.Read(ctx, kTest.ID)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("when the SSH key does not exist", func(t *testing.T) {
 		err := client.SSHKeys.Delete(ctx, kTest.ID)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("when the SSH key ID is invalid", func(t *testing.T) {
@@ -27,9 +27,16 @@ type StateVersions interface {
 	// Read a state version by its ID.
 	Read(ctx context.Context, svID string) (*StateVersion, error)
 
+	// ReadWithOptions reads a state version by its ID with the given options.
+	ReadWithOptions(ctx context.Context, svID string, options StateVersionReadOptions) (*StateVersion, error)
+
 	// Current reads the latest available state from the given workspace.
 	Current(ctx context.Context, workspaceID string) (*StateVersion, error)
 
+	// CurrentWithOptions reads the latest available state from the given
+	// workspace with the given options.
+	CurrentWithOptions(ctx context.Context, workspaceID string, options StateVersionReadOptions) (*StateVersion, error)
+
 	// Download retrieves the actual stored state of a state version
 	Download(ctx context.Context, url string) ([]byte, error)
 }
@@ -39,6 +46,16 @@ type stateVersions struct {
 	client *Client
 }
 
+// StateVersionIncludeOpt represents a related resource that can be included
+// when reading or listing state versions.
+type StateVersionIncludeOpt string
+
+// List all available state version includes.
+const (
+	StateVersionIncludeRun                     StateVersionIncludeOpt = "run"
+	StateVersionIncludeRunConfigurationVersion StateVersionIncludeOpt = "run.configuration_version"
+)
+
 // StateVersionList represents a list of state versions.
 type StateVersionList struct {
 	*Pagination
@@ -63,16 +80,19 @@ type StateVersionListOptions struct {
 	ListOptions
 	Organization *string `url:"filter[organization][name]"`
 	Workspace    *string `url:"filter[workspace][name]"`
+
+	// The attributes to return per resource type.
+	Fields Fields `url:"fields,omitempty"`
+
+	// The related resources to include in the response.
+	Include []StateVersionIncludeOpt `url:"include,omitempty,comma"`
 }
 
 func (o StateVersionListOptions) valid() error {
-	if !validString(o.Organization) {
-		return errors.New("organization is required")
-	}
-	if !validString(o.Workspace) {
-		return errors.New("workspace is required")
-	}
-	return nil
+	var v validator
+	v.check(validString(o.Organization), "filter[organization][name]", "organization is required")
+	v.check(validString(o.Workspace), "filter[workspace][name]", "workspace is required")
+	return v.err()
 }
 
 // List all the state versions for a given workspace.
@@ -81,7 +101,7 @@ func (s *stateVersions) List(ctx context.Context, options StateVersionListOption
 		return nil, err
 	}
 
-	req, err := s.client.newRequest("GET", "state-versions", &options)
+	req, err := s.client.newRequest("StateVersions.List", "GET", "state-versions", &options)
 	if err != nil {
 		return nil, err
 	}
@@ -120,17 +140,13 @@ type StateVersionCreateOptions struct {
 	Run *Run `jsonapi:"relation,run,omitempty"`
 }
 
-func (o StateVersionCreateOptions) valid() error {
-	if !validString(o.MD5) {
-		return errors.New("MD5 is required")
-	}
-	if o.Serial == nil {
-		return errors.New("serial is required")
-	}
-	if !validString(o.State) {
-		return errors.New("state is required")
-	}
-	return nil
+// Validate checks that the MD5, serial and state are set.
+func (o StateVersionCreateOptions) Validate() error {
+	var v validator
+	v.check(validString(o.MD5), "md5", "MD5 is required")
+	v.check(o.Serial != nil, "serial", "serial is required")
+	v.check(validString(o.State), "state", "state is required")
+	return v.err()
 }
 
 // Create a new state version for the given workspace.
@@ -138,7 +154,7 @@ func (s *stateVersions) Create(ctx context.Context, workspaceID string, options
 	if !validStringID(&workspaceID) {
 		return nil, errors.New("invalid value for workspace ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -146,7 +162,7 @@ func (s *stateVersions) Create(ctx context.Context, workspaceID string, options
 	options.ID = ""
 
 	u := fmt.Sprintf("workspaces/%s/state-versions", url.QueryEscape(workspaceID))
-	req, err := s.client.newRequest("POST", u, &options)
+	req, err := s.client.newRequest("StateVersions.Create", "POST", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -162,12 +178,29 @@ func (s *stateVersions) Create(ctx context.Context, workspaceID string, options
 
 // Read a state version by its ID.
 func (s *stateVersions) Read(ctx context.Context, svID string) (*StateVersion, error) {
+	return s.readWithOptions(ctx, "StateVersions.Read", svID, StateVersionReadOptions{})
+}
+
+// StateVersionReadOptions represents the options for reading a state version.
+type StateVersionReadOptions struct {
+	// The related resources to include in the response.
+	Include []StateVersionIncludeOpt `url:"include,omitempty,comma"`
+}
+
+// ReadWithOptions reads a state version by its ID with the given options.
+func (s *stateVersions) ReadWithOptions(ctx context.Context, svID string, options StateVersionReadOptions) (*StateVersion, error) {
+	return s.readWithOptions(ctx, "StateVersions.ReadWithOptions", svID, options)
+}
+
+// readWithOptions implements ReadWithOptions, naming the request after the
+// given operation.
+func (s *stateVersions) readWithOptions(ctx context.Context, operation, svID string, options StateVersionReadOptions) (*StateVersion, error) {
 	if !validStringID(&svID) {
 		return nil, errors.New("invalid value for state version ID")
 	}
 
 	u := fmt.Sprintf("state-versions/%s", url.QueryEscape(svID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest(operation, "GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -183,12 +216,24 @@ func (s *stateVersions) Read(ctx context.Context, svID string) (*StateVersion, e
 
 // Current reads the latest available state from the given workspace.
 func (s *stateVersions) Current(ctx context.Context, workspaceID string) (*StateVersion, error) {
+	return s.currentWithOptions(ctx, "StateVersions.Current", workspaceID, StateVersionReadOptions{})
+}
+
+// CurrentWithOptions reads the latest available state from the given
+// workspace with the given options.
+func (s *stateVersions) CurrentWithOptions(ctx context.Context, workspaceID string, options StateVersionReadOptions) (*StateVersion, error) {
+	return s.currentWithOptions(ctx, "StateVersions.CurrentWithOptions", workspaceID, options)
+}
+
+// currentWithOptions implements CurrentWithOptions, naming the request after
+// the given operation.
+func (s *stateVersions) currentWithOptions(ctx context.Context, operation, workspaceID string, options StateVersionReadOptions) (*StateVersion, error) {
 	if !validStringID(&workspaceID) {
 		return nil, errors.New("invalid value for workspace ID")
 	}
 
 	u := fmt.Sprintf("workspaces/%s/current-state-version", url.QueryEscape(workspaceID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest(operation, "GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -204,7 +249,7 @@ func (s *stateVersions) Current(ctx context.Context, workspaceID string) (*State
 
 // Download retrieves the actual stored state of a state version
 func (s *stateVersions) Download(ctx context.Context, url string) ([]byte, error) {
-	req, err := s.client.newRequest("GET", url, nil)
+	req, err := s.client.newRequest("StateVersions.Download", "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"crypto/md5"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -269,7 +272,7 @@ func TestStateVersionsRead(t *testing.T) {
 	t.Run("when the state version does not exist", func(t *testing.T) {
 		sv, err := client.StateVersions.Read(ctx, "nonexisting")
 		assert.Nil(t, sv)
-		assert.Equal(t, ErrResourceNotFound, err)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("with invalid state version id", func(t *testing.T) {
@@ -279,6 +282,55 @@ func TestStateVersionsRead(t *testing.T) {
 	})
 }
 
+func TestStateVersionsReadWithOptions(t *testing.T) {
+	ctx := context.Background()
+
+	var query url.Values
+	client, done := testMiddlewareClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.Write([]byte(`{
+			"data": {
+				"id": "sv-123",
+				"type": "state-versions",
+				"attributes": {"serial": 3},
+				"relationships": {
+					"run": {"data": {"id": "run-123", "type": "runs"}}
+				}
+			},
+			"included": [
+				{"id": "run-123", "type": "runs", "attributes": {"status": "applied"}}
+			]
+		}`))
+	})
+	defer done()
+
+	t.Run("when reading by ID", func(t *testing.T) {
+		sv, err := client.StateVersions.ReadWithOptions(ctx, "sv-123", StateVersionReadOptions{
+			Include: []StateVersionIncludeOpt{StateVersionIncludeRun},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "run", query.Get("include"))
+		require.NotNil(t, sv.Run)
+		assert.Equal(t, RunApplied, sv.Run.Status)
+	})
+
+	t.Run("when reading the current state version", func(t *testing.T) {
+		sv, err := client.StateVersions.CurrentWithOptions(ctx, "ws-123", StateVersionReadOptions{
+			Include: []StateVersionIncludeOpt{StateVersionIncludeRun, StateVersionIncludeRunConfigurationVersion},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "run,run.configuration_version", query.Get("include"))
+		assert.Equal(t, int64(3), sv.Serial)
+	})
+
+	t.Run("with invalid state version ID", func(t *testing.T) {
+		sv, err := client.StateVersions.ReadWithOptions(ctx, badIdentifier, StateVersionReadOptions{})
+		assert.Nil(t, sv)
+		assert.EqualError(t, err, "invalid value for state version ID")
+	})
+}
+
 func TestStateVersionsCurrent(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -307,7 +359,7 @@ func TestStateVersionsCurrent(t *testing.T) {
 	t.Run("when a state version does not exist", func(t *testing.T) {
 		sv, err := client.StateVersions.Current(ctx, wTest2.ID)
 		assert.Nil(t, sv)
-		assert.Equal(t, ErrResourceNotFound, err)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("with invalid workspace id", func(t *testing.T) {
@@ -345,6 +397,6 @@ func TestStateVersionsDownload(t *testing.T) {
 	t.Run("with an invalid url", func(t *testing.T) {
 		state, err := client.StateVersions.Download(ctx, badIdentifier)
 		assert.Nil(t, state)
-		assert.Equal(t, ErrResourceNotFound, err)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 }
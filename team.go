@@ -83,7 +83,7 @@ func (s *teams) List(ctx context.Context, organization string, options TeamListO
 	}
 
 	u := fmt.Sprintf("organizations/%s/teams", url.QueryEscape(organization))
-	req, err := s.client.newRequest("GET", u, &options)
+	req, err := s.client.newRequest("Teams.List", "GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -119,11 +119,11 @@ type OrganizationAccessOptions struct {
 	ManageVCSSettings *bool `json:"manage-vcs-settings,omitempty"`
 }
 
-func (o TeamCreateOptions) valid() error {
-	if !validString(o.Name) {
-		return errors.New("name is required")
-	}
-	return nil
+// Validate checks that the name is set.
+func (o TeamCreateOptions) Validate() error {
+	var v validator
+	v.check(validString(o.Name), "name", "name is required")
+	return v.err()
 }
 
 // Create a new team with the given options.
@@ -131,7 +131,7 @@ func (s *teams) Create(ctx context.Context, organization string, options TeamCre
 	if !validStringID(&organization) {
 		return nil, errors.New("invalid value for organization")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -139,7 +139,7 @@ func (s *teams) Create(ctx context.Context, organization string, options TeamCre
 	options.ID = ""
 
 	u := fmt.Sprintf("organizations/%s/teams", url.QueryEscape(organization))
-	req, err := s.client.newRequest("POST", u, &options)
+	req, err := s.client.newRequest("Teams.Create", "POST", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -160,7 +160,7 @@ func (s *teams) Read(ctx context.Context, teamID string) (*Team, error) {
 	}
 
 	u := fmt.Sprintf("teams/%s", url.QueryEscape(teamID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("Teams.Read", "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -189,17 +189,28 @@ type TeamUpdateOptions struct {
 	Visibility *string `jsonapi:"attr,visibility,omitempty"`
 }
 
+// Validate checks that the name is not empty when set.
+func (o TeamUpdateOptions) Validate() error {
+	var v validator
+	v.check(o.Name == nil || *o.Name != "", "name", "invalid value for name")
+	return v.err()
+}
+
 // Update a team by its ID.
 func (s *teams) Update(ctx context.Context, teamID string, options TeamUpdateOptions) (*Team, error) {
 	if !validStringID(&teamID) {
 		return nil, errors.New("invalid value for team ID")
 	}
 
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Make sure we don't send a user provided ID.
 	options.ID = ""
 
 	u := fmt.Sprintf("teams/%s", url.QueryEscape(teamID))
-	req, err := s.client.newRequest("PATCH", u, &options)
+	req, err := s.client.newRequest("Teams.Update", "PATCH", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -220,7 +231,7 @@ func (s *teams) Delete(ctx context.Context, teamID string) error {
 	}
 
 	u := fmt.Sprintf("teams/%s", url.QueryEscape(teamID))
-	req, err := s.client.newRequest("DELETE", u, nil)
+	req, err := s.client.newRequest("Teams.Delete", "DELETE", u, nil)
 	if err != nil {
 		return err
 	}
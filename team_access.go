@@ -108,13 +108,10 @@ type TeamAccessListOptions struct {
 }
 
 func (o TeamAccessListOptions) valid() error {
-	if !validString(o.WorkspaceID) {
-		return errors.New("workspace ID is required")
-	}
-	if !validStringID(o.WorkspaceID) {
-		return errors.New("invalid value for workspace ID")
-	}
-	return nil
+	var v validator
+	v.check(validString(o.WorkspaceID), "filter[workspace][id]", "workspace ID is required")
+	v.check(validStringID(o.WorkspaceID), "filter[workspace][id]", "invalid value for workspace ID")
+	return v.err()
 }
 
 // List all the team accesses for a given workspace.
@@ -123,7 +120,7 @@ func (s *teamAccesses) List(ctx context.Context, options TeamAccessListOptions)
 		return nil, err
 	}
 
-	req, err := s.client.newRequest("GET", "team-workspaces", &options)
+	req, err := s.client.newRequest("TeamAccesses.List", "GET", "team-workspaces", &options)
 	if err != nil {
 		return nil, err
 	}
@@ -160,29 +157,25 @@ type TeamAccessAddOptions struct {
 	Workspace *Workspace `jsonapi:"relation,workspace"`
 }
 
-func (o TeamAccessAddOptions) valid() error {
-	if o.Access == nil {
-		return errors.New("access is required")
-	}
-	if o.Team == nil {
-		return errors.New("team is required")
-	}
-	if o.Workspace == nil {
-		return errors.New("workspace is required")
-	}
-	return nil
+// Validate checks that the access, team and workspace are set.
+func (o TeamAccessAddOptions) Validate() error {
+	var v validator
+	v.check(o.Access != nil, "access", "access is required")
+	v.check(o.Team != nil, "team", "team is required")
+	v.check(o.Workspace != nil, "workspace", "workspace is required")
+	return v.err()
 }
 
 // Add team access for a workspace.
 func (s *teamAccesses) Add(ctx context.Context, options TeamAccessAddOptions) (*TeamAccess, error) {
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
 	// Make sure we don't send a user provided ID.
 	options.ID = ""
 
-	req, err := s.client.newRequest("POST", "team-workspaces", &options)
+	req, err := s.client.newRequest("TeamAccesses.Add", "POST", "team-workspaces", &options)
 	if err != nil {
 		return nil, err
 	}
@@ -203,7 +196,7 @@ func (s *teamAccesses) Read(ctx context.Context, teamAccessID string) (*TeamAcce
 	}
 
 	u := fmt.Sprintf("team-workspaces/%s", url.QueryEscape(teamAccessID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("TeamAccesses.Read", "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -234,17 +227,28 @@ type TeamAccessUpdateOptions struct {
 	WorkspaceLocking *bool                        `jsonapi:"attr,workspace-locking,omitempty"`
 }
 
+// Validate checks that the access is not empty when set.
+func (o TeamAccessUpdateOptions) Validate() error {
+	var v validator
+	v.check(o.Access == nil || *o.Access != "", "access", "invalid value for access")
+	return v.err()
+}
+
 // Update team access for a workspace
 func (s *teamAccesses) Update(ctx context.Context, teamAccessID string, options TeamAccessUpdateOptions) (*TeamAccess, error) {
 	if !validStringID(&teamAccessID) {
 		return nil, errors.New("invalid value for team access ID")
 	}
 
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Make sure we don't send a user provided ID.
 	options.ID = ""
 
 	u := fmt.Sprintf("team-workspaces/%s", url.QueryEscape(teamAccessID))
-	req, err := s.client.newRequest("PATCH", u, &options)
+	req, err := s.client.newRequest("TeamAccesses.Update", "PATCH", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -265,7 +269,7 @@ func (s *teamAccesses) Remove(ctx context.Context, teamAccessID string) error {
 	}
 
 	u := fmt.Sprintf("team-workspaces/%s", url.QueryEscape(teamAccessID))
-	req, err := s.client.newRequest("DELETE", u, nil)
+	req, err := s.client.newRequest("TeamAccesses.Remove", "DELETE", u, nil)
 	if err != nil {
 		return err
 	}
@@ -51,11 +51,17 @@ type teamMemberOrgMembership struct {
 // List returns all Users of a team calling ListUsers
 // See ListOrganizationMemberships for fetching memberships
 func (s *teamMembers) List(ctx context.Context, teamID string) ([]*User, error) {
-	return s.ListUsers(ctx, teamID)
+	return s.listUsers(ctx, "TeamMembers.List", teamID)
 }
 
 // ListUsers returns the Users of this team.
 func (s *teamMembers) ListUsers(ctx context.Context, teamID string) ([]*User, error) {
+	return s.listUsers(ctx, "TeamMembers.ListUsers", teamID)
+}
+
+// listUsers implements ListUsers, naming the request after the given
+// operation.
+func (s *teamMembers) listUsers(ctx context.Context, operation, teamID string) ([]*User, error) {
 	if !validStringID(&teamID) {
 		return nil, errors.New("invalid value for team ID")
 	}
@@ -67,7 +73,7 @@ func (s *teamMembers) ListUsers(ctx context.Context, teamID string) ([]*User, er
 	}
 
 	u := fmt.Sprintf("teams/%s", url.QueryEscape(teamID))
-	req, err := s.client.newRequest("GET", u, options)
+	req, err := s.client.newRequest(operation, "GET", u, options)
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +100,7 @@ func (s *teamMembers) ListOrganizationMemberships(ctx context.Context, teamID st
 	}
 
 	u := fmt.Sprintf("teams/%s", url.QueryEscape(teamID))
-	req, err := s.client.newRequest("GET", u, options)
+	req, err := s.client.newRequest("TeamMembers.ListOrganizationMemberships", "GET", u, options)
 	if err != nil {
 		return nil, err
 	}
@@ -115,20 +121,18 @@ type TeamMemberAddOptions struct {
 	OrganizationMembershipIDs []string
 }
 
-func (o *TeamMemberAddOptions) valid() error {
-	if o.Usernames == nil && o.OrganizationMembershipIDs == nil {
-		return errors.New("usernames or organization membership ids are required")
-	}
-	if o.Usernames != nil && o.OrganizationMembershipIDs != nil {
-		return errors.New("only one of usernames or organization membership ids can be provided")
-	}
-	if o.Usernames != nil && len(o.Usernames) == 0 {
-		return errors.New("invalid value for usernames")
-	}
-	if o.OrganizationMembershipIDs != nil && len(o.OrganizationMembershipIDs) == 0 {
-		return errors.New("invalid value for organization membership ids")
-	}
-	return nil
+// Validate checks that either usernames or organization membership IDs are
+// given, but not both.
+func (o *TeamMemberAddOptions) Validate() error {
+	var v validator
+	v.check(o.Usernames != nil || o.OrganizationMembershipIDs != nil,
+		"usernames", "usernames or organization membership ids are required")
+	v.check(o.Usernames == nil || o.OrganizationMembershipIDs == nil,
+		"usernames", "only one of usernames or organization membership ids can be provided")
+	v.check(o.Usernames == nil || len(o.Usernames) > 0, "usernames", "invalid value for usernames")
+	v.check(o.OrganizationMembershipIDs == nil || len(o.OrganizationMembershipIDs) > 0,
+		"organization-memberships", "invalid value for organization membership ids")
+	return v.err()
 }
 
 // kind returns "users" or "organization-memberships"
@@ -145,7 +149,7 @@ func (s *teamMembers) Add(ctx context.Context, teamID string, options TeamMember
 	if !validStringID(&teamID) {
 		return errors.New("invalid value for team ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return err
 	}
 
@@ -160,7 +164,7 @@ func (s *teamMembers) Add(ctx context.Context, teamID string, options TeamMember
 		for _, name := range options.Usernames {
 			members = append(members, &teamMemberUser{Username: name})
 		}
-		req, err = s.client.newRequest("POST", URL, members)
+		req, err = s.client.newRequest("TeamMembers.Add", "POST", URL, members)
 		if err != nil {
 			return err
 		}
@@ -170,7 +174,7 @@ func (s *teamMembers) Add(ctx context.Context, teamID string, options TeamMember
 		for _, ID := range options.OrganizationMembershipIDs {
 			members = append(members, &teamMemberOrgMembership{ID: ID})
 		}
-		req, err = s.client.newRequest("POST", URL, members)
+		req, err = s.client.newRequest("TeamMembers.Add", "POST", URL, members)
 		if err != nil {
 			return err
 		}
@@ -186,20 +190,18 @@ type TeamMemberRemoveOptions struct {
 	OrganizationMembershipIDs []string
 }
 
-func (o *TeamMemberRemoveOptions) valid() error {
-	if o.Usernames == nil && o.OrganizationMembershipIDs == nil {
-		return errors.New("usernames or organization membership ids are required")
-	}
-	if o.Usernames != nil && o.OrganizationMembershipIDs != nil {
-		return errors.New("only one of usernames or organization membership ids can be provided")
-	}
-	if o.Usernames != nil && len(o.Usernames) == 0 {
-		return errors.New("invalid value for usernames")
-	}
-	if o.OrganizationMembershipIDs != nil && len(o.OrganizationMembershipIDs) == 0 {
-		return errors.New("invalid value for organization membership ids")
-	}
-	return nil
+// Validate checks that either usernames or organization membership IDs are
+// given, but not both.
+func (o *TeamMemberRemoveOptions) Validate() error {
+	var v validator
+	v.check(o.Usernames != nil || o.OrganizationMembershipIDs != nil,
+		"usernames", "usernames or organization membership ids are required")
+	v.check(o.Usernames == nil || o.OrganizationMembershipIDs == nil,
+		"usernames", "only one of usernames or organization membership ids can be provided")
+	v.check(o.Usernames == nil || len(o.Usernames) > 0, "usernames", "invalid value for usernames")
+	v.check(o.OrganizationMembershipIDs == nil || len(o.OrganizationMembershipIDs) > 0,
+		"organization-memberships", "invalid value for organization membership ids")
+	return v.err()
 }
 
 // kind returns "users" or "organization-memberships"
@@ -216,7 +218,7 @@ func (s *teamMembers) Remove(ctx context.Context, teamID string, options TeamMem
 	if !validStringID(&teamID) {
 		return errors.New("invalid value for team ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return err
 	}
 
@@ -231,7 +233,7 @@ func (s *teamMembers) Remove(ctx context.Context, teamID string, options TeamMem
 		for _, name := range options.Usernames {
 			members = append(members, &teamMemberUser{Username: name})
 		}
-		req, err = s.client.newRequest("DELETE", URL, members)
+		req, err = s.client.newRequest("TeamMembers.Remove", "DELETE", URL, members)
 		if err != nil {
 			return err
 		}
@@ -241,7 +243,7 @@ func (s *teamMembers) Remove(ctx context.Context, teamID string, options TeamMem
 		for _, ID := range options.OrganizationMembershipIDs {
 			members = append(members, &teamMemberOrgMembership{ID: ID})
 		}
-		req, err = s.client.newRequest("DELETE", URL, members)
+		req, err = s.client.newRequest("TeamMembers.Remove", "DELETE", URL, members)
 		if err != nil {
 			return err
 		}
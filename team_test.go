@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -130,7 +131,7 @@ func TestTeamsRead(t *testing.T) {
 	t.Run("when the team does not exist", func(t *testing.T) {
 		tm, err := client.Teams.Read(ctx, "nonexisting")
 		assert.Nil(t, tm)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("without a valid team ID", func(t *testing.T) {
@@ -190,7 +191,7 @@ func TestTeamsUpdate(t *testing.T) {
 			Name: String("foo bar"),
 		})
 		assert.Nil(t, tm)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("without a valid team ID", func(t *testing.T) {
@@ -215,7 +216,7 @@ func TestTeamsDelete(t *testing.T) {
 
 		// Try loading the workspace - it should fail.
 		_, err = client.Teams.Read(ctx, tmTest.ID)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("without valid team ID", func(t *testing.T) {
@@ -48,7 +48,7 @@ func (s *teamTokens) Generate(ctx context.Context, teamID string) (*TeamToken, e
 	}
 
 	u := fmt.Sprintf("teams/%s/authentication-token", url.QueryEscape(teamID))
-	req, err := s.client.newRequest("POST", u, nil)
+	req, err := s.client.newRequest("TeamTokens.Generate", "POST", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +69,7 @@ func (s *teamTokens) Read(ctx context.Context, teamID string) (*TeamToken, error
 	}
 
 	u := fmt.Sprintf("teams/%s/authentication-token", url.QueryEscape(teamID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("TeamTokens.Read", "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +90,7 @@ func (s *teamTokens) Delete(ctx context.Context, teamID string) error {
 	}
 
 	u := fmt.Sprintf("teams/%s/authentication-token", url.QueryEscape(teamID))
-	req, err := s.client.newRequest("DELETE", u, nil)
+	req, err := s.client.newRequest("TeamTokens.Delete", "DELETE", u, nil)
 	if err != nil {
 		return err
 	}
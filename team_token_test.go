@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -55,7 +56,7 @@ func TestTeamTokensRead(t *testing.T) {
 
 	t.Run("when a token doesn't exists", func(t *testing.T) {
 		tt, err := client.TeamTokens.Read(ctx, tmTest.ID)
-		assert.Equal(t, ErrResourceNotFound, err)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 		assert.Nil(t, tt)
 	})
 
@@ -82,7 +83,7 @@ func TestTeamTokensDelete(t *testing.T) {
 
 	t.Run("when a token does not exist", func(t *testing.T) {
 		err := client.TeamTokens.Delete(ctx, tmTest.ID)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("without valid team ID", func(t *testing.T) {
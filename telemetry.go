@@ -0,0 +1,85 @@
+package tfe
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// Telemetry receives information about every API call made by the client,
+// which can be used to produce traces and metrics. The tfeotel module
+// provides an OpenTelemetry implementation.
+type Telemetry interface {
+	// StartCall is called before the API call for op is made. The returned
+	// context is used for the call, and the returned function is called with
+	// the outcome of the call once it finished. Headers added to req, for
+	// example to propagate a trace, are sent with every attempt.
+	StartCall(ctx context.Context, op Operation, req *http.Request) (context.Context, func(CallInfo))
+}
+
+// CallInfo describes the outcome of a single API call.
+type CallInfo struct {
+	// The status code of the last response, or 0 if none was received.
+	StatusCode int
+
+	// The number of times the request was retried.
+	Retries int
+
+	// The number of 429 responses received, including those that were
+	// retried successfully.
+	RateLimited int
+
+	// The time spent waiting for the client-side rate limiter.
+	RateLimitWait time.Duration
+
+	// Cached is true when the response was served from the response cache,
+	// including cached responses that were revalidated with the server.
+	Cached bool
+
+	// The total duration of the call, including waits and retries.
+	Duration time.Duration
+
+	// The error the call failed with, if any.
+	Err error
+}
+
+// callInfoContextKey is the context key used to collect the call info while
+// the call is being made.
+type callInfoContextKey struct{}
+
+// startCall starts collecting telemetry for an API call. The returned
+// function must be called with the result of the call.
+func (c *Client) startCall(ctx context.Context, op Operation, req *http.Request) (context.Context, func(error)) {
+	if c.telemetry == nil {
+		return ctx, func(error) {}
+	}
+
+	ctx, end := c.telemetry.StartCall(ctx, op, req)
+
+	info := &CallInfo{}
+	ctx = context.WithValue(ctx, callInfoContextKey{}, info)
+	start := time.Now()
+
+	return ctx, func(err error) {
+		info.Duration = time.Since(start)
+		info.Err = err
+		end(*info)
+	}
+}
+
+// callInfo returns the call info collected for the call made with ctx, or nil
+// if telemetry is disabled.
+func callInfo(ctx context.Context) *CallInfo {
+	info, _ := ctx.Value(callInfoContextKey{}).(*CallInfo)
+	return info
+}
+
+// recordAttempt is used as the request log hook of the HTTP client to count
+// the number of retries.
+func recordAttempt(_ retryablehttp.Logger, req *http.Request, attemptNum int) {
+	if info := callInfo(req.Context()); info != nil {
+		info.Retries = attemptNum
+	}
+}
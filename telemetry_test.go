@@ -0,0 +1,128 @@
+package tfe
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCall struct {
+	op     Operation
+	header http.Header
+	info   CallInfo
+}
+
+type testTelemetry struct {
+	calls []*testCall
+}
+
+func (t *testTelemetry) StartCall(ctx context.Context, op Operation, req *http.Request) (context.Context, func(CallInfo)) {
+	req.Header.Set("Traceparent", "test-trace")
+
+	call := &testCall{op: op, header: req.Header}
+	t.calls = append(t.calls, call)
+
+	return ctx, func(info CallInfo) {
+		call.info = info
+	}
+}
+
+func TestTelemetry_do(t *testing.T) {
+	attempts := 0
+	var traceparent string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == DefaultBasePath+PingEndpoint {
+			w.WriteHeader(204)
+			return
+		}
+
+		traceparent = r.Header.Get("Traceparent")
+
+		attempts++
+		switch {
+		case r.URL.Path == DefaultBasePath+"workspaces/ws-404":
+			w.WriteHeader(404)
+		case attempts < 3:
+			w.Header().Set("X-RateLimit-Reset", "0.01")
+			w.WriteHeader(429)
+		default:
+			testWorkspaceHandler(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	telemetry := &testTelemetry{}
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Telemetry:  telemetry,
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = client.Workspaces.ReadByID(ctx, "ws-123")
+	require.NoError(t, err)
+
+	_, err = client.Workspaces.ReadByID(ctx, "ws-404")
+	require.Error(t, err)
+
+	require.Len(t, telemetry.calls, 2)
+	assert.Equal(t, "test-trace", traceparent)
+
+	call := telemetry.calls[0]
+	assert.Equal(t, "Workspaces.ReadByID", call.op.Name)
+	assert.Equal(t, 200, call.info.StatusCode)
+	assert.Equal(t, 2, call.info.Retries)
+	assert.Equal(t, 2, call.info.RateLimited)
+	assert.True(t, call.info.Duration > 0)
+	assert.NoError(t, call.info.Err)
+
+	call = telemetry.calls[1]
+	assert.Equal(t, 404, call.info.StatusCode)
+	assert.Equal(t, 0, call.info.Retries)
+	assert.Equal(t, 0, call.info.RateLimited)
+	assert.Equal(t, err, call.info.Err)
+}
+
+func TestTelemetry_logReader(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == DefaultBasePath+PingEndpoint {
+			w.WriteHeader(204)
+			return
+		}
+		w.Write([]byte("\x02Terraform run log\x03"))
+	}))
+	defer ts.Close()
+
+	telemetry := &testTelemetry{}
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Telemetry:  telemetry,
+	})
+	require.NoError(t, err)
+
+	logURL, err := url.Parse(ts.URL + "/logs")
+	require.NoError(t, err)
+
+	lr := &LogReader{
+		client: client,
+		ctx:    context.Background(),
+		logURL: logURL,
+	}
+
+	_, err = lr.Read(make([]byte, 100))
+	require.NoError(t, err)
+
+	require.Len(t, telemetry.calls, 1)
+	assert.Equal(t, "LogReader.Read", telemetry.calls[0].op.Name)
+	assert.Equal(t, 200, telemetry.calls[0].info.StatusCode)
+	assert.Equal(t, "test-trace", telemetry.calls[0].header.Get("Traceparent"))
+}
@@ -22,7 +22,7 @@ type TestAccountDetails struct {
 // address associated with the token used to run the tests.
 func FetchTestAccountDetails(t *testing.T, client *Client) *TestAccountDetails {
 	tad := &TestAccountDetails{}
-	req, err := client.newRequest("GET", "account/details", nil)
+	req, err := client.newRequest("", "GET", "account/details", nil)
 	if err != nil {
 		t.Fatalf("could not create account details request: %v", err)
 	}
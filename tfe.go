@@ -14,19 +14,21 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/go-querystring/query"
 	"github.com/hashicorp/go-cleanhttp"
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
 	"github.com/svanharmelen/jsonapi"
-	"golang.org/x/time/rate"
 )
 
 const (
 	userAgent        = "go-tfe"
 	headerRateLimit  = "X-RateLimit-Limit"
 	headerRateReset  = "X-RateLimit-Reset"
+	headerRetryAfter = "Retry-After"
 	headerAPIVersion = "TFP-API-Version"
 
 	// DefaultAddress of Terraform Enterprise.
@@ -37,6 +39,8 @@ const (
 	PingEndpoint = "ping"
 )
 
+// The sentinel errors are wrapped by the returned errors, like APIError, so
+// they must be matched using errors.Is instead of comparing them using ==.
 var (
 	// ErrWorkspaceLocked is returned when trying to lock a
 	// locked workspace.
@@ -49,6 +53,15 @@ var (
 	ErrUnauthorized = errors.New("unauthorized")
 	// ErrResourceNotFound is returned when a receiving a 404.
 	ErrResourceNotFound = errors.New("resource not found")
+	// ErrForbidden is returned when a receiving a 403.
+	ErrForbidden = errors.New("forbidden")
+	// ErrConflict is returned when a receiving a 409.
+	ErrConflict = errors.New("conflict")
+	// ErrUnprocessableEntity is returned when a receiving a 422, which
+	// usually means the request failed validation.
+	ErrUnprocessableEntity = errors.New("unprocessable entity")
+	// ErrRateLimited is returned when a receiving a 429.
+	ErrRateLimited = errors.New("rate limited")
 )
 
 // RetryLogHook allows a function to run before each retry.
@@ -65,6 +78,11 @@ type Config struct {
 	// API token used to access the Terraform Enterprise API.
 	Token string
 
+	// TokenSource provides the API token for every request, so tokens can
+	// be rotated without recreating the client. It takes precedence over
+	// Token when set.
+	TokenSource TokenSource
+
 	// Headers that will be added to every request.
 	Headers http.Header
 
@@ -73,6 +91,42 @@ type Config struct {
 
 	// RetryLogHook is invoked each time a request is retried.
 	RetryLogHook RetryLogHook
+
+	// RateLimiter limits the rate of API calls. A limiter can be shared by
+	// multiple clients. Each client uses its own AdaptiveLimiter when left
+	// empty.
+	RateLimiter RateLimiter
+
+	// RetryPolicy configures how failed requests are retried. When left
+	// empty, rate limited requests are retried up to 30 times and server
+	// errors are only retried after calling Client.RetryServerErrors.
+	RetryPolicy *RetryPolicy
+
+	// Middleware wraps every API call made by the services. The first
+	// middleware is the outermost one.
+	Middleware []Middleware
+
+	// Telemetry receives timing and outcome information about every API
+	// call. Telemetry is disabled when left empty.
+	Telemetry Telemetry
+
+	// DryRun records all requests that would modify resources instead of
+	// sending them. Requests are sent normally when left empty.
+	DryRun *DryRun
+
+	// Cache caches the responses of API reads. Responses are not cached
+	// when left empty.
+	Cache *ResponseCache
+
+	// LazyPing defers the request used to retrieve the API version and rate
+	// limit until the first API call, so the client can be constructed
+	// without network access.
+	LazyPing bool
+
+	// MinimumAPIVersions sets the minimum API versions of features, which
+	// are checked before calling their APIs and by Client.Supports. Features
+	// without a version, or with a zero version, are not checked.
+	MinimumAPIVersions map[Feature]APIVersion
 }
 
 // DefaultConfig returns a default config structure.
@@ -100,13 +154,24 @@ func DefaultConfig() *Config {
 // connectivity and configuration for accessing the TFE API.
 type Client struct {
 	baseURL           *url.URL
-	token             string
+	tokenSource       TokenSource
 	headers           http.Header
 	http              *retryablehttp.Client
-	limiter           *rate.Limiter
+	limiter           RateLimiter
 	retryLogHook      RetryLogHook
 	retryServerErrors bool
-	remoteAPIVersion  string
+	retryPolicy       *RetryPolicy
+	middleware        []Middleware
+	telemetry         Telemetry
+	cache             *ResponseCache
+	lazyPing          bool
+	featureVersions   map[Feature]APIVersion
+
+	// The API metadata is retrieved by loadMetadata and protected by metaMu.
+	metaMu           sync.Mutex
+	metaLoaded       uint32
+	remoteAPIVersion string
+	fakeAPIVersion   bool
 
 	Applies                    Applies
 	ConfigurationVersions      ConfigurationVersions
@@ -139,6 +204,13 @@ type Client struct {
 
 // NewClient creates a new Terraform Enterprise API client.
 func NewClient(cfg *Config) (*Client, error) {
+	return NewClientWithContext(context.Background(), cfg)
+}
+
+// NewClientWithContext creates a new Terraform Enterprise API client. The
+// provided ctx is used for the initial request to the API, unless the config
+// defers it by setting LazyPing.
+func NewClientWithContext(ctx context.Context, cfg *Config) (*Client, error) {
 	config := DefaultConfig()
 
 	// Layer in the provided config for any non-blank values.
@@ -152,6 +224,9 @@ func NewClient(cfg *Config) (*Client, error) {
 		if cfg.Token != "" {
 			config.Token = cfg.Token
 		}
+		if cfg.TokenSource != nil {
+			config.TokenSource = cfg.TokenSource
+		}
 		for k, v := range cfg.Headers {
 			config.Headers[k] = v
 		}
@@ -161,6 +236,28 @@ func NewClient(cfg *Config) (*Client, error) {
 		if cfg.RetryLogHook != nil {
 			config.RetryLogHook = cfg.RetryLogHook
 		}
+		if cfg.RateLimiter != nil {
+			config.RateLimiter = cfg.RateLimiter
+		}
+		if cfg.RetryPolicy != nil {
+			config.RetryPolicy = cfg.RetryPolicy
+		}
+		if cfg.Middleware != nil {
+			config.Middleware = cfg.Middleware
+		}
+		if cfg.Telemetry != nil {
+			config.Telemetry = cfg.Telemetry
+		}
+		if cfg.DryRun != nil {
+			config.DryRun = cfg.DryRun
+		}
+		if cfg.Cache != nil {
+			config.Cache = cfg.Cache
+		}
+		config.LazyPing = cfg.LazyPing
+		if cfg.MinimumAPIVersions != nil {
+			config.MinimumAPIVersions = cfg.MinimumAPIVersions
+		}
 	}
 
 	// Parse the address to make sure its a valid URL.
@@ -175,39 +272,67 @@ func NewClient(cfg *Config) (*Client, error) {
 	}
 
 	// This value must be provided by the user.
-	if config.Token == "" {
-		return nil, fmt.Errorf("missing API token")
+	if config.TokenSource == nil {
+		if config.Token == "" {
+			return nil, fmt.Errorf("missing API token")
+		}
+		config.TokenSource = StaticTokenSource(config.Token)
 	}
 
 	// Create the client.
 	client := &Client{
 		baseURL:      baseURL,
-		token:        config.Token,
+		tokenSource:  config.TokenSource,
 		headers:      config.Headers,
+		lazyPing:     config.LazyPing,
+		limiter:      config.RateLimiter,
 		retryLogHook: config.RetryLogHook,
+		middleware:   config.Middleware,
+		telemetry:    config.Telemetry,
+		cache:        config.Cache,
+	}
+
+	// Copy the minimum API versions, so the config can be reused.
+	if len(config.MinimumAPIVersions) > 0 {
+		client.featureVersions = make(map[Feature]APIVersion, len(config.MinimumAPIVersions))
+		for feature, v := range config.MinimumAPIVersions {
+			client.featureVersions[feature] = v
+		}
 	}
 
 	client.http = &retryablehttp.Client{
-		Backoff:      client.retryHTTPBackoff,
-		CheckRetry:   client.retryHTTPCheck,
-		ErrorHandler: retryablehttp.PassthroughErrorHandler,
-		HTTPClient:   config.HTTPClient,
-		RetryWaitMin: 100 * time.Millisecond,
-		RetryWaitMax: 400 * time.Millisecond,
-		RetryMax:     30,
+		Backoff:        client.retryHTTPBackoff,
+		CheckRetry:     client.retryHTTPCheck,
+		ErrorHandler:   retryablehttp.PassthroughErrorHandler,
+		HTTPClient:     config.HTTPClient,
+		RequestLogHook: recordAttempt,
+		RetryWaitMin:   100 * time.Millisecond,
+		RetryWaitMax:   400 * time.Millisecond,
+		RetryMax:       30,
 	}
 
-	meta, err := client.getRawAPIMetadata()
-	if err != nil {
-		return nil, err
+	// Configure the retry policy.
+	if config.RetryPolicy != nil {
+		client.retryPolicy = config.RetryPolicy.withDefaults()
+		client.http.RetryMax = client.retryPolicy.MaxAttempts - 1
 	}
 
-	// Configure the rate limiter.
-	client.configureLimiter(meta.RateLimit)
+	// Record mutating requests after all other middleware has run.
+	if config.DryRun != nil {
+		client.middleware = append(client.middleware[:len(client.middleware):len(client.middleware)], config.DryRun.middleware)
+	}
 
-	// Save the API version so we can return it from the RemoteAPIVersion
-	// method later.
-	client.remoteAPIVersion = meta.APIVersion
+	// Use a limiter for this client only, if no shared one is configured.
+	if client.limiter == nil {
+		client.limiter = NewAdaptiveLimiter()
+	}
+
+	// Retrieve the API metadata now, unless it should be deferred.
+	if !client.lazyPing {
+		if err := client.loadMetadata(ctx); err != nil {
+			return nil, err
+		}
+	}
 
 	// Create the services.
 	client.Applies = &applies{client: client}
@@ -246,7 +371,9 @@ func NewClient(cfg *Config) (*Client, error) {
 // A Terraform Cloud or Enterprise API server returns its API version in an
 // HTTP header field in all responses. The NewClient function saves the
 // version number returned in its initial setup request and RemoteAPIVersion
-// returns that cached value.
+// returns that cached value. If the setup request was deferred using
+// LazyPing, an empty string is returned until it succeeded, which happens on
+// the first API call or by calling Ping explicitly.
 //
 // The API protocol calls for this string to be a dotted-decimal version number
 // like 2.3.0, where the first number indicates the API major version while the
@@ -258,30 +385,89 @@ func NewClient(cfg *Config) (*Client, error) {
 // information. In that case, this function returns an empty string as the
 // version.
 func (c *Client) RemoteAPIVersion() string {
+	c.metaMu.Lock()
+	defer c.metaMu.Unlock()
+
 	return c.remoteAPIVersion
 }
 
+// Ping retrieves the API version and rate limit from the API, if that was
+// deferred using LazyPing and has not succeeded yet. It can be used to verify
+// the connection before making any other API calls.
+func (c *Client) Ping(ctx context.Context) error {
+	return c.loadMetadata(ctx)
+}
+
+// loadMetadata retrieves the API metadata and configures the client with
+// it, unless that already succeeded. It is safe for concurrent use and
+// retries on the next call if the request failed.
+func (c *Client) loadMetadata(ctx context.Context) error {
+	if atomic.LoadUint32(&c.metaLoaded) == 1 {
+		return nil
+	}
+
+	c.metaMu.Lock()
+	defer c.metaMu.Unlock()
+
+	if atomic.LoadUint32(&c.metaLoaded) == 1 {
+		return nil
+	}
+
+	meta, err := c.getRawAPIMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Configure the rate limiter.
+	c.configureLimiter(meta.RateLimit)
+
+	// Save the API version so we can return it from the RemoteAPIVersion
+	// method later.
+	if !c.fakeAPIVersion {
+		c.remoteAPIVersion = meta.APIVersion
+	}
+
+	atomic.StoreUint32(&c.metaLoaded, 1)
+
+	return nil
+}
+
 // SetFakeRemoteAPIVersion allows setting a given string as the client's remoteAPIVersion,
 // overriding the value pulled from the API header during client initialization.
 //
 // This is intended for use in tests, when you may want to configure your TFE client to
 // return something different than the actual API version in order to test error handling.
 func (c *Client) SetFakeRemoteAPIVersion(fakeAPIVersion string) {
+	c.metaMu.Lock()
+	defer c.metaMu.Unlock()
+
+	c.fakeAPIVersion = true
 	c.remoteAPIVersion = fakeAPIVersion
 }
 
 // RetryServerErrors configures the retry HTTP check to also retry
-// unexpected errors or requests that failed with a server error.
+// unexpected errors or requests that failed with a server error. It has
+// no effect when a RetryPolicy is configured.
 func (c *Client) RetryServerErrors(retry bool) {
 	c.retryServerErrors = retry
 }
 
 // retryHTTPCheck provides a callback for Client.CheckRetry which
-// will retry both rate limit (429) and server (>= 500) errors.
+// will retry both rate limit (429) and server (>= 500) errors, or
+// follows the retry policy if one is configured.
 func (c *Client) retryHTTPCheck(ctx context.Context, resp *http.Response, err error) (bool, error) {
 	if ctx.Err() != nil {
 		return false, ctx.Err()
 	}
+	if resp != nil {
+		c.limiter.Update(resp.Header)
+	}
+	if info := callInfo(ctx); info != nil && resp != nil && resp.StatusCode == 429 {
+		info.RateLimited++
+	}
+	if c.retryPolicy != nil {
+		return c.retryPolicy.shouldRetry(ctx, resp, err), err
+	}
 	if err != nil {
 		return c.retryServerErrors, err
 	}
@@ -298,6 +484,11 @@ func (c *Client) retryHTTPBackoff(min, max time.Duration, attemptNum int, resp *
 		c.retryLogHook(attemptNum, resp)
 	}
 
+	// Use the configured retry policy, if any.
+	if c.retryPolicy != nil {
+		return c.retryPolicy.wait(attemptNum, resp)
+	}
+
 	// Use the rate limit backoff function when we are rate limited.
 	if resp != nil && resp.StatusCode == 429 {
 		return rateLimitBackoff(min, max, attemptNum, resp)
@@ -350,7 +541,7 @@ type rawAPIMetadata struct {
 	RateLimit string
 }
 
-func (c *Client) getRawAPIMetadata() (rawAPIMetadata, error) {
+func (c *Client) getRawAPIMetadata(ctx context.Context) (rawAPIMetadata, error) {
 	var meta rawAPIMetadata
 
 	// Create a new request.
@@ -362,13 +553,19 @@ func (c *Client) getRawAPIMetadata() (rawAPIMetadata, error) {
 	if err != nil {
 		return meta, err
 	}
+	req = req.WithContext(ctx)
 
 	// Attach the default headers.
 	for k, v := range c.headers {
 		req.Header[k] = v
 	}
 	req.Header.Set("Accept", "application/vnd.api+json")
-	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	token, err := c.tokenSource.Token(ctx)
+	if err != nil {
+		return meta, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
 
 	// Make a single request to retrieve the rate limit headers.
 	resp, err := c.http.HTTPClient.Do(req)
@@ -383,45 +580,31 @@ func (c *Client) getRawAPIMetadata() (rawAPIMetadata, error) {
 	return meta, nil
 }
 
-// configureLimiter configures the rate limiter.
+// configureLimiter configures the rate limiter using the rate limit
+// reported by the ping endpoint.
 func (c *Client) configureLimiter(rawLimit string) {
-
-	// Set default values for when rate limiting is disabled.
-	limit := rate.Inf
-	burst := 0
-
-	if v := rawLimit; v != "" {
-		if rateLimit, _ := strconv.ParseFloat(v, 64); rateLimit > 0 {
-			// Configure the limit and burst using a split of 2/3 for the limit and
-			// 1/3 for the burst. This enables clients to burst 1/3 of the allowed
-			// calls before the limiter kicks in. The remaining calls will then be
-			// spread out evenly using intervals of time.Second / limit which should
-			// prevent hitting the rate limit.
-			limit = rate.Limit(rateLimit * 0.66)
-			burst = int(rateLimit * 0.33)
-		}
-	}
-
-	// Create a new limiter using the calculated values.
-	c.limiter = rate.NewLimiter(limit, burst)
+	header := make(http.Header)
+	header.Set(headerRateLimit, rawLimit)
+	c.limiter.Update(header)
 }
 
-// newRequest creates an API request. A relative URL path can be provided in
-// path, in which case it is resolved relative to the apiVersionPath of the
+// newRequest creates an API request for the operation, which is the name of
+// the calling service method, like "Workspaces.Read". A relative URL path can
+// be provided in path, in which case it is resolved relative to the apiVersionPath of the
 // Client. Relative URL paths should always be specified without a preceding
 // slash.
 // If v is supplied, the value will be JSONAPI encoded and included as the
 // request body. If the method is GET, the value will be parsed and added as
 // query parameters.
-func (c *Client) newRequest(method, path string, v interface{}) (*retryablehttp.Request, error) {
+func (c *Client) newRequest(operation, method, path string, v interface{}) (*retryablehttp.Request, error) {
 	u, err := c.baseURL.Parse(path)
 	if err != nil {
 		return nil, err
 	}
 
-	// Create a request specific headers map.
+	// Create a request specific headers map. The Authorization header is
+	// set by do, as the token may change between requests.
 	reqHeaders := make(http.Header)
-	reqHeaders.Set("Authorization", "Bearer "+c.token)
 
 	var body interface{}
 	switch method {
@@ -467,6 +650,9 @@ func (c *Client) newRequest(method, path string, v interface{}) (*retryablehttp.
 		req.Header[k] = v
 	}
 
+	// Attach the operation so it can be passed to the middleware.
+	req = withOperation(req, operation, c.baseURL.Path)
+
 	return req, nil
 }
 
@@ -480,18 +666,67 @@ func (c *Client) newRequest(method, path string, v interface{}) (*retryablehttp.
 // The provided ctx must be non-nil. If it is canceled or times out, ctx.Err()
 // will be returned.
 func (c *Client) do(ctx context.Context, req *retryablehttp.Request, v interface{}) error {
+	op := requestOperation(req)
+
+	ctx, end := c.startCall(ctx, op, req.Request)
+	err := c.doRequest(ctx, op, req, v)
+	end(err)
+
+	return err
+}
+
+// doRequest waits for the rate limiter, sends the request and decodes the
+// response into v.
+func (c *Client) doRequest(ctx context.Context, op Operation, req *retryablehttp.Request, v interface{}) error {
+	// Make sure the rate limiter is configured before the first call.
+	if err := c.loadMetadata(ctx); err != nil {
+		return err
+	}
+
+	// Serve fresh cached responses without using up the rate limit.
+	var cacheKey string
+	var cached *CacheEntry
+	if c.cache != nil && req.Method == "GET" {
+		var fresh bool
+		var err error
+		if cacheKey, cached, fresh, err = c.cacheLookup(ctx, req); err != nil {
+			return err
+		}
+		if fresh {
+			if info := callInfo(ctx); info != nil {
+				info.StatusCode = http.StatusOK
+				info.Cached = true
+			}
+			return decodeResponse(cached.response(req.Request), v)
+		}
+	}
+
 	// Wait will block until the limiter can obtain a new token
 	// or returns an error if the given context is canceled.
+	start := time.Now()
 	if err := c.limiter.Wait(ctx); err != nil {
 		return err
 	}
+	if info := callInfo(ctx); info != nil {
+		info.RateLimitWait = time.Since(start)
+	}
+
+	// Track the attempts of this call if a retry policy is configured.
+	if c.retryPolicy != nil {
+		ctx = withRetryState(ctx, req.Method)
+	}
 
 	// Add the context to the request.
 	req = req.WithContext(ctx)
 
 	// Execute the request and check the response.
-	resp, err := c.http.Do(req)
+	resp, err := c.sendAuthorized(ctx, op, req)
 	if err != nil {
+		// The request may have modified resources even though it failed.
+		if c.cache != nil && req.Method != "GET" {
+			c.cache.invalidate(op, nil)
+		}
+
 		// If we got an error, and the context has been canceled,
 		// the context's error is probably more useful.
 		select {
@@ -501,21 +736,37 @@ func (c *Client) do(ctx context.Context, req *retryablehttp.Request, v interface
 			return err
 		}
 	}
+
+	// Update the response cache.
+	if c.cache != nil {
+		if resp, err = c.updateCache(ctx, op, req, cacheKey, cached, resp); err != nil {
+			return err
+		}
+	}
 	defer resp.Body.Close()
 
+	if info := callInfo(ctx); info != nil {
+		info.StatusCode = resp.StatusCode
+	}
+
 	// Basic response checking.
 	if err := checkResponseCode(resp); err != nil {
 		return err
 	}
 
+	return decodeResponse(resp, v)
+}
+
+// decodeResponse decodes the body of a successful response into v.
+func decodeResponse(resp *http.Response, v interface{}) error {
 	// Return here if decoding the response isn't needed.
-	if v == nil {
+	if v == nil || resp.StatusCode == http.StatusNoContent {
 		return nil
 	}
 
 	// If v implements io.Writer, write the raw response body.
 	if w, ok := v.(io.Writer); ok {
-		_, err = io.Copy(w, resp.Body)
+		_, err := io.Copy(w, resp.Body)
 		return err
 	}
 
@@ -612,43 +863,11 @@ func parsePagination(body io.Reader) (*Pagination, error) {
 }
 
 // checkResponseCode can be used to check the status code of an HTTP request.
+// It returns an *APIError for any non-2xx status code.
 func checkResponseCode(r *http.Response) error {
 	if r.StatusCode >= 200 && r.StatusCode <= 299 {
 		return nil
 	}
 
-	switch r.StatusCode {
-	case 401:
-		return ErrUnauthorized
-	case 404:
-		return ErrResourceNotFound
-	case 409:
-		switch {
-		case strings.HasSuffix(r.Request.URL.Path, "actions/lock"):
-			return ErrWorkspaceLocked
-		case strings.HasSuffix(r.Request.URL.Path, "actions/unlock"):
-			return ErrWorkspaceNotLocked
-		case strings.HasSuffix(r.Request.URL.Path, "actions/force-unlock"):
-			return ErrWorkspaceNotLocked
-		}
-	}
-
-	// Decode the error payload.
-	errPayload := &jsonapi.ErrorsPayload{}
-	err := json.NewDecoder(r.Body).Decode(errPayload)
-	if err != nil || len(errPayload.Errors) == 0 {
-		return fmt.Errorf(r.Status)
-	}
-
-	// Parse and format the errors.
-	var errs []string
-	for _, e := range errPayload.Errors {
-		if e.Detail == "" {
-			errs = append(errs, e.Title)
-		} else {
-			errs = append(errs, fmt.Sprintf("%s\n\n%s", e.Title, e.Detail))
-		}
-	}
-
-	return fmt.Errorf(strings.Join(errs, "\n"))
+	return newAPIError(r)
 }
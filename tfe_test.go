@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -32,8 +34,8 @@ func TestClient_newClient(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		if client.token != "abcd1234" {
-			t.Fatalf("unexpected token: %q", client.token)
+		if token, _ := client.tokenSource.Token(context.Background()); token != "abcd1234" {
+			t.Fatalf("unexpected token: %q", token)
 		}
 		if client.baseURL.String() != ts.URL+DefaultBasePath {
 			t.Fatalf("unexpected address: %q", client.baseURL.String())
@@ -64,8 +66,8 @@ func TestClient_newClient(t *testing.T) {
 		if config.Address+DefaultBasePath != client.baseURL.String() {
 			t.Fatalf("unexpected client address %q", client.baseURL.String())
 		}
-		if config.Token != client.token {
-			t.Fatalf("unexpected client token %q", client.token)
+		if token, _ := client.tokenSource.Token(context.Background()); token != config.Token {
+			t.Fatalf("unexpected client token %q", token)
 		}
 		if ts.Client() != client.http.HTTPClient {
 			t.Fatal("unexpected HTTP client value")
@@ -265,12 +267,17 @@ func TestClient_configureLimiter(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		if client.limiter.Limit() != tc.limit {
-			t.Fatalf("test %s expected limit %f, got: %f", name, tc.limit, client.limiter.Limit())
+		limiter, ok := client.limiter.(*AdaptiveLimiter)
+		if !ok {
+			t.Fatalf("test %s expected an adaptive limiter, got: %T", name, client.limiter)
 		}
 
-		if client.limiter.Burst() != tc.burst {
-			t.Fatalf("test %s expected burst %d, got: %d", name, tc.burst, client.limiter.Burst())
+		if limiter.Limit() != tc.limit {
+			t.Fatalf("test %s expected limit %f, got: %f", name, tc.limit, limiter.Limit())
+		}
+
+		if limiter.Burst() != tc.burst {
+			t.Fatalf("test %s expected burst %d, got: %d", name, tc.burst, limiter.Burst())
 		}
 	}
 }
@@ -394,6 +401,119 @@ func TestClient_retryHTTPBackoff(t *testing.T) {
 	}
 }
 
+func TestClient_lazyPing(t *testing.T) {
+	var pings int32
+	var failPing int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == DefaultBasePath+PingEndpoint {
+			atomic.AddInt32(&pings, 1)
+			if atomic.LoadInt32(&failPing) == 1 {
+				// Close the connection without a response.
+				conn, _, _ := w.(http.Hijacker).Hijack()
+				conn.Close()
+				return
+			}
+			w.Header().Set("X-RateLimit-Limit", "30")
+			w.Header().Set("TFP-API-Version", "34.21.9")
+			w.WriteHeader(204)
+			return
+		}
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.Write([]byte(`{"data":{"id":"ws-123","type":"workspaces"}}`))
+	}))
+	defer ts.Close()
+
+	cfg := &Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		LazyPing:   true,
+	}
+
+	t.Run("defers the ping until first use", func(t *testing.T) {
+		atomic.StoreInt32(&pings, 0)
+
+		client, err := NewClient(cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v := client.RemoteAPIVersion(); v != "" {
+			t.Fatalf("unexpected remote API version %q", v)
+		}
+		if n := atomic.LoadInt32(&pings); n != 0 {
+			t.Fatalf("expected no pings, got: %d", n)
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, err := client.Workspaces.ReadByID(context.Background(), "ws-123"); err != nil {
+					t.Error(err)
+				}
+				if v := client.RemoteAPIVersion(); v != "34.21.9" {
+					t.Errorf("unexpected remote API version %q", v)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if n := atomic.LoadInt32(&pings); n != 1 {
+			t.Fatalf("expected a single ping, got: %d", n)
+		}
+		if limit := client.limiter.(*AdaptiveLimiter).Limit(); limit != rate.Limit(19.8) {
+			t.Fatalf("expected limit 19.8, got: %f", limit)
+		}
+	})
+
+	t.Run("retries a failed ping", func(t *testing.T) {
+		client, err := NewClient(cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		atomic.StoreInt32(&failPing, 1)
+		if err := client.Ping(context.Background()); err == nil {
+			t.Fatal("expected an error")
+		}
+		if v := client.RemoteAPIVersion(); v != "" {
+			t.Fatalf("unexpected remote API version %q", v)
+		}
+
+		atomic.StoreInt32(&failPing, 0)
+		if err := client.Ping(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if v := client.RemoteAPIVersion(); v != "34.21.9" {
+			t.Fatalf("unexpected remote API version %q", v)
+		}
+
+		// Once succeeded, the ping is not repeated.
+		n := atomic.LoadInt32(&pings)
+		if err := client.Ping(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if m := atomic.LoadInt32(&pings); m != n {
+			t.Fatalf("expected %d pings, got: %d", n, m)
+		}
+	})
+
+	t.Run("uses the context of the constructor", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := NewClientWithContext(ctx, &Config{
+			Address:    ts.URL,
+			Token:      "dummy-token",
+			HTTPClient: ts.Client(),
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected a canceled context error, got: %v", err)
+		}
+	})
+}
+
 func setupEnvVars(token, address string) func() {
 	origToken := os.Getenv("TFE_TOKEN")
 	origAddress := os.Getenv("TFE_ADDRESS")
@@ -0,0 +1,195 @@
+// Package tfeotel provides OpenTelemetry instrumentation for the Terraform
+// Enterprise API client.
+//
+// It lives in its own module so the client itself does not depend on
+// OpenTelemetry. To enable it, set the Telemetry field of the client config:
+//
+//	telemetry, err := tfeotel.New()
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	config := &tfe.Config{
+//		Token:     token,
+//		Telemetry: telemetry,
+//	}
+//
+// Every API call produces a span and is recorded in the following metrics:
+//
+//	tfe.client.request.duration  histogram of the call duration in seconds
+//	tfe.client.request.retries   counter of retried requests
+//	tfe.client.request.throttled counter of 429 responses
+//	tfe.client.rate_limit.wait   histogram of the rate limiter wait in seconds
+package tfeotel
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName identifies this package as the source of the spans
+// and metrics.
+const instrumentationName = "github.com/hashicorp/go-tfe/tfeotel"
+
+// Option configures the instrumentation.
+type Option func(*config)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	propagator     propagation.TextMapPropagator
+}
+
+// WithTracerProvider sets the tracer provider used to create spans. The
+// global tracer provider is used by default.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tp
+	}
+}
+
+// WithMeterProvider sets the meter provider used to record metrics. The
+// global meter provider is used by default.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = mp
+	}
+}
+
+// WithPropagator sets the propagator used to inject the span context into
+// the request headers. The global propagator is used by default.
+func WithPropagator(p propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagator = p
+	}
+}
+
+// Telemetry implements tfe.Telemetry using OpenTelemetry.
+type Telemetry struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+
+	duration  metric.Float64Histogram
+	retries   metric.Int64Counter
+	throttled metric.Int64Counter
+	wait      metric.Float64Histogram
+}
+
+var _ tfe.Telemetry = (*Telemetry)(nil)
+
+// New creates a new Telemetry using the given options.
+func New(options ...Option) (*Telemetry, error) {
+	c := &config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+		propagator:     otel.GetTextMapPropagator(),
+	}
+	for _, o := range options {
+		o(c)
+	}
+
+	meter := c.meterProvider.Meter(instrumentationName)
+	t := &Telemetry{
+		tracer:     c.tracerProvider.Tracer(instrumentationName),
+		propagator: c.propagator,
+	}
+
+	var err error
+	if t.duration, err = meter.Float64Histogram(
+		"tfe.client.request.duration",
+		metric.WithDescription("Duration of Terraform Enterprise API calls, including retries."),
+		metric.WithUnit("s"),
+	); err != nil {
+		return nil, err
+	}
+	if t.retries, err = meter.Int64Counter(
+		"tfe.client.request.retries",
+		metric.WithDescription("Number of retried Terraform Enterprise API requests."),
+	); err != nil {
+		return nil, err
+	}
+	if t.throttled, err = meter.Int64Counter(
+		"tfe.client.request.throttled",
+		metric.WithDescription("Number of 429 responses received from the Terraform Enterprise API."),
+	); err != nil {
+		return nil, err
+	}
+	if t.wait, err = meter.Float64Histogram(
+		"tfe.client.rate_limit.wait",
+		metric.WithDescription("Time spent waiting for the client-side rate limiter."),
+		metric.WithUnit("s"),
+	); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// StartCall implements tfe.Telemetry.
+func (t *Telemetry) StartCall(ctx context.Context, op tfe.Operation, req *http.Request) (context.Context, func(tfe.CallInfo)) {
+	name := op.Name
+	if name == "" {
+		name = "tfe." + req.Method
+	}
+
+	service, method := op.Name, ""
+	if i := strings.LastIndex(op.Name, "."); i >= 0 {
+		service, method = op.Name[:i], op.Name[i+1:]
+	}
+
+	attrs := []attribute.KeyValue{
+		attribute.String("tfe.service", service),
+		attribute.String("tfe.method", method),
+		attribute.String("tfe.resource_type", op.ResourceType),
+		attribute.String("http.request.method", req.Method),
+	}
+
+	spanAttrs := append([]attribute.KeyValue{
+		attribute.String("url.full", req.URL.String()),
+	}, attrs...)
+	for collection, id := range op.IDs {
+		spanAttrs = append(spanAttrs, attribute.String("tfe.id."+collection, id))
+	}
+
+	ctx, span := t.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(spanAttrs...),
+	)
+	t.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	return ctx, func(info tfe.CallInfo) {
+		span.SetAttributes(
+			attribute.Int("http.response.status_code", info.StatusCode),
+			attribute.Int("tfe.retries", info.Retries),
+			attribute.Int("tfe.throttled", info.RateLimited),
+			attribute.Float64("tfe.rate_limit.wait", info.RateLimitWait.Seconds()),
+			attribute.Bool("tfe.cached", info.Cached),
+		)
+		if info.Err != nil {
+			span.RecordError(info.Err)
+			span.SetStatus(codes.Error, info.Err.Error())
+		}
+		span.End()
+
+		metricAttrs := metric.WithAttributes(append(attrs,
+			attribute.Int("http.response.status_code", info.StatusCode),
+		)...)
+		t.duration.Record(ctx, info.Duration.Seconds(), metricAttrs)
+		t.wait.Record(ctx, info.RateLimitWait.Seconds(), metricAttrs)
+		if info.Retries > 0 {
+			t.retries.Add(ctx, int64(info.Retries), metricAttrs)
+		}
+		if info.RateLimited > 0 {
+			t.throttled.Add(ctx, int64(info.RateLimited), metricAttrs)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package tfeotel
+
+import (
+	"context"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/go-tfe/tfetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTelemetry(t *testing.T) {
+	srv := tfetest.NewServer()
+	defer srv.Close()
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	telemetry, err := New(
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+		WithPropagator(propagation.TraceContext{}),
+	)
+	require.NoError(t, err)
+
+	config := srv.Config()
+	config.Telemetry = telemetry
+
+	client, err := tfe.NewClient(config)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = client.Workspaces.Create(ctx, "my-org", tfe.WorkspaceCreateOptions{
+		Name: tfe.String("my-workspace"),
+	})
+	require.NoError(t, err)
+
+	_, err = client.Workspaces.Read(ctx, "my-org", "nonexisting")
+	require.Error(t, err)
+
+	t.Run("records a span per call", func(t *testing.T) {
+		ended := spans.Ended()
+		require.Len(t, ended, 2)
+
+		assert.Equal(t, "Workspaces.Create", ended[0].Name())
+		assert.Equal(t, codes.Unset, ended[0].Status().Code)
+		assert.Contains(t, ended[0].Attributes(), attribute.String("tfe.service", "Workspaces"))
+		assert.Contains(t, ended[0].Attributes(), attribute.String("tfe.method", "Create"))
+		assert.Contains(t, ended[0].Attributes(), attribute.String("tfe.id.organizations", "my-org"))
+		assert.Contains(t, ended[0].Attributes(), attribute.Int("http.response.status_code", 201))
+		assert.Contains(t, ended[0].Attributes(), attribute.Int("tfe.retries", 0))
+
+		assert.Equal(t, "Workspaces.Read", ended[1].Name())
+		assert.Equal(t, codes.Error, ended[1].Status().Code)
+		assert.Contains(t, ended[1].Attributes(), attribute.Int("http.response.status_code", 404))
+	})
+
+	t.Run("records metrics", func(t *testing.T) {
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(ctx, &rm))
+		require.Len(t, rm.ScopeMetrics, 1)
+
+		names := map[string]metricdata.Aggregation{}
+		for _, m := range rm.ScopeMetrics[0].Metrics {
+			names[m.Name] = m.Data
+		}
+
+		duration, ok := names["tfe.client.request.duration"].(metricdata.Histogram[float64])
+		require.True(t, ok)
+		assert.Len(t, duration.DataPoints, 2)
+
+		_, ok = names["tfe.client.rate_limit.wait"]
+		assert.True(t, ok)
+	})
+}
@@ -0,0 +1,142 @@
+package tfetest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// configurationVersion is a stored configuration version.
+type configurationVersion struct {
+	*tfe.ConfigurationVersion
+
+	workspaceID string
+	archive     []byte
+}
+
+func (s *Server) registerConfigurationVersionRoutes() {
+	s.handle("GET", "workspaces/:id/configuration-versions", s.withWorkspace(s.listConfigurationVersions))
+	s.handle("POST", "workspaces/:id/configuration-versions", s.withWorkspace(s.createConfigurationVersion))
+	s.handle("GET", "configuration-versions/:id", s.readConfigurationVersion)
+}
+
+func (s *Server) configurationVersionByID(id string) *configurationVersion {
+	for _, cv := range s.configurationVersions {
+		if cv.ID == id {
+			return cv
+		}
+	}
+	return nil
+}
+
+// latestConfigurationVersion returns the most recently created
+// configuration version of a workspace.
+func (s *Server) latestConfigurationVersion(workspaceID string) *configurationVersion {
+	for i := len(s.configurationVersions) - 1; i >= 0; i-- {
+		if s.configurationVersions[i].workspaceID == workspaceID {
+			return s.configurationVersions[i]
+		}
+	}
+	return nil
+}
+
+func (s *Server) listConfigurationVersions(w http.ResponseWriter, r *http.Request, ws *tfe.Workspace) {
+	// Configuration versions are listed newest first.
+	items := []*tfe.ConfigurationVersion{}
+	for i := len(s.configurationVersions) - 1; i >= 0; i-- {
+		if s.configurationVersions[i].workspaceID == ws.ID {
+			items = append(items, s.configurationVersions[i].ConfigurationVersion)
+		}
+	}
+
+	writeList(w, r, items)
+}
+
+func (s *Server) createConfigurationVersion(w http.ResponseWriter, r *http.Request, ws *tfe.Workspace) {
+	cv := &tfe.ConfigurationVersion{AutoQueueRuns: true}
+
+	attrs, err := decode(r, cv)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "malformed request", err.Error())
+		return
+	}
+
+	// The speculative attribute is not decoded into the model because of
+	// its malformed struct tag, so set it explicitly.
+	if v, ok := attrs["speculative"].(bool); ok {
+		cv.Speculative = v
+	}
+
+	cv.ID = s.newID("cv")
+	cv.Source = tfe.ConfigurationSourceAPI
+	cv.Status = tfe.ConfigurationPending
+	cv.StatusTimestamps = &tfe.CVStatusTimestamps{
+		QueuedAt: time.Now().UTC().Truncate(time.Second),
+	}
+	cv.UploadURL = s.URL + uploadPath + "upload/" + cv.ID
+
+	s.configurationVersions = append(s.configurationVersions, &configurationVersion{
+		ConfigurationVersion: cv,
+		workspaceID:          ws.ID,
+	})
+
+	write(w, http.StatusCreated, cv)
+}
+
+func (s *Server) readConfigurationVersion(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	cv := s.configurationVersionByID(params["id"])
+	if cv == nil {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+
+	write(w, http.StatusOK, cv.ConfigurationVersion)
+}
+
+// serveArchivist handles configuration version uploads and state version
+// downloads.
+func (s *Server) serveArchivist(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, uploadPath)
+
+	switch {
+	case r.Method == "PUT" && strings.HasPrefix(path, "upload/"):
+		cv := s.configurationVersionByID(strings.TrimPrefix(path, "upload/"))
+		if cv == nil {
+			writeError(w, http.StatusNotFound, "not found")
+			return
+		}
+
+		archive, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "malformed request", err.Error())
+			return
+		}
+
+		cv.archive = archive
+		cv.Status = tfe.ConfigurationUploaded
+		cv.StatusTimestamps.StartedAt = time.Now().UTC().Truncate(time.Second)
+		cv.StatusTimestamps.FinishedAt = cv.StatusTimestamps.StartedAt
+
+		w.WriteHeader(http.StatusOK)
+
+	case r.Method == "GET" && strings.HasPrefix(path, "state/"):
+		sv := s.stateVersionByID(strings.TrimPrefix(path, "state/"))
+		if sv == nil {
+			writeError(w, http.StatusNotFound, "not found")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write(sv.state)
+
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
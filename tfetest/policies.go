@@ -0,0 +1,165 @@
+package tfetest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// policy is a stored policy.
+type policy struct {
+	*tfe.Policy
+
+	content []byte
+}
+
+func (s *Server) registerPolicyRoutes() {
+	s.handle("GET", "organizations/:org/policies", s.listPolicies)
+	s.handle("POST", "organizations/:org/policies", s.createPolicy)
+	s.handle("GET", "policies/:id", s.withPolicy(s.readPolicy))
+	s.handle("PATCH", "policies/:id", s.withPolicy(s.updatePolicy))
+	s.handle("DELETE", "policies/:id", s.withPolicy(s.deletePolicy))
+	s.handle("PUT", "policies/:id/upload", s.withPolicy(s.uploadPolicy))
+	s.handle("GET", "policies/:id/download", s.withPolicy(s.downloadPolicy))
+}
+
+// policyHandlerFunc handles a request for a single existing policy.
+type policyHandlerFunc func(w http.ResponseWriter, r *http.Request, p *policy)
+
+// withPolicy looks up the policy identified by the id parameter.
+func (s *Server) withPolicy(h policyHandlerFunc) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		for _, p := range s.policies {
+			if p.ID == params["id"] {
+				h(w, r, p)
+				return
+			}
+		}
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *Server) listPolicies(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	search := r.URL.Query().Get("search[name]")
+
+	items := []*tfe.Policy{}
+	for _, p := range s.policies {
+		if p.Organization.Name != params["org"] {
+			continue
+		}
+		if search != "" && !containsFold(p.Name, search) {
+			continue
+		}
+		items = append(items, p.Policy)
+	}
+
+	writeList(w, r, items)
+}
+
+func (s *Server) createPolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	p := &tfe.Policy{}
+	if _, err := decode(r, p); err != nil {
+		writeError(w, http.StatusBadRequest, "malformed request", err.Error())
+		return
+	}
+
+	if !s.validPolicy(w, params["org"], p) {
+		return
+	}
+
+	p.ID = s.newID("pol")
+	p.UpdatedAt = time.Now().UTC().Truncate(time.Second)
+	p.Organization = &tfe.Organization{Name: params["org"]}
+
+	s.policies = append(s.policies, &policy{Policy: p})
+
+	write(w, http.StatusCreated, p)
+}
+
+func (s *Server) readPolicy(w http.ResponseWriter, r *http.Request, p *policy) {
+	write(w, http.StatusOK, p.Policy)
+}
+
+func (s *Server) updatePolicy(w http.ResponseWriter, r *http.Request, p *policy) {
+	updated := *p.Policy
+	if _, err := decode(r, &updated); err != nil {
+		writeError(w, http.StatusBadRequest, "malformed request", err.Error())
+		return
+	}
+
+	if !s.validPolicy(w, p.Organization.Name, &updated) {
+		return
+	}
+	updated.UpdatedAt = time.Now().UTC().Truncate(time.Second)
+
+	*p.Policy = updated
+
+	write(w, http.StatusOK, p.Policy)
+}
+
+func (s *Server) deletePolicy(w http.ResponseWriter, r *http.Request, p *policy) {
+	for i, other := range s.policies {
+		if other == p {
+			s.policies = append(s.policies[:i], s.policies[i+1:]...)
+			break
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) uploadPolicy(w http.ResponseWriter, r *http.Request, p *policy) {
+	content, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "malformed request", err.Error())
+		return
+	}
+	p.content = content
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) downloadPolicy(w http.ResponseWriter, r *http.Request, p *policy) {
+	if p.content == nil {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	w.Write(p.content)
+}
+
+// validPolicy writes a validation error and returns false if the policy is
+// invalid or its name is already used in the organization.
+func (s *Server) validPolicy(w http.ResponseWriter, organization string, p *tfe.Policy) bool {
+	if p.Name == "" {
+		writeError(w, http.StatusUnprocessableEntity, "invalid attribute", "Name can't be blank")
+		return false
+	}
+	if !reWorkspaceName.MatchString(p.Name) {
+		writeError(w, http.StatusUnprocessableEntity, "invalid attribute", "Name is invalid")
+		return false
+	}
+	if len(p.Enforce) == 0 {
+		writeError(w, http.StatusUnprocessableEntity, "invalid attribute", "Enforce can't be blank")
+		return false
+	}
+	for _, e := range p.Enforce {
+		switch e.Mode {
+		case tfe.EnforcementAdvisory, tfe.EnforcementSoft, tfe.EnforcementHard:
+		default:
+			writeError(w, http.StatusUnprocessableEntity, "invalid attribute", "Enforcement mode is invalid")
+			return false
+		}
+	}
+	for _, other := range s.policies {
+		if other.Organization.Name == organization && other.ID != p.ID && other.Name == p.Name {
+			writeError(w, http.StatusUnprocessableEntity, "invalid attribute", "Name has already been taken")
+			return false
+		}
+	}
+	return true
+}
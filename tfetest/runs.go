@@ -0,0 +1,259 @@
+package tfetest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+func (s *Server) registerRunRoutes() {
+	s.handle("GET", "workspaces/:id/runs", s.withWorkspace(s.listRuns))
+	s.handle("POST", "runs", s.createRun)
+	s.handle("GET", "runs/:id", s.withRun(s.readRun))
+	s.handle("POST", "runs/:id/actions/apply", s.withRun(s.applyRun))
+	s.handle("POST", "runs/:id/actions/cancel", s.withRun(s.cancelRun))
+	s.handle("POST", "runs/:id/actions/force-cancel", s.withRun(s.cancelRun))
+	s.handle("POST", "runs/:id/actions/discard", s.withRun(s.discardRun))
+}
+
+// SetRunStatus sets the status of a run. New runs immediately transition
+// to planned (or applied when the workspace uses auto-apply), so this can be
+// used to emulate any other part of the run lifecycle.
+func (s *Server) SetRunStatus(runID string, status tfe.RunStatus) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r := s.runByID(runID)
+	if r == nil {
+		return fmt.Errorf("run %s not found", runID)
+	}
+	setRunStatus(r, status)
+
+	return nil
+}
+
+// runHandlerFunc handles a request for a single existing run.
+type runHandlerFunc func(w http.ResponseWriter, r *http.Request, run *tfe.Run)
+
+// withRun looks up the run identified by the id parameter.
+func (s *Server) withRun(h runHandlerFunc) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		run := s.runByID(params["id"])
+		if run == nil {
+			writeError(w, http.StatusNotFound, "not found")
+			return
+		}
+		h(w, r, run)
+	}
+}
+
+func (s *Server) runByID(id string) *tfe.Run {
+	for _, r := range s.runs {
+		if r.ID == id {
+			return r
+		}
+	}
+	return nil
+}
+
+func (s *Server) listRuns(w http.ResponseWriter, r *http.Request, ws *tfe.Workspace) {
+	q := r.URL.Query()
+	statuses := queryList(q.Get("filter[status]"))
+	sources := queryList(q.Get("filter[source]"))
+
+	// Runs are listed newest first.
+	items := []*tfe.Run{}
+	for i := len(s.runs) - 1; i >= 0; i-- {
+		run := s.runs[i]
+		if run.Workspace.ID != ws.ID {
+			continue
+		}
+		if statuses != nil && !statuses[string(run.Status)] {
+			continue
+		}
+		if sources != nil && !sources[string(run.Source)] {
+			continue
+		}
+		items = append(items, run)
+	}
+
+	writeList(w, r, items)
+}
+
+func (s *Server) createRun(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	run := &tfe.Run{}
+	attrs, err := decode(r, run)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "malformed request", err.Error())
+		return
+	}
+
+	if run.Workspace == nil {
+		writeError(w, http.StatusUnprocessableEntity, "invalid attribute", "Workspace is required")
+		return
+	}
+	ws := s.workspaceByID(run.Workspace.ID)
+	if ws == nil {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+
+	if run.ConfigurationVersion != nil {
+		cv := s.configurationVersionByID(run.ConfigurationVersion.ID)
+		if cv == nil || cv.workspaceID != ws.ID {
+			writeError(w, http.StatusNotFound, "not found")
+			return
+		}
+		run.ConfigurationVersion = &tfe.ConfigurationVersion{ID: cv.ID}
+	} else {
+		cv := s.latestConfigurationVersion(ws.ID)
+		if cv == nil {
+			writeError(w, http.StatusUnprocessableEntity, "invalid attribute", "Workspace has no configuration versions")
+			return
+		}
+		run.ConfigurationVersion = &tfe.ConfigurationVersion{ID: cv.ID}
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	run.ID = s.newID("run")
+	run.CreatedAt = now
+	run.Source = tfe.RunSourceAPI
+	run.Workspace = &tfe.Workspace{ID: ws.ID}
+	run.StatusTimestamps = &tfe.RunStatusTimestamps{QueuedAt: now}
+	run.Permissions = &tfe.RunPermissions{
+		CanApply:        true,
+		CanCancel:       true,
+		CanDiscard:      true,
+		CanForceCancel:  true,
+		CanForceExecute: true,
+	}
+
+	// Runs refresh and follow the auto-apply setting of their workspace,
+	// unless requested otherwise.
+	run.Refresh = true
+	if v, ok := attrs["refresh"].(bool); ok {
+		run.Refresh = v
+	}
+	run.AutoApply = ws.AutoApply
+	if v, ok := attrs["auto-apply"].(bool); ok {
+		run.AutoApply = v
+	}
+
+	switch {
+	case run.PlanOnly:
+		setRunStatus(run, tfe.RunPlannedAndFinished)
+	case run.AutoApply:
+		setRunStatus(run, tfe.RunApplied)
+	default:
+		setRunStatus(run, tfe.RunPlanned)
+	}
+
+	s.runs = append(s.runs, run)
+	if !run.PlanOnly {
+		ws.CurrentRun = &tfe.Run{ID: run.ID}
+	}
+
+	write(w, http.StatusCreated, run)
+}
+
+func (s *Server) readRun(w http.ResponseWriter, r *http.Request, run *tfe.Run) {
+	write(w, http.StatusOK, run)
+}
+
+func (s *Server) applyRun(w http.ResponseWriter, r *http.Request, run *tfe.Run) {
+	if !run.Actions.IsConfirmable {
+		writeError(w, http.StatusConflict, "transition not allowed", "Run is not confirmable.")
+		return
+	}
+	setRunStatus(run, tfe.RunApplied)
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *Server) cancelRun(w http.ResponseWriter, r *http.Request, run *tfe.Run) {
+	if !run.Actions.IsCancelable {
+		writeError(w, http.StatusConflict, "transition not allowed", "Run is not cancelable.")
+		return
+	}
+	setRunStatus(run, tfe.RunCanceled)
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *Server) discardRun(w http.ResponseWriter, r *http.Request, run *tfe.Run) {
+	if !run.Actions.IsDiscardable {
+		writeError(w, http.StatusConflict, "transition not allowed", "Run is not discardable.")
+		return
+	}
+	setRunStatus(run, tfe.RunDiscarded)
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// setRunStatus updates the status, timestamps and available actions of
+// a run.
+func setRunStatus(run *tfe.Run, status tfe.RunStatus) {
+	now := time.Now().UTC().Truncate(time.Second)
+
+	run.Status = status
+	if run.StatusTimestamps == nil {
+		run.StatusTimestamps = &tfe.RunStatusTimestamps{}
+	}
+
+	switch status {
+	case tfe.RunPlanning:
+		run.StatusTimestamps.PlanningAt = now
+	case tfe.RunPlanned, tfe.RunCostEstimated, tfe.RunPolicyChecked:
+		run.StatusTimestamps.PlannedAt = now
+	case tfe.RunPlannedAndFinished:
+		run.StatusTimestamps.PlannedAndFinishedAt = now
+		run.StatusTimestamps.FinishedAt = now
+	case tfe.RunApplying:
+		run.StatusTimestamps.ApplyingAt = now
+	case tfe.RunApplied:
+		run.StatusTimestamps.AppliedAt = now
+		run.StatusTimestamps.FinishedAt = now
+	case tfe.RunErrored:
+		run.StatusTimestamps.ErroredAt = now
+		run.StatusTimestamps.FinishedAt = now
+	case tfe.RunCanceled, tfe.RunDiscarded:
+		run.StatusTimestamps.FinishedAt = now
+	}
+
+	confirmable := false
+	cancelable := false
+	discardable := false
+
+	switch status {
+	case tfe.RunPlanned, tfe.RunCostEstimated, tfe.RunPolicyChecked, tfe.RunPolicyOverride:
+		confirmable = true
+		discardable = true
+	case tfe.RunPending, tfe.RunPlanQueued, tfe.RunPlanning, tfe.RunCostEstimating,
+		tfe.RunPolicyChecking, tfe.RunApplyQueued, tfe.RunApplying, tfe.RunConfirmed:
+		cancelable = true
+	}
+
+	run.Actions = &tfe.RunActions{
+		IsCancelable:      cancelable,
+		IsConfirmable:     confirmable,
+		IsDiscardable:     discardable,
+		IsForceCancelable: cancelable,
+	}
+}
+
+// queryList returns the set of values of a comma separated query parameter,
+// or nil if it is empty.
+func queryList(v string) map[string]bool {
+	if v == "" {
+		return nil
+	}
+	set := make(map[string]bool)
+	for _, item := range strings.Split(v, ",") {
+		set[item] = true
+	}
+	return set
+}
@@ -0,0 +1,342 @@
+// Package tfetest provides an in-memory fake of the Terraform Enterprise API
+// that can be used to test code consuming a *tfe.Client without network
+// access or a real Terraform Enterprise instance.
+//
+// The fake keeps all state in memory and emulates the ping, workspaces, runs,
+// configuration versions, state versions, variables, teams and policies
+// endpoints closely enough for full create, read, update and delete flows:
+//
+//	srv := tfetest.NewServer()
+//	defer srv.Close()
+//
+//	client, err := srv.Client()
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//
+//	w, err := client.Workspaces.Create(ctx, "my-org", tfe.WorkspaceCreateOptions{
+//		Name: tfe.String("my-workspace"),
+//	})
+package tfetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/svanharmelen/jsonapi"
+)
+
+const (
+	// DefaultToken is the API token accepted by a server created
+	// with NewServer.
+	DefaultToken = "tfetest-token"
+
+	// APIVersion is the API version reported by the fake server in the
+	// TFP-API-Version response header.
+	APIVersion = "2.3"
+
+	// uploadPath is the path prefix used for configuration version uploads
+	// and state downloads, mimicking the separate archivist service.
+	uploadPath = "/_archivist/"
+
+	defaultPageSize = 20
+)
+
+// Server is an in-memory fake of the Terraform Enterprise API.
+type Server struct {
+	// URL of the fake server, suitable as tfe.Config.Address.
+	URL string
+
+	// Token is the API token the fake server accepts. Requests using any
+	// other token are rejected with a 401 response.
+	Token string
+
+	server *httptest.Server
+	routes []route
+
+	mu                    sync.Mutex
+	ids                   map[string]int
+	workspaces            []*tfe.Workspace
+	runs                  []*tfe.Run
+	configurationVersions []*configurationVersion
+	stateVersions         []*stateVersion
+	variables             []*tfe.Variable
+	teams                 []*team
+	policies              []*policy
+}
+
+// NewServer starts and returns a new fake Terraform Enterprise server. The
+// caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		Token: DefaultToken,
+		ids:   make(map[string]int),
+	}
+	s.registerRoutes()
+
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL
+
+	return s
+}
+
+// Close shuts down the server and blocks until all outstanding requests
+// on this server have completed.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Config returns a tfe.Config that points to the fake server.
+func (s *Server) Config() *tfe.Config {
+	return &tfe.Config{
+		Address:    s.URL,
+		Token:      s.Token,
+		HTTPClient: s.server.Client(),
+	}
+}
+
+// Client returns a new tfe.Client that is configured to use the fake server.
+func (s *Server) Client() (*tfe.Client, error) {
+	return tfe.NewClient(s.Config())
+}
+
+// handlerFunc handles a single routed API request. The params contain the
+// values of all named path segments of the matched route.
+type handlerFunc func(w http.ResponseWriter, r *http.Request, params map[string]string)
+
+// route maps a method and path pattern to a handler. Path segments
+// starting with a colon are named parameters.
+type route struct {
+	method   string
+	segments []string
+	handler  handlerFunc
+}
+
+// handle registers a new route.
+func (s *Server) handle(method, pattern string, h handlerFunc) {
+	s.routes = append(s.routes, route{
+		method:   method,
+		segments: strings.Split(pattern, "/"),
+		handler:  h,
+	})
+}
+
+// registerRoutes registers all supported API endpoints.
+func (s *Server) registerRoutes() {
+	s.handle("GET", "ping", s.ping)
+
+	s.registerWorkspaceRoutes()
+	s.registerRunRoutes()
+	s.registerConfigurationVersionRoutes()
+	s.registerStateVersionRoutes()
+	s.registerVariableRoutes()
+	s.registerTeamRoutes()
+	s.registerPolicyRoutes()
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	// Uploads and downloads are authorized by their secret URL instead
+	// of by the API token, just like the real archivist service.
+	if strings.HasPrefix(r.URL.Path, uploadPath) {
+		s.serveArchivist(w, r)
+		return
+	}
+
+	if r.Header.Get("Authorization") != "Bearer "+s.Token {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, tfe.DefaultBasePath)
+	if path == r.URL.Path {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+	segments := strings.Split(strings.TrimSuffix(path, "/"), "/")
+
+	for _, rt := range s.routes {
+		params, ok := rt.match(segments)
+		if !ok || rt.method != r.Method {
+			continue
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		rt.handler(w, r, params)
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "not found")
+}
+
+// match checks if the given path segments match the route and returns the
+// values of the named segments if they do.
+func (rt route) match(segments []string) (map[string]string, bool) {
+	if len(segments) != len(rt.segments) {
+		return nil, false
+	}
+
+	params := make(map[string]string)
+	for i, seg := range rt.segments {
+		if strings.HasPrefix(seg, ":") {
+			params[seg[1:]] = segments[i]
+			continue
+		}
+		if seg != segments[i] {
+			return nil, false
+		}
+	}
+
+	return params, true
+}
+
+func (s *Server) ping(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	w.Header().Set("Content-Type", "application/vnd.api+json")
+	w.Header().Set("TFP-API-Version", APIVersion)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// newID returns a new unique resource ID using the given prefix.
+func (s *Server) newID(prefix string) string {
+	s.ids[prefix]++
+	return fmt.Sprintf("%s-%016d", prefix, s.ids[prefix])
+}
+
+// decode decodes the JSON:API document in the request body into model. Only
+// the attributes and relations present in the document are set, so decoding
+// into an existing model updates it in place. The raw attributes of the
+// document are returned so callers can inspect attributes that are not part
+// of the model.
+func decode(r *http.Request, model interface{}) (map[string]interface{}, error) {
+	payload := &jsonapi.OnePayload{}
+	if err := json.NewDecoder(r.Body).Decode(payload); err != nil {
+		if err == io.EOF {
+			return map[string]interface{}{}, nil
+		}
+		return nil, err
+	}
+	if payload.Data == nil {
+		return map[string]interface{}{}, nil
+	}
+
+	// The fake never accepts client-generated IDs.
+	payload.Data.ID = ""
+
+	buf := bytes.NewBuffer(nil)
+	if err := json.NewEncoder(buf).Encode(&jsonapi.OnePayload{Data: payload.Data}); err != nil {
+		return nil, err
+	}
+	if err := jsonapi.UnmarshalPayload(buf, model); err != nil {
+		return nil, err
+	}
+
+	if payload.Data.Attributes == nil {
+		return map[string]interface{}{}, nil
+	}
+
+	return payload.Data.Attributes, nil
+}
+
+// write writes model as a JSON:API document.
+func write(w http.ResponseWriter, status int, model interface{}) {
+	w.Header().Set("Content-Type", "application/vnd.api+json")
+	w.WriteHeader(status)
+
+	if err := jsonapi.MarshalPayloadWithoutIncluded(w, model); err != nil {
+		panic(fmt.Sprintf("tfetest: error encoding %T: %v", model, err))
+	}
+}
+
+// writeList writes the page of items requested by r as a JSON:API document,
+// including the pagination details. The items value must be a slice of
+// model pointers.
+func writeList(w http.ResponseWriter, r *http.Request, items interface{}) {
+	all, err := jsonapi.Marshal(items)
+	if err != nil {
+		panic(fmt.Sprintf("tfetest: error encoding %T: %v", items, err))
+	}
+	payload := all.(*jsonapi.ManyPayload)
+
+	pageNumber := queryInt(r, "page[number]", 1)
+	pageSize := queryInt(r, "page[size]", defaultPageSize)
+
+	total := len(payload.Data)
+	totalPages := (total + pageSize - 1) / pageSize
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	start := (pageNumber - 1) * pageSize
+	if start > total {
+		start = total
+	}
+	end := start + pageSize
+	if end > total {
+		end = total
+	}
+
+	p := tfe.Pagination{
+		CurrentPage: pageNumber,
+		TotalPages:  totalPages,
+		TotalCount:  total,
+	}
+	if pageNumber > 1 {
+		p.PreviousPage = pageNumber - 1
+	}
+	if pageNumber < totalPages {
+		p.NextPage = pageNumber + 1
+	}
+
+	payload.Data = payload.Data[start:end]
+	payload.Included = nil
+	payload.Meta = &jsonapi.Meta{"pagination": p}
+
+	w.Header().Set("Content-Type", "application/vnd.api+json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(payload); err != nil {
+		panic(fmt.Sprintf("tfetest: error encoding %T: %v", items, err))
+	}
+}
+
+// writeError writes a JSON:API error document.
+func writeError(w http.ResponseWriter, status int, title string, detail ...string) {
+	w.Header().Set("Content-Type", "application/vnd.api+json")
+	w.WriteHeader(status)
+
+	jsonapi.MarshalErrors(w, []*jsonapi.ErrorObject{{
+		Status: strconv.Itoa(status),
+		Title:  title,
+		Detail: strings.Join(detail, " "),
+	}})
+}
+
+// queryInt returns the integer value of the given query parameter, or def
+// if the parameter is missing or invalid.
+func queryInt(r *http.Request, key string, def int) int {
+	v, err := strconv.Atoi(r.URL.Query().Get(key))
+	if err != nil || v < 1 {
+		return def
+	}
+	return v
+}
+
+// stringAttr returns the string value of the given raw attribute.
+func stringAttr(attrs map[string]interface{}, key string) (string, bool) {
+	v, ok := attrs[key].(string)
+	return v, ok && v != ""
+}
+
+// containsFold reports whether substr is within s, ignoring case.
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
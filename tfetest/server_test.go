@@ -0,0 +1,378 @@
+package tfetest
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testServer(t *testing.T) (*Server, *tfe.Client) {
+	srv := NewServer()
+
+	client, err := srv.Client()
+	if err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+
+	return srv, client
+}
+
+func TestServer_authentication(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	cfg := srv.Config()
+	cfg.Token = "wrong-token"
+
+	client, err := tfe.NewClient(cfg)
+	require.NoError(t, err)
+
+	_, err = client.Workspaces.List(context.Background(), "my-org", tfe.WorkspaceListOptions{})
+	assert.True(t, errors.Is(err, tfe.ErrUnauthorized))
+}
+
+func TestServer_workspaces(t *testing.T) {
+	srv, client := testServer(t)
+	defer srv.Close()
+
+	ctx := context.Background()
+
+	w, err := client.Workspaces.Create(ctx, "my-org", tfe.WorkspaceCreateOptions{
+		Name:      tfe.String("my-workspace"),
+		AutoApply: tfe.Bool(true),
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, w.ID)
+	assert.Equal(t, "my-workspace", w.Name)
+	assert.True(t, w.AutoApply)
+	assert.Equal(t, "my-org", w.Organization.Name)
+
+	t.Run("with a duplicate name", func(t *testing.T) {
+		_, err := client.Workspaces.Create(ctx, "my-org", tfe.WorkspaceCreateOptions{
+			Name: tfe.String("my-workspace"),
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("read by name and ID", func(t *testing.T) {
+		byName, err := client.Workspaces.Read(ctx, "my-org", "my-workspace")
+		require.NoError(t, err)
+		assert.Equal(t, w.ID, byName.ID)
+
+		byID, err := client.Workspaces.ReadByID(ctx, w.ID)
+		require.NoError(t, err)
+		assert.Equal(t, w.Name, byID.Name)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		updated, err := client.Workspaces.UpdateByID(ctx, w.ID, tfe.WorkspaceUpdateOptions{
+			TerraformVersion: tfe.String("0.12.0"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "0.12.0", updated.TerraformVersion)
+		assert.True(t, updated.AutoApply)
+	})
+
+	t.Run("lock and unlock", func(t *testing.T) {
+		locked, err := client.Workspaces.Lock(ctx, w.ID, tfe.WorkspaceLockOptions{})
+		require.NoError(t, err)
+		assert.True(t, locked.Locked)
+
+		_, err = client.Workspaces.Lock(ctx, w.ID, tfe.WorkspaceLockOptions{})
+		assert.True(t, errors.Is(err, tfe.ErrWorkspaceLocked))
+
+		unlocked, err := client.Workspaces.Unlock(ctx, w.ID)
+		require.NoError(t, err)
+		assert.False(t, unlocked.Locked)
+	})
+
+	t.Run("list with pagination", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			_, err := client.Workspaces.Create(ctx, "my-org", tfe.WorkspaceCreateOptions{
+				Name: tfe.String(fmt.Sprintf("other-%d", i)),
+			})
+			require.NoError(t, err)
+		}
+
+		wl, err := client.Workspaces.List(ctx, "my-org", tfe.WorkspaceListOptions{
+			ListOptions: tfe.ListOptions{PageNumber: 2, PageSize: 2},
+		})
+		require.NoError(t, err)
+		assert.Len(t, wl.Items, 1)
+		assert.Equal(t, 3, wl.TotalCount)
+		assert.Equal(t, 2, wl.TotalPages)
+		assert.Equal(t, 1, wl.PreviousPage)
+
+		wl, err = client.Workspaces.List(ctx, "my-org", tfe.WorkspaceListOptions{
+			Search: tfe.String("other"),
+		})
+		require.NoError(t, err)
+		assert.Len(t, wl.Items, 2)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		err := client.Workspaces.Delete(ctx, "my-org", "my-workspace")
+		require.NoError(t, err)
+
+		_, err = client.Workspaces.ReadByID(ctx, w.ID)
+		assert.True(t, errors.Is(err, tfe.ErrResourceNotFound))
+	})
+}
+
+func TestServer_runs(t *testing.T) {
+	srv, client := testServer(t)
+	defer srv.Close()
+
+	ctx := context.Background()
+
+	w, err := client.Workspaces.Create(ctx, "my-org", tfe.WorkspaceCreateOptions{
+		Name: tfe.String("my-workspace"),
+	})
+	require.NoError(t, err)
+
+	t.Run("without a configuration version", func(t *testing.T) {
+		_, err := client.Runs.Create(ctx, tfe.RunCreateOptions{Workspace: w})
+		assert.Error(t, err)
+	})
+
+	cv, err := client.ConfigurationVersions.Create(ctx, w.ID, tfe.ConfigurationVersionCreateOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, tfe.ConfigurationPending, cv.Status)
+
+	err = client.ConfigurationVersions.Upload(ctx, cv.UploadURL, "../test-fixtures/config-version")
+	require.NoError(t, err)
+
+	cv, err = client.ConfigurationVersions.Read(ctx, cv.ID)
+	require.NoError(t, err)
+	assert.Equal(t, tfe.ConfigurationUploaded, cv.Status)
+
+	r, err := client.Runs.Create(ctx, tfe.RunCreateOptions{
+		Workspace: w,
+		Message:   tfe.String("testing"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, tfe.RunPlanned, r.Status)
+	assert.Equal(t, "testing", r.Message)
+	assert.Equal(t, cv.ID, r.ConfigurationVersion.ID)
+	assert.True(t, r.Actions.IsConfirmable)
+
+	w, err = client.Workspaces.ReadByID(ctx, w.ID)
+	require.NoError(t, err)
+	assert.Equal(t, r.ID, w.CurrentRun.ID)
+
+	err = client.Runs.Apply(ctx, r.ID, tfe.RunApplyOptions{})
+	require.NoError(t, err)
+
+	r, err = client.Runs.Read(ctx, r.ID)
+	require.NoError(t, err)
+	assert.Equal(t, tfe.RunApplied, r.Status)
+
+	// An applied run can not be discarded.
+	err = client.Runs.Discard(ctx, r.ID, tfe.RunDiscardOptions{})
+	assert.Error(t, err)
+
+	require.NoError(t, srv.SetRunStatus(r.ID, tfe.RunPlanning))
+	require.NoError(t, client.Runs.Cancel(ctx, r.ID, tfe.RunCancelOptions{}))
+
+	rl, err := client.Runs.List(ctx, w.ID, tfe.RunListOptions{})
+	require.NoError(t, err)
+	require.Len(t, rl.Items, 1)
+	assert.Equal(t, tfe.RunCanceled, rl.Items[0].Status)
+
+	rl, err = client.Runs.List(ctx, w.ID, tfe.RunListOptions{
+		Status: []tfe.RunStatus{tfe.RunErrored, tfe.RunApplied},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, rl.Items)
+
+	t.Run("with a plan-only run", func(t *testing.T) {
+		r, err := client.Runs.Create(ctx, tfe.RunCreateOptions{
+			Workspace: w,
+			PlanOnly:  tfe.Bool(true),
+			Variables: []*tfe.RunVariable{{Key: "region", Value: `"us-east-1"`}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, tfe.RunPlannedAndFinished, r.Status)
+		assert.True(t, r.PlanOnly)
+		assert.True(t, r.Refresh)
+		assert.Equal(t, "region", r.Variables[0].Key)
+	})
+
+	t.Run("overriding auto-apply", func(t *testing.T) {
+		r, err := client.Runs.Create(ctx, tfe.RunCreateOptions{
+			Workspace: w,
+			AutoApply: tfe.Bool(true),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, tfe.RunApplied, r.Status)
+		assert.True(t, r.AutoApply)
+	})
+}
+
+func TestServer_stateVersions(t *testing.T) {
+	srv, client := testServer(t)
+	defer srv.Close()
+
+	ctx := context.Background()
+
+	w, err := client.Workspaces.Create(ctx, "my-org", tfe.WorkspaceCreateOptions{
+		Name: tfe.String("my-workspace"),
+	})
+	require.NoError(t, err)
+
+	state := []byte(`{"version": 4, "serial": 1}`)
+	options := tfe.StateVersionCreateOptions{
+		MD5:    tfe.String(fmt.Sprintf("%x", md5.Sum(state))),
+		Serial: tfe.Int64(1),
+		State:  tfe.String(base64.StdEncoding.EncodeToString(state)),
+	}
+
+	t.Run("when the workspace is not locked", func(t *testing.T) {
+		_, err := client.StateVersions.Create(ctx, w.ID, options)
+		assert.Error(t, err)
+	})
+
+	_, err = client.Workspaces.Lock(ctx, w.ID, tfe.WorkspaceLockOptions{})
+	require.NoError(t, err)
+
+	sv, err := client.StateVersions.Create(ctx, w.ID, options)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), sv.Serial)
+
+	current, err := client.StateVersions.Current(ctx, w.ID)
+	require.NoError(t, err)
+	assert.Equal(t, sv.ID, current.ID)
+
+	svl, err := client.StateVersions.List(ctx, tfe.StateVersionListOptions{
+		Organization: tfe.String("my-org"),
+		Workspace:    tfe.String("my-workspace"),
+	})
+	require.NoError(t, err)
+	assert.Len(t, svl.Items, 1)
+
+	downloaded, err := client.StateVersions.Download(ctx, current.DownloadURL)
+	require.NoError(t, err)
+	assert.Equal(t, state, downloaded)
+}
+
+func TestServer_variables(t *testing.T) {
+	srv, client := testServer(t)
+	defer srv.Close()
+
+	ctx := context.Background()
+
+	w, err := client.Workspaces.Create(ctx, "my-org", tfe.WorkspaceCreateOptions{
+		Name: tfe.String("my-workspace"),
+	})
+	require.NoError(t, err)
+
+	v, err := client.Variables.Create(ctx, w.ID, tfe.VariableCreateOptions{
+		Key:       tfe.String("secret"),
+		Value:     tfe.String("hunter2"),
+		Category:  tfe.Category(tfe.CategoryEnv),
+		Sensitive: tfe.Bool(true),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "secret", v.Key)
+	assert.Empty(t, v.Value)
+	assert.Equal(t, w.ID, v.Workspace.ID)
+
+	v, err = client.Variables.Update(ctx, w.ID, v.ID, tfe.VariableUpdateOptions{
+		Key: tfe.String("password"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "password", v.Key)
+	assert.True(t, v.Sensitive)
+
+	vl, err := client.Variables.List(ctx, w.ID, tfe.VariableListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, vl.Items, 1)
+
+	require.NoError(t, client.Variables.Delete(ctx, w.ID, v.ID))
+
+	_, err = client.Variables.Read(ctx, w.ID, v.ID)
+	assert.True(t, errors.Is(err, tfe.ErrResourceNotFound))
+}
+
+func TestServer_teams(t *testing.T) {
+	srv, client := testServer(t)
+	defer srv.Close()
+
+	ctx := context.Background()
+
+	tm, err := client.Teams.Create(ctx, "my-org", tfe.TeamCreateOptions{
+		Name: tfe.String("developers"),
+		OrganizationAccess: &tfe.OrganizationAccessOptions{
+			ManageWorkspaces: tfe.Bool(true),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "developers", tm.Name)
+	assert.Equal(t, "secret", tm.Visibility)
+	assert.True(t, tm.OrganizationAccess.ManageWorkspaces)
+
+	tm, err = client.Teams.Update(ctx, tm.ID, tfe.TeamUpdateOptions{
+		Visibility: tfe.String("organization"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "organization", tm.Visibility)
+
+	tl, err := client.Teams.List(ctx, "my-org", tfe.TeamListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, tl.Items, 1)
+
+	require.NoError(t, client.Teams.Delete(ctx, tm.ID))
+
+	_, err = client.Teams.Read(ctx, tm.ID)
+	assert.True(t, errors.Is(err, tfe.ErrResourceNotFound))
+}
+
+func TestServer_policies(t *testing.T) {
+	srv, client := testServer(t)
+	defer srv.Close()
+
+	ctx := context.Background()
+
+	p, err := client.Policies.Create(ctx, "my-org", tfe.PolicyCreateOptions{
+		Name: tfe.String("my-policy"),
+		Enforce: []*tfe.EnforcementOptions{{
+			Path: tfe.String("my-policy.sentinel"),
+			Mode: tfe.EnforcementMode(tfe.EnforcementSoft),
+		}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "my-policy", p.Name)
+	require.Len(t, p.Enforce, 1)
+	assert.Equal(t, tfe.EnforcementSoft, p.Enforce[0].Mode)
+	assert.Equal(t, "my-policy.sentinel", p.Enforce[0].Path)
+
+	content := []byte(`main = rule { true }`)
+	require.NoError(t, client.Policies.Upload(ctx, p.ID, content))
+
+	downloaded, err := client.Policies.Download(ctx, p.ID)
+	require.NoError(t, err)
+	assert.Equal(t, content, downloaded)
+
+	p, err = client.Policies.Update(ctx, p.ID, tfe.PolicyUpdateOptions{
+		Description: tfe.String("A test policy"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "A test policy", p.Description)
+
+	pl, err := client.Policies.List(ctx, "my-org", tfe.PolicyListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, pl.Items, 1)
+
+	require.NoError(t, client.Policies.Delete(ctx, p.ID))
+
+	_, err = client.Policies.Read(ctx, p.ID)
+	assert.True(t, errors.Is(err, tfe.ErrResourceNotFound))
+}
@@ -0,0 +1,139 @@
+package tfetest
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// stateVersion is a stored state version.
+type stateVersion struct {
+	*tfe.StateVersion
+
+	workspaceID string
+	state       []byte
+}
+
+func (s *Server) registerStateVersionRoutes() {
+	s.handle("GET", "state-versions", s.listStateVersions)
+	s.handle("POST", "workspaces/:id/state-versions", s.withWorkspace(s.createStateVersion))
+	s.handle("GET", "state-versions/:id", s.readStateVersion)
+	s.handle("GET", "workspaces/:id/current-state-version", s.withWorkspace(s.currentStateVersion))
+}
+
+func (s *Server) stateVersionByID(id string) *stateVersion {
+	for _, sv := range s.stateVersions {
+		if sv.ID == id {
+			return sv
+		}
+	}
+	return nil
+}
+
+func (s *Server) listStateVersions(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	q := r.URL.Query()
+
+	ws := s.workspaceByName(q.Get("filter[organization][name]"), q.Get("filter[workspace][name]"))
+	if ws == nil {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+
+	// State versions are listed newest first.
+	items := []*tfe.StateVersion{}
+	for i := len(s.stateVersions) - 1; i >= 0; i-- {
+		if s.stateVersions[i].workspaceID == ws.ID {
+			items = append(items, s.stateVersions[i].StateVersion)
+		}
+	}
+
+	writeList(w, r, items)
+}
+
+func (s *Server) createStateVersion(w http.ResponseWriter, r *http.Request, ws *tfe.Workspace) {
+	sv := &tfe.StateVersion{}
+
+	attrs, err := decode(r, sv)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "malformed request", err.Error())
+		return
+	}
+
+	if !ws.Locked {
+		writeError(w, http.StatusConflict, "conflict", "The workspace must be locked to create a state version.")
+		return
+	}
+
+	encoded, ok := stringAttr(attrs, "state")
+	if !ok {
+		writeError(w, http.StatusUnprocessableEntity, "invalid attribute", "State can't be blank")
+		return
+	}
+	state, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "invalid attribute", "State is not base64 encoded")
+		return
+	}
+
+	sum, _ := stringAttr(attrs, "md5")
+	if sum != fmt.Sprintf("%x", md5.Sum(state)) {
+		writeError(w, http.StatusUnprocessableEntity, "invalid attribute", "MD5 does not match the state")
+		return
+	}
+
+	if current := s.currentState(ws.ID); current != nil && sv.Serial <= current.Serial {
+		if force, _ := attrs["force"].(bool); !force {
+			writeError(w, http.StatusConflict, "conflict", "Serial must be greater than the current serial.")
+			return
+		}
+	}
+
+	sv.ID = s.newID("sv")
+	sv.CreatedAt = time.Now().UTC().Truncate(time.Second)
+	sv.DownloadURL = s.URL + uploadPath + "state/" + sv.ID
+	if sv.Run != nil {
+		sv.Run = &tfe.Run{ID: sv.Run.ID}
+	}
+
+	s.stateVersions = append(s.stateVersions, &stateVersion{
+		StateVersion: sv,
+		workspaceID:  ws.ID,
+		state:        state,
+	})
+
+	write(w, http.StatusCreated, sv)
+}
+
+func (s *Server) readStateVersion(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	sv := s.stateVersionByID(params["id"])
+	if sv == nil {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+
+	write(w, http.StatusOK, sv.StateVersion)
+}
+
+func (s *Server) currentStateVersion(w http.ResponseWriter, r *http.Request, ws *tfe.Workspace) {
+	sv := s.currentState(ws.ID)
+	if sv == nil {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+
+	write(w, http.StatusOK, sv.StateVersion)
+}
+
+// currentState returns the latest state version of a workspace.
+func (s *Server) currentState(workspaceID string) *stateVersion {
+	for i := len(s.stateVersions) - 1; i >= 0; i-- {
+		if s.stateVersions[i].workspaceID == workspaceID {
+			return s.stateVersions[i]
+		}
+	}
+	return nil
+}
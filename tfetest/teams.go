@@ -0,0 +1,125 @@
+package tfetest
+
+import (
+	"net/http"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// team is a stored team.
+type team struct {
+	*tfe.Team
+
+	organization string
+}
+
+func (s *Server) registerTeamRoutes() {
+	s.handle("GET", "organizations/:org/teams", s.listTeams)
+	s.handle("POST", "organizations/:org/teams", s.createTeam)
+	s.handle("GET", "teams/:id", s.withTeam(s.readTeam))
+	s.handle("PATCH", "teams/:id", s.withTeam(s.updateTeam))
+	s.handle("DELETE", "teams/:id", s.withTeam(s.deleteTeam))
+}
+
+// teamHandlerFunc handles a request for a single existing team.
+type teamHandlerFunc func(w http.ResponseWriter, r *http.Request, t *team)
+
+// withTeam looks up the team identified by the id parameter.
+func (s *Server) withTeam(h teamHandlerFunc) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		for _, t := range s.teams {
+			if t.ID == params["id"] {
+				h(w, r, t)
+				return
+			}
+		}
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *Server) listTeams(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	items := []*tfe.Team{}
+	for _, t := range s.teams {
+		if t.organization == params["org"] {
+			items = append(items, t.Team)
+		}
+	}
+
+	writeList(w, r, items)
+}
+
+func (s *Server) createTeam(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	t := &tfe.Team{
+		OrganizationAccess: &tfe.OrganizationAccess{},
+		Visibility:         "secret",
+	}
+	if _, err := decode(r, t); err != nil {
+		writeError(w, http.StatusBadRequest, "malformed request", err.Error())
+		return
+	}
+
+	if !s.validTeam(w, params["org"], t) {
+		return
+	}
+
+	t.ID = s.newID("team")
+	t.Permissions = &tfe.TeamPermissions{
+		CanDestroy:          true,
+		CanUpdateMembership: true,
+	}
+
+	s.teams = append(s.teams, &team{Team: t, organization: params["org"]})
+
+	write(w, http.StatusCreated, t)
+}
+
+func (s *Server) readTeam(w http.ResponseWriter, r *http.Request, t *team) {
+	write(w, http.StatusOK, t.Team)
+}
+
+func (s *Server) updateTeam(w http.ResponseWriter, r *http.Request, t *team) {
+	updated := *t.Team
+	if _, err := decode(r, &updated); err != nil {
+		writeError(w, http.StatusBadRequest, "malformed request", err.Error())
+		return
+	}
+
+	if !s.validTeam(w, t.organization, &updated) {
+		return
+	}
+
+	*t.Team = updated
+
+	write(w, http.StatusOK, t.Team)
+}
+
+func (s *Server) deleteTeam(w http.ResponseWriter, r *http.Request, t *team) {
+	for i, other := range s.teams {
+		if other == t {
+			s.teams = append(s.teams[:i], s.teams[i+1:]...)
+			break
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validTeam writes a validation error and returns false if the team is
+// invalid or its name is already used in the organization.
+func (s *Server) validTeam(w http.ResponseWriter, organization string, t *tfe.Team) bool {
+	if t.Name == "" {
+		writeError(w, http.StatusUnprocessableEntity, "invalid attribute", "Name can't be blank")
+		return false
+	}
+	if t.Visibility != "secret" && t.Visibility != "organization" {
+		writeError(w, http.StatusUnprocessableEntity, "invalid attribute", "Visibility is not included in the list")
+		return false
+	}
+	for _, other := range s.teams {
+		if other.organization == organization && other.ID != t.ID && other.Name == t.Name {
+			writeError(w, http.StatusUnprocessableEntity, "invalid attribute", "Name has already been taken")
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,131 @@
+package tfetest
+
+import (
+	"net/http"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+func (s *Server) registerVariableRoutes() {
+	s.handle("GET", "workspaces/:id/vars", s.withWorkspace(s.listVariables))
+	s.handle("POST", "workspaces/:id/vars", s.withWorkspace(s.createVariable))
+	s.handle("GET", "workspaces/:id/vars/:var", s.withVariable(s.readVariable))
+	s.handle("PATCH", "workspaces/:id/vars/:var", s.withVariable(s.updateVariable))
+	s.handle("DELETE", "workspaces/:id/vars/:var", s.withVariable(s.deleteVariable))
+}
+
+// variableHandlerFunc handles a request for a single existing variable.
+type variableHandlerFunc func(w http.ResponseWriter, r *http.Request, v *tfe.Variable)
+
+// withVariable looks up the variable identified by the id and var
+// parameters.
+func (s *Server) withVariable(h variableHandlerFunc) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		for _, v := range s.variables {
+			if v.ID == params["var"] && v.Workspace.ID == params["id"] {
+				h(w, r, v)
+				return
+			}
+		}
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *Server) listVariables(w http.ResponseWriter, r *http.Request, ws *tfe.Workspace) {
+	items := []*tfe.Variable{}
+	for _, v := range s.variables {
+		if v.Workspace.ID == ws.ID {
+			items = append(items, redactVariable(v))
+		}
+	}
+
+	writeList(w, r, items)
+}
+
+func (s *Server) createVariable(w http.ResponseWriter, r *http.Request, ws *tfe.Workspace) {
+	v := &tfe.Variable{}
+	if _, err := decode(r, v); err != nil {
+		writeError(w, http.StatusBadRequest, "malformed request", err.Error())
+		return
+	}
+
+	if !s.validVariable(w, ws.ID, v) {
+		return
+	}
+
+	v.ID = s.newID("var")
+	v.Workspace = &tfe.Workspace{ID: ws.ID}
+
+	s.variables = append(s.variables, v)
+
+	write(w, http.StatusCreated, redactVariable(v))
+}
+
+func (s *Server) readVariable(w http.ResponseWriter, r *http.Request, v *tfe.Variable) {
+	write(w, http.StatusOK, redactVariable(v))
+}
+
+func (s *Server) updateVariable(w http.ResponseWriter, r *http.Request, v *tfe.Variable) {
+	updated := *v
+	if _, err := decode(r, &updated); err != nil {
+		writeError(w, http.StatusBadRequest, "malformed request", err.Error())
+		return
+	}
+
+	if !s.validVariable(w, v.Workspace.ID, &updated) {
+		return
+	}
+
+	// Sensitive variables can not be made non-sensitive again.
+	if v.Sensitive && !updated.Sensitive {
+		writeError(w, http.StatusUnprocessableEntity, "invalid attribute", "Sensitive can not be unset")
+		return
+	}
+
+	*v = updated
+
+	write(w, http.StatusOK, redactVariable(v))
+}
+
+func (s *Server) deleteVariable(w http.ResponseWriter, r *http.Request, v *tfe.Variable) {
+	for i, other := range s.variables {
+		if other == v {
+			s.variables = append(s.variables[:i], s.variables[i+1:]...)
+			break
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validVariable writes a validation error and returns false if the variable
+// is invalid or its key is already used in the workspace.
+func (s *Server) validVariable(w http.ResponseWriter, workspaceID string, v *tfe.Variable) bool {
+	if v.Key == "" {
+		writeError(w, http.StatusUnprocessableEntity, "invalid attribute", "Key can't be blank")
+		return false
+	}
+	if v.Category != tfe.CategoryTerraform && v.Category != tfe.CategoryEnv {
+		writeError(w, http.StatusUnprocessableEntity, "invalid attribute", "Category is not included in the list")
+		return false
+	}
+	for _, other := range s.variables {
+		if other.Workspace.ID == workspaceID && other.ID != v.ID &&
+			other.Key == v.Key && other.Category == v.Category {
+			writeError(w, http.StatusUnprocessableEntity, "invalid attribute", "Key has already been taken")
+			return false
+		}
+	}
+	return true
+}
+
+// redactVariable returns a copy of the variable that hides the value of
+// sensitive variables.
+func redactVariable(v *tfe.Variable) *tfe.Variable {
+	if !v.Sensitive {
+		return v
+	}
+	redacted := *v
+	redacted.Value = ""
+	return &redacted
+}
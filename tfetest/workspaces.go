@@ -0,0 +1,240 @@
+package tfetest
+
+import (
+	"net/http"
+	"regexp"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// reWorkspaceName matches valid workspace names.
+var reWorkspaceName = regexp.MustCompile(`^[a-zA-Z0-9\-_]+$`)
+
+func (s *Server) registerWorkspaceRoutes() {
+	s.handle("GET", "organizations/:org/workspaces", s.listWorkspaces)
+	s.handle("POST", "organizations/:org/workspaces", s.createWorkspace)
+	s.handle("GET", "organizations/:org/workspaces/:name", s.withWorkspaceByName(s.readWorkspace))
+	s.handle("PATCH", "organizations/:org/workspaces/:name", s.withWorkspaceByName(s.updateWorkspace))
+	s.handle("DELETE", "organizations/:org/workspaces/:name", s.withWorkspaceByName(s.deleteWorkspace))
+	s.handle("GET", "workspaces/:id", s.withWorkspace(s.readWorkspace))
+	s.handle("PATCH", "workspaces/:id", s.withWorkspace(s.updateWorkspace))
+	s.handle("DELETE", "workspaces/:id", s.withWorkspace(s.deleteWorkspace))
+	s.handle("POST", "workspaces/:id/actions/lock", s.withWorkspace(s.lockWorkspace))
+	s.handle("POST", "workspaces/:id/actions/unlock", s.withWorkspace(s.unlockWorkspace))
+	s.handle("POST", "workspaces/:id/actions/force-unlock", s.withWorkspace(s.unlockWorkspace))
+}
+
+// workspaceHandlerFunc handles a request for a single existing workspace.
+type workspaceHandlerFunc func(w http.ResponseWriter, r *http.Request, ws *tfe.Workspace)
+
+// withWorkspace looks up the workspace identified by the id parameter.
+func (s *Server) withWorkspace(h workspaceHandlerFunc) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		ws := s.workspaceByID(params["id"])
+		if ws == nil {
+			writeError(w, http.StatusNotFound, "not found")
+			return
+		}
+		h(w, r, ws)
+	}
+}
+
+// withWorkspaceByName looks up the workspace identified by the org and name
+// parameters.
+func (s *Server) withWorkspaceByName(h workspaceHandlerFunc) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		ws := s.workspaceByName(params["org"], params["name"])
+		if ws == nil {
+			writeError(w, http.StatusNotFound, "not found")
+			return
+		}
+		h(w, r, ws)
+	}
+}
+
+func (s *Server) workspaceByID(id string) *tfe.Workspace {
+	for _, ws := range s.workspaces {
+		if ws.ID == id {
+			return ws
+		}
+	}
+	return nil
+}
+
+func (s *Server) workspaceByName(organization, name string) *tfe.Workspace {
+	for _, ws := range s.workspaces {
+		if ws.Organization.Name == organization && ws.Name == name {
+			return ws
+		}
+	}
+	return nil
+}
+
+func (s *Server) listWorkspaces(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	search := r.URL.Query().Get("search[name]")
+
+	items := []*tfe.Workspace{}
+	for _, ws := range s.workspaces {
+		if ws.Organization.Name != params["org"] {
+			continue
+		}
+		if search != "" && !containsFold(ws.Name, search) {
+			continue
+		}
+		items = append(items, ws)
+	}
+
+	writeList(w, r, items)
+}
+
+func (s *Server) createWorkspace(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	ws := &tfe.Workspace{
+		FileTriggersEnabled: true,
+		Operations:          true,
+		TerraformVersion:    "0.12.24",
+	}
+	if _, err := decode(r, ws); err != nil {
+		writeError(w, http.StatusBadRequest, "malformed request", err.Error())
+		return
+	}
+
+	if !s.validWorkspaceName(w, params["org"], ws.Name, "") {
+		return
+	}
+
+	ws.ID = s.newID("ws")
+	ws.CreatedAt = time.Now().UTC().Truncate(time.Second)
+	ws.Organization = &tfe.Organization{Name: params["org"]}
+	ws.Actions = &tfe.WorkspaceActions{IsDestroyable: true}
+	ws.Permissions = &tfe.WorkspacePermissions{
+		CanDestroy:        true,
+		CanForceUnlock:    true,
+		CanLock:           true,
+		CanQueueApply:     true,
+		CanQueueDestroy:   true,
+		CanQueueRun:       true,
+		CanReadSettings:   true,
+		CanUnlock:         true,
+		CanUpdate:         true,
+		CanUpdateVariable: true,
+	}
+
+	s.workspaces = append(s.workspaces, ws)
+
+	write(w, http.StatusCreated, ws)
+}
+
+func (s *Server) readWorkspace(w http.ResponseWriter, r *http.Request, ws *tfe.Workspace) {
+	write(w, http.StatusOK, ws)
+}
+
+func (s *Server) updateWorkspace(w http.ResponseWriter, r *http.Request, ws *tfe.Workspace) {
+	updated := *ws
+
+	attrs, err := decode(r, &updated)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "malformed request", err.Error())
+		return
+	}
+
+	// An explicit null removes the VCS connection.
+	if v, ok := attrs["vcs-repo"]; ok && v == nil {
+		updated.VCSRepo = nil
+	}
+
+	if !s.validWorkspaceName(w, ws.Organization.Name, updated.Name, ws.ID) {
+		return
+	}
+
+	*ws = updated
+
+	write(w, http.StatusOK, ws)
+}
+
+func (s *Server) deleteWorkspace(w http.ResponseWriter, r *http.Request, ws *tfe.Workspace) {
+	for i, v := range s.workspaces {
+		if v == ws {
+			s.workspaces = append(s.workspaces[:i], s.workspaces[i+1:]...)
+			break
+		}
+	}
+
+	// Remove all resources that belong to the workspace.
+	s.deleteWorkspaceResources(ws.ID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) lockWorkspace(w http.ResponseWriter, r *http.Request, ws *tfe.Workspace) {
+	if ws.Locked {
+		writeError(w, http.StatusConflict, "conflict", "Unable to lock workspace. The workspace is already locked.")
+		return
+	}
+	ws.Locked = true
+
+	write(w, http.StatusOK, ws)
+}
+
+func (s *Server) unlockWorkspace(w http.ResponseWriter, r *http.Request, ws *tfe.Workspace) {
+	if !ws.Locked {
+		writeError(w, http.StatusConflict, "conflict", "Unable to unlock workspace. The workspace is already unlocked.")
+		return
+	}
+	ws.Locked = false
+
+	write(w, http.StatusOK, ws)
+}
+
+// validWorkspaceName writes a validation error and returns false if the name
+// is invalid or already used by another workspace in the organization.
+func (s *Server) validWorkspaceName(w http.ResponseWriter, organization, name, id string) bool {
+	if name == "" {
+		writeError(w, http.StatusUnprocessableEntity, "invalid attribute", "Name can't be blank")
+		return false
+	}
+	if !reWorkspaceName.MatchString(name) {
+		writeError(w, http.StatusUnprocessableEntity, "invalid attribute", "Name is invalid")
+		return false
+	}
+	if other := s.workspaceByName(organization, name); other != nil && other.ID != id {
+		writeError(w, http.StatusUnprocessableEntity, "invalid attribute", "Name has already been taken")
+		return false
+	}
+	return true
+}
+
+// deleteWorkspaceResources removes all resources scoped to a workspace.
+func (s *Server) deleteWorkspaceResources(workspaceID string) {
+	var runs []*tfe.Run
+	for _, r := range s.runs {
+		if r.Workspace.ID != workspaceID {
+			runs = append(runs, r)
+		}
+	}
+	s.runs = runs
+
+	var cvs []*configurationVersion
+	for _, cv := range s.configurationVersions {
+		if cv.workspaceID != workspaceID {
+			cvs = append(cvs, cv)
+		}
+	}
+	s.configurationVersions = cvs
+
+	var svs []*stateVersion
+	for _, sv := range s.stateVersions {
+		if sv.workspaceID != workspaceID {
+			svs = append(svs, sv)
+		}
+	}
+	s.stateVersions = svs
+
+	var vars []*tfe.Variable
+	for _, v := range s.variables {
+		if v.Workspace.ID != workspaceID {
+			vars = append(vars, v)
+		}
+	}
+	s.variables = vars
+}
@@ -0,0 +1,132 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// TokenSource provides the API token used to authenticate requests. The
+// client asks for the token before every request, so a TokenSource can
+// rotate tokens without recreating the client. When a request is rejected
+// with a 401 response, the client asks for the token once more and retries
+// the request if a different token is returned. Implementations must be
+// safe for concurrent use.
+type TokenSource interface {
+	// Token returns the current API token.
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenSourceFunc is an adapter to allow the use of an ordinary function as
+// a TokenSource.
+type TokenSourceFunc func(ctx context.Context) (string, error)
+
+// Token implements TokenSource.
+func (f TokenSourceFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// StaticTokenSource returns a TokenSource that always returns the same
+// token.
+func StaticTokenSource(token string) TokenSource {
+	return staticTokenSource(token)
+}
+
+type staticTokenSource string
+
+func (s staticTokenSource) Token(ctx context.Context) (string, error) {
+	if s == "" {
+		return "", errors.New("missing API token")
+	}
+	return string(s), nil
+}
+
+// EnvTokenSource returns a TokenSource that reads the token from the named
+// environment variable on every request.
+func EnvTokenSource(name string) TokenSource {
+	return TokenSourceFunc(func(ctx context.Context) (string, error) {
+		token := os.Getenv(name)
+		if token == "" {
+			return "", fmt.Errorf("missing API token in environment variable %s", name)
+		}
+		return token, nil
+	})
+}
+
+// FileTokenSource returns a TokenSource that reads the token from a file,
+// like a mounted secret. The file is read again whenever its modification
+// time or size changes. Leading and trailing whitespace is ignored.
+func FileTokenSource(path string) TokenSource {
+	return &fileTokenSource{path: path}
+}
+
+type fileTokenSource struct {
+	path string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+func (s *fileTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return "", fmt.Errorf("error reading API token: %v", err)
+	}
+
+	if s.token != "" && info.ModTime().Equal(s.modTime) && info.Size() == s.size {
+		return s.token, nil
+	}
+
+	content, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return "", fmt.Errorf("error reading API token: %v", err)
+	}
+
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("missing API token in %s", s.path)
+	}
+
+	s.token, s.modTime, s.size = token, info.ModTime(), info.Size()
+
+	return s.token, nil
+}
+
+// sendAuthorized sends the request using the current token. When the request
+// is rejected with a 401 response and the token source now returns a
+// different token, the request is sent once more using the new token.
+func (c *Client) sendAuthorized(ctx context.Context, op Operation, req *retryablehttp.Request) (*http.Response, error) {
+	token, err := c.tokenSource.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := c.sendRequest(ctx, op, req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	refreshed, err := c.tokenSource.Token(ctx)
+	if err != nil || refreshed == token {
+		return resp, nil
+	}
+	resp.Body.Close()
+
+	req.Header.Set("Authorization", "Bearer "+refreshed)
+
+	return c.sendRequest(ctx, op, req)
+}
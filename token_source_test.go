@@ -0,0 +1,213 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaticTokenSource(t *testing.T) {
+	token, err := StaticTokenSource("abcd1234").Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "abcd1234", token)
+
+	_, err = StaticTokenSource("").Token(context.Background())
+	assert.EqualError(t, err, "missing API token")
+}
+
+func TestEnvTokenSource(t *testing.T) {
+	defer os.Unsetenv("TFE_TEST_TOKEN")
+	source := EnvTokenSource("TFE_TEST_TOKEN")
+	ctx := context.Background()
+
+	_, err := source.Token(ctx)
+	assert.EqualError(t, err, "missing API token in environment variable TFE_TEST_TOKEN")
+
+	os.Setenv("TFE_TEST_TOKEN", "first")
+	token, err := source.Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "first", token)
+
+	os.Setenv("TFE_TEST_TOKEN", "second")
+	token, err = source.Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "second", token)
+}
+
+func TestFileTokenSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go-tfe")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "token")
+	source := FileTokenSource(path)
+	ctx := context.Background()
+
+	t.Run("when the file does not exist", func(t *testing.T) {
+		_, err := source.Token(ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("when the file exists", func(t *testing.T) {
+		require.NoError(t, ioutil.WriteFile(path, []byte("first-token\n"), 0600))
+
+		token, err := source.Token(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "first-token", token)
+	})
+
+	t.Run("when the file changes", func(t *testing.T) {
+		require.NoError(t, ioutil.WriteFile(path, []byte("second-token\n"), 0600))
+		modTime := time.Now().Add(time.Minute)
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+
+		token, err := source.Token(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "second-token", token)
+	})
+
+	t.Run("when the file is empty", func(t *testing.T) {
+		require.NoError(t, ioutil.WriteFile(path, []byte("  \n"), 0600))
+
+		_, err := source.Token(ctx)
+		assert.EqualError(t, err, "missing API token in "+path)
+	})
+}
+
+func TestClient_tokenSource(t *testing.T) {
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	var valid string
+	var received []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == DefaultBasePath+PingEndpoint {
+			w.WriteHeader(204)
+			return
+		}
+
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		body, _ := ioutil.ReadAll(r.Body)
+
+		mu.Lock()
+		received = append(received, token+" "+string(body))
+		ok := token == valid
+		mu.Unlock()
+
+		if !ok {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		testWorkspaceHandler(w, r)
+	}
+	reset := func(token string) {
+		mu.Lock()
+		defer mu.Unlock()
+		valid = token
+		received = nil
+	}
+
+	t.Run("uses the current token for every request", func(t *testing.T) {
+		var current atomic.Value
+		current.Store("first")
+		source := TokenSourceFunc(func(ctx context.Context) (string, error) {
+			return current.Load().(string), nil
+		})
+
+		client, done := testTokenSourceClient(t, source, handler)
+		defer done()
+
+		reset("first")
+		_, err := client.Workspaces.ReadByID(ctx, "ws-123")
+		require.NoError(t, err)
+
+		reset("second")
+		current.Store("second")
+		_, err = client.Workspaces.ReadByID(ctx, "ws-123")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"second "}, received)
+	})
+
+	t.Run("re-fetches the token once after a 401", func(t *testing.T) {
+		// The token is rotated as soon as the expired token is rejected.
+		var rotated int32
+		source := TokenSourceFunc(func(ctx context.Context) (string, error) {
+			if atomic.LoadInt32(&rotated) == 0 {
+				return "expired", nil
+			}
+			return "rotated", nil
+		})
+
+		client, done := testTokenSourceClient(t, source, func(w http.ResponseWriter, r *http.Request) {
+			handler(w, r)
+			if r.URL.Path != DefaultBasePath+PingEndpoint && r.Header.Get("Authorization") == "Bearer expired" {
+				atomic.StoreInt32(&rotated, 1)
+			}
+		})
+		defer done()
+
+		reset("rotated")
+		_, err := client.Workspaces.Update(ctx, "my-org", "my-workspace", WorkspaceUpdateOptions{
+			Name: String("renamed"),
+		})
+		require.NoError(t, err)
+
+		require.Len(t, received, 2)
+		assert.True(t, strings.HasPrefix(received[0], "expired {"))
+		assert.True(t, strings.HasPrefix(received[1], "rotated {"))
+		assert.Equal(t, strings.TrimPrefix(received[0], "expired"), strings.TrimPrefix(received[1], "rotated"))
+	})
+
+	t.Run("fails when the token did not change", func(t *testing.T) {
+		client, done := testTokenSourceClient(t, StaticTokenSource("expired"), handler)
+		defer done()
+
+		reset("rotated")
+		_, err := client.Workspaces.ReadByID(ctx, "ws-123")
+		assert.True(t, errors.Is(err, ErrUnauthorized))
+		assert.Len(t, received, 1)
+	})
+
+	t.Run("fails when the token source fails", func(t *testing.T) {
+		source := TokenSourceFunc(func(ctx context.Context) (string, error) {
+			return "", errors.New("vault is sealed")
+		})
+
+		client, done := testTokenSourceClient(t, source, handler)
+		defer done()
+
+		reset("rotated")
+		_, err := client.Workspaces.ReadByID(ctx, "ws-123")
+		assert.EqualError(t, err, "vault is sealed")
+		assert.Empty(t, received)
+	})
+}
+
+func testTokenSourceClient(t *testing.T, source TokenSource, h http.HandlerFunc) (*Client, func()) {
+	ts := httptest.NewServer(h)
+
+	client, err := NewClient(&Config{
+		Address:     ts.URL,
+		TokenSource: source,
+		HTTPClient:  ts.Client(),
+		LazyPing:    true,
+	})
+	if err != nil {
+		ts.Close()
+		t.Fatal(err)
+	}
+
+	return client, ts.Close
+}
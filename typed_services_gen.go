@@ -0,0 +1,999 @@
+// Code generated by resourceidgen. DO NOT EDIT.
+
+package tfe
+
+import (
+	"context"
+	"io"
+)
+
+// TypedServices holds the services of a client whose methods take resource
+// IDs, wrapped to take typed IDs instead of strings.
+type TypedServices struct {
+	Applies                    TypedApplies
+	ConfigurationVersions      TypedConfigurationVersions
+	CostEstimates              TypedCostEstimates
+	NotificationConfigurations TypedNotificationConfigurations
+	OAuthClients               TypedOAuthClients
+	OAuthTokens                TypedOAuthTokens
+	OrganizationMemberships    TypedOrganizationMemberships
+	Plans                      TypedPlans
+	PlanExports                TypedPlanExports
+	Policies                   TypedPolicies
+	PolicyChecks               TypedPolicyChecks
+	PolicySetParameters        TypedPolicySetParameters
+	PolicySets                 TypedPolicySets
+	Runs                       TypedRuns
+	RunTriggers                TypedRunTriggers
+	SSHKeys                    TypedSSHKeys
+	StateVersions              TypedStateVersions
+	Teams                      TypedTeams
+	TeamAccess                 TypedTeamAccesses
+	TeamMembers                TypedTeamMembers
+	TeamTokens                 TypedTeamTokens
+	Variables                  TypedVariables
+	Workspaces                 TypedWorkspaces
+}
+
+// Typed returns the services of the client taking typed IDs, so passing
+// the ID of one resource where the ID of another one is expected does not
+// compile:
+//
+//	runID, err := tfe.ParseRunID(input)
+//	if err != nil {
+//		return err
+//	}
+//	r, err := client.Typed().Runs.Read(ctx, runID)
+//
+// The typed IDs are validated, including their prefix, before making a
+// request.
+func (c *Client) Typed() *TypedServices {
+	return &TypedServices{
+		Applies:                    TypedApplies{c.Applies},
+		ConfigurationVersions:      TypedConfigurationVersions{c.ConfigurationVersions},
+		CostEstimates:              TypedCostEstimates{c.CostEstimates},
+		NotificationConfigurations: TypedNotificationConfigurations{c.NotificationConfigurations},
+		OAuthClients:               TypedOAuthClients{c.OAuthClients},
+		OAuthTokens:                TypedOAuthTokens{c.OAuthTokens},
+		OrganizationMemberships:    TypedOrganizationMemberships{c.OrganizationMemberships},
+		Plans:                      TypedPlans{c.Plans},
+		PlanExports:                TypedPlanExports{c.PlanExports},
+		Policies:                   TypedPolicies{c.Policies},
+		PolicyChecks:               TypedPolicyChecks{c.PolicyChecks},
+		PolicySetParameters:        TypedPolicySetParameters{c.PolicySetParameters},
+		PolicySets:                 TypedPolicySets{c.PolicySets},
+		Runs:                       TypedRuns{c.Runs},
+		RunTriggers:                TypedRunTriggers{c.RunTriggers},
+		SSHKeys:                    TypedSSHKeys{c.SSHKeys},
+		StateVersions:              TypedStateVersions{c.StateVersions},
+		Teams:                      TypedTeams{c.Teams},
+		TeamAccess:                 TypedTeamAccesses{c.TeamAccess},
+		TeamMembers:                TypedTeamMembers{c.TeamMembers},
+		TeamTokens:                 TypedTeamTokens{c.TeamTokens},
+		Variables:                  TypedVariables{c.Variables},
+		Workspaces:                 TypedWorkspaces{c.Workspaces},
+	}
+}
+
+// TypedApplies wraps Applies to take typed IDs.
+// Methods without IDs are promoted from Applies.
+type TypedApplies struct {
+	Applies
+}
+
+// Read validates the IDs and calls Applies.Read.
+func (s TypedApplies) Read(ctx context.Context, applyID ApplyID) (*Apply, error) {
+	if err := applyID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Applies.Read(ctx, applyID.String())
+}
+
+// Logs validates the IDs and calls Applies.Logs.
+func (s TypedApplies) Logs(ctx context.Context, applyID ApplyID) (io.Reader, error) {
+	if err := applyID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Applies.Logs(ctx, applyID.String())
+}
+
+// TypedConfigurationVersions wraps ConfigurationVersions to take typed IDs.
+// Methods without IDs are promoted from ConfigurationVersions.
+type TypedConfigurationVersions struct {
+	ConfigurationVersions
+}
+
+// List validates the IDs and calls ConfigurationVersions.List.
+func (s TypedConfigurationVersions) List(ctx context.Context, workspaceID WorkspaceID, options ConfigurationVersionListOptions) (*ConfigurationVersionList, error) {
+	if err := workspaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.ConfigurationVersions.List(ctx, workspaceID.String(), options)
+}
+
+// Create validates the IDs and calls ConfigurationVersions.Create.
+func (s TypedConfigurationVersions) Create(ctx context.Context, workspaceID WorkspaceID, options ConfigurationVersionCreateOptions) (*ConfigurationVersion, error) {
+	if err := workspaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.ConfigurationVersions.Create(ctx, workspaceID.String(), options)
+}
+
+// Read validates the IDs and calls ConfigurationVersions.Read.
+func (s TypedConfigurationVersions) Read(ctx context.Context, cvID ConfigurationVersionID) (*ConfigurationVersion, error) {
+	if err := cvID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.ConfigurationVersions.Read(ctx, cvID.String())
+}
+
+// ReadWithOptions validates the IDs and calls ConfigurationVersions.ReadWithOptions.
+func (s TypedConfigurationVersions) ReadWithOptions(ctx context.Context, cvID ConfigurationVersionID, options ConfigurationVersionReadOptions) (*ConfigurationVersion, error) {
+	if err := cvID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.ConfigurationVersions.ReadWithOptions(ctx, cvID.String(), options)
+}
+
+// TypedCostEstimates wraps CostEstimates to take typed IDs.
+// Methods without IDs are promoted from CostEstimates.
+type TypedCostEstimates struct {
+	CostEstimates
+}
+
+// Read validates the IDs and calls CostEstimates.Read.
+func (s TypedCostEstimates) Read(ctx context.Context, costEstimateID CostEstimateID) (*CostEstimate, error) {
+	if err := costEstimateID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.CostEstimates.Read(ctx, costEstimateID.String())
+}
+
+// Logs validates the IDs and calls CostEstimates.Logs.
+func (s TypedCostEstimates) Logs(ctx context.Context, costEstimateID CostEstimateID) (io.Reader, error) {
+	if err := costEstimateID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.CostEstimates.Logs(ctx, costEstimateID.String())
+}
+
+// TypedNotificationConfigurations wraps NotificationConfigurations to take typed IDs.
+// Methods without IDs are promoted from NotificationConfigurations.
+type TypedNotificationConfigurations struct {
+	NotificationConfigurations
+}
+
+// List validates the IDs and calls NotificationConfigurations.List.
+func (s TypedNotificationConfigurations) List(ctx context.Context, workspaceID WorkspaceID, options NotificationConfigurationListOptions) (*NotificationConfigurationList, error) {
+	if err := workspaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.NotificationConfigurations.List(ctx, workspaceID.String(), options)
+}
+
+// Create validates the IDs and calls NotificationConfigurations.Create.
+func (s TypedNotificationConfigurations) Create(ctx context.Context, workspaceID WorkspaceID, options NotificationConfigurationCreateOptions) (*NotificationConfiguration, error) {
+	if err := workspaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.NotificationConfigurations.Create(ctx, workspaceID.String(), options)
+}
+
+// Read validates the IDs and calls NotificationConfigurations.Read.
+func (s TypedNotificationConfigurations) Read(ctx context.Context, notificationConfigurationID NotificationConfigurationID) (*NotificationConfiguration, error) {
+	if err := notificationConfigurationID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.NotificationConfigurations.Read(ctx, notificationConfigurationID.String())
+}
+
+// Update validates the IDs and calls NotificationConfigurations.Update.
+func (s TypedNotificationConfigurations) Update(ctx context.Context, notificationConfigurationID NotificationConfigurationID, options NotificationConfigurationUpdateOptions) (*NotificationConfiguration, error) {
+	if err := notificationConfigurationID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.NotificationConfigurations.Update(ctx, notificationConfigurationID.String(), options)
+}
+
+// Delete validates the IDs and calls NotificationConfigurations.Delete.
+func (s TypedNotificationConfigurations) Delete(ctx context.Context, notificationConfigurationID NotificationConfigurationID) error {
+	if err := notificationConfigurationID.Validate(); err != nil {
+		return err
+	}
+	return s.NotificationConfigurations.Delete(ctx, notificationConfigurationID.String())
+}
+
+// Verify validates the IDs and calls NotificationConfigurations.Verify.
+func (s TypedNotificationConfigurations) Verify(ctx context.Context, notificationConfigurationID NotificationConfigurationID) (*NotificationConfiguration, error) {
+	if err := notificationConfigurationID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.NotificationConfigurations.Verify(ctx, notificationConfigurationID.String())
+}
+
+// TypedOAuthClients wraps OAuthClients to take typed IDs.
+// Methods without IDs are promoted from OAuthClients.
+type TypedOAuthClients struct {
+	OAuthClients
+}
+
+// Read validates the IDs and calls OAuthClients.Read.
+func (s TypedOAuthClients) Read(ctx context.Context, oAuthClientID OAuthClientID) (*OAuthClient, error) {
+	if err := oAuthClientID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.OAuthClients.Read(ctx, oAuthClientID.String())
+}
+
+// Delete validates the IDs and calls OAuthClients.Delete.
+func (s TypedOAuthClients) Delete(ctx context.Context, oAuthClientID OAuthClientID) error {
+	if err := oAuthClientID.Validate(); err != nil {
+		return err
+	}
+	return s.OAuthClients.Delete(ctx, oAuthClientID.String())
+}
+
+// TypedOAuthTokens wraps OAuthTokens to take typed IDs.
+// Methods without IDs are promoted from OAuthTokens.
+type TypedOAuthTokens struct {
+	OAuthTokens
+}
+
+// Read validates the IDs and calls OAuthTokens.Read.
+func (s TypedOAuthTokens) Read(ctx context.Context, oAuthTokenID OAuthTokenID) (*OAuthToken, error) {
+	if err := oAuthTokenID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.OAuthTokens.Read(ctx, oAuthTokenID.String())
+}
+
+// Update validates the IDs and calls OAuthTokens.Update.
+func (s TypedOAuthTokens) Update(ctx context.Context, oAuthTokenID OAuthTokenID, options OAuthTokenUpdateOptions) (*OAuthToken, error) {
+	if err := oAuthTokenID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.OAuthTokens.Update(ctx, oAuthTokenID.String(), options)
+}
+
+// Delete validates the IDs and calls OAuthTokens.Delete.
+func (s TypedOAuthTokens) Delete(ctx context.Context, oAuthTokenID OAuthTokenID) error {
+	if err := oAuthTokenID.Validate(); err != nil {
+		return err
+	}
+	return s.OAuthTokens.Delete(ctx, oAuthTokenID.String())
+}
+
+// TypedOrganizationMemberships wraps OrganizationMemberships to take typed IDs.
+// Methods without IDs are promoted from OrganizationMemberships.
+type TypedOrganizationMemberships struct {
+	OrganizationMemberships
+}
+
+// Read validates the IDs and calls OrganizationMemberships.Read.
+func (s TypedOrganizationMemberships) Read(ctx context.Context, organizationMembershipID OrganizationMembershipID) (*OrganizationMembership, error) {
+	if err := organizationMembershipID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.OrganizationMemberships.Read(ctx, organizationMembershipID.String())
+}
+
+// ReadWithOptions validates the IDs and calls OrganizationMemberships.ReadWithOptions.
+func (s TypedOrganizationMemberships) ReadWithOptions(ctx context.Context, organizationMembershipID OrganizationMembershipID, options OrganizationMembershipReadOptions) (*OrganizationMembership, error) {
+	if err := organizationMembershipID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.OrganizationMemberships.ReadWithOptions(ctx, organizationMembershipID.String(), options)
+}
+
+// Delete validates the IDs and calls OrganizationMemberships.Delete.
+func (s TypedOrganizationMemberships) Delete(ctx context.Context, organizationMembershipID OrganizationMembershipID) error {
+	if err := organizationMembershipID.Validate(); err != nil {
+		return err
+	}
+	return s.OrganizationMemberships.Delete(ctx, organizationMembershipID.String())
+}
+
+// TypedPlans wraps Plans to take typed IDs.
+// Methods without IDs are promoted from Plans.
+type TypedPlans struct {
+	Plans
+}
+
+// Read validates the IDs and calls Plans.Read.
+func (s TypedPlans) Read(ctx context.Context, planID PlanID) (*Plan, error) {
+	if err := planID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Plans.Read(ctx, planID.String())
+}
+
+// Logs validates the IDs and calls Plans.Logs.
+func (s TypedPlans) Logs(ctx context.Context, planID PlanID) (io.Reader, error) {
+	if err := planID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Plans.Logs(ctx, planID.String())
+}
+
+// TypedPlanExports wraps PlanExports to take typed IDs.
+// Methods without IDs are promoted from PlanExports.
+type TypedPlanExports struct {
+	PlanExports
+}
+
+// Read validates the IDs and calls PlanExports.Read.
+func (s TypedPlanExports) Read(ctx context.Context, planExportID PlanExportID) (*PlanExport, error) {
+	if err := planExportID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.PlanExports.Read(ctx, planExportID.String())
+}
+
+// Delete validates the IDs and calls PlanExports.Delete.
+func (s TypedPlanExports) Delete(ctx context.Context, planExportID PlanExportID) error {
+	if err := planExportID.Validate(); err != nil {
+		return err
+	}
+	return s.PlanExports.Delete(ctx, planExportID.String())
+}
+
+// Download validates the IDs and calls PlanExports.Download.
+func (s TypedPlanExports) Download(ctx context.Context, planExportID PlanExportID) ([]byte, error) {
+	if err := planExportID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.PlanExports.Download(ctx, planExportID.String())
+}
+
+// TypedPolicies wraps Policies to take typed IDs.
+// Methods without IDs are promoted from Policies.
+type TypedPolicies struct {
+	Policies
+}
+
+// Read validates the IDs and calls Policies.Read.
+func (s TypedPolicies) Read(ctx context.Context, policyID PolicyID) (*Policy, error) {
+	if err := policyID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Policies.Read(ctx, policyID.String())
+}
+
+// Update validates the IDs and calls Policies.Update.
+func (s TypedPolicies) Update(ctx context.Context, policyID PolicyID, options PolicyUpdateOptions) (*Policy, error) {
+	if err := policyID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Policies.Update(ctx, policyID.String(), options)
+}
+
+// Delete validates the IDs and calls Policies.Delete.
+func (s TypedPolicies) Delete(ctx context.Context, policyID PolicyID) error {
+	if err := policyID.Validate(); err != nil {
+		return err
+	}
+	return s.Policies.Delete(ctx, policyID.String())
+}
+
+// Upload validates the IDs and calls Policies.Upload.
+func (s TypedPolicies) Upload(ctx context.Context, policyID PolicyID, content []byte) error {
+	if err := policyID.Validate(); err != nil {
+		return err
+	}
+	return s.Policies.Upload(ctx, policyID.String(), content)
+}
+
+// Download validates the IDs and calls Policies.Download.
+func (s TypedPolicies) Download(ctx context.Context, policyID PolicyID) ([]byte, error) {
+	if err := policyID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Policies.Download(ctx, policyID.String())
+}
+
+// TypedPolicyChecks wraps PolicyChecks to take typed IDs.
+// Methods without IDs are promoted from PolicyChecks.
+type TypedPolicyChecks struct {
+	PolicyChecks
+}
+
+// List validates the IDs and calls PolicyChecks.List.
+func (s TypedPolicyChecks) List(ctx context.Context, runID RunID, options PolicyCheckListOptions) (*PolicyCheckList, error) {
+	if err := runID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.PolicyChecks.List(ctx, runID.String(), options)
+}
+
+// Read validates the IDs and calls PolicyChecks.Read.
+func (s TypedPolicyChecks) Read(ctx context.Context, policyCheckID PolicyCheckID) (*PolicyCheck, error) {
+	if err := policyCheckID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.PolicyChecks.Read(ctx, policyCheckID.String())
+}
+
+// Override validates the IDs and calls PolicyChecks.Override.
+func (s TypedPolicyChecks) Override(ctx context.Context, policyCheckID PolicyCheckID) (*PolicyCheck, error) {
+	if err := policyCheckID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.PolicyChecks.Override(ctx, policyCheckID.String())
+}
+
+// Logs validates the IDs and calls PolicyChecks.Logs.
+func (s TypedPolicyChecks) Logs(ctx context.Context, policyCheckID PolicyCheckID) (io.Reader, error) {
+	if err := policyCheckID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.PolicyChecks.Logs(ctx, policyCheckID.String())
+}
+
+// TypedPolicySetParameters wraps PolicySetParameters to take typed IDs.
+// Methods without IDs are promoted from PolicySetParameters.
+type TypedPolicySetParameters struct {
+	PolicySetParameters
+}
+
+// List validates the IDs and calls PolicySetParameters.List.
+func (s TypedPolicySetParameters) List(ctx context.Context, policySetID PolicySetID, options PolicySetParameterListOptions) (*PolicySetParameterList, error) {
+	if err := policySetID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.PolicySetParameters.List(ctx, policySetID.String(), options)
+}
+
+// Create validates the IDs and calls PolicySetParameters.Create.
+func (s TypedPolicySetParameters) Create(ctx context.Context, policySetID PolicySetID, options PolicySetParameterCreateOptions) (*PolicySetParameter, error) {
+	if err := policySetID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.PolicySetParameters.Create(ctx, policySetID.String(), options)
+}
+
+// Read validates the IDs and calls PolicySetParameters.Read.
+func (s TypedPolicySetParameters) Read(ctx context.Context, policySetID PolicySetID, parameterID string) (*PolicySetParameter, error) {
+	if err := policySetID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.PolicySetParameters.Read(ctx, policySetID.String(), parameterID)
+}
+
+// Update validates the IDs and calls PolicySetParameters.Update.
+func (s TypedPolicySetParameters) Update(ctx context.Context, policySetID PolicySetID, parameterID string, options PolicySetParameterUpdateOptions) (*PolicySetParameter, error) {
+	if err := policySetID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.PolicySetParameters.Update(ctx, policySetID.String(), parameterID, options)
+}
+
+// Delete validates the IDs and calls PolicySetParameters.Delete.
+func (s TypedPolicySetParameters) Delete(ctx context.Context, policySetID PolicySetID, parameterID string) error {
+	if err := policySetID.Validate(); err != nil {
+		return err
+	}
+	return s.PolicySetParameters.Delete(ctx, policySetID.String(), parameterID)
+}
+
+// TypedPolicySets wraps PolicySets to take typed IDs.
+// Methods without IDs are promoted from PolicySets.
+type TypedPolicySets struct {
+	PolicySets
+}
+
+// Read validates the IDs and calls PolicySets.Read.
+func (s TypedPolicySets) Read(ctx context.Context, policySetID PolicySetID) (*PolicySet, error) {
+	if err := policySetID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.PolicySets.Read(ctx, policySetID.String())
+}
+
+// ReadWithOptions validates the IDs and calls PolicySets.ReadWithOptions.
+func (s TypedPolicySets) ReadWithOptions(ctx context.Context, policySetID PolicySetID, options PolicySetReadOptions) (*PolicySet, error) {
+	if err := policySetID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.PolicySets.ReadWithOptions(ctx, policySetID.String(), options)
+}
+
+// Update validates the IDs and calls PolicySets.Update.
+func (s TypedPolicySets) Update(ctx context.Context, policySetID PolicySetID, options PolicySetUpdateOptions) (*PolicySet, error) {
+	if err := policySetID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.PolicySets.Update(ctx, policySetID.String(), options)
+}
+
+// AddPolicies validates the IDs and calls PolicySets.AddPolicies.
+func (s TypedPolicySets) AddPolicies(ctx context.Context, policySetID PolicySetID, options PolicySetAddPoliciesOptions) error {
+	if err := policySetID.Validate(); err != nil {
+		return err
+	}
+	return s.PolicySets.AddPolicies(ctx, policySetID.String(), options)
+}
+
+// RemovePolicies validates the IDs and calls PolicySets.RemovePolicies.
+func (s TypedPolicySets) RemovePolicies(ctx context.Context, policySetID PolicySetID, options PolicySetRemovePoliciesOptions) error {
+	if err := policySetID.Validate(); err != nil {
+		return err
+	}
+	return s.PolicySets.RemovePolicies(ctx, policySetID.String(), options)
+}
+
+// AddWorkspaces validates the IDs and calls PolicySets.AddWorkspaces.
+func (s TypedPolicySets) AddWorkspaces(ctx context.Context, policySetID PolicySetID, options PolicySetAddWorkspacesOptions) error {
+	if err := policySetID.Validate(); err != nil {
+		return err
+	}
+	return s.PolicySets.AddWorkspaces(ctx, policySetID.String(), options)
+}
+
+// RemoveWorkspaces validates the IDs and calls PolicySets.RemoveWorkspaces.
+func (s TypedPolicySets) RemoveWorkspaces(ctx context.Context, policySetID PolicySetID, options PolicySetRemoveWorkspacesOptions) error {
+	if err := policySetID.Validate(); err != nil {
+		return err
+	}
+	return s.PolicySets.RemoveWorkspaces(ctx, policySetID.String(), options)
+}
+
+// Delete validates the IDs and calls PolicySets.Delete.
+func (s TypedPolicySets) Delete(ctx context.Context, policyID PolicyID) error {
+	if err := policyID.Validate(); err != nil {
+		return err
+	}
+	return s.PolicySets.Delete(ctx, policyID.String())
+}
+
+// TypedRuns wraps Runs to take typed IDs.
+// Methods without IDs are promoted from Runs.
+type TypedRuns struct {
+	Runs
+}
+
+// List validates the IDs and calls Runs.List.
+func (s TypedRuns) List(ctx context.Context, workspaceID WorkspaceID, options RunListOptions) (*RunList, error) {
+	if err := workspaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Runs.List(ctx, workspaceID.String(), options)
+}
+
+// Read validates the IDs and calls Runs.Read.
+func (s TypedRuns) Read(ctx context.Context, runID RunID) (*Run, error) {
+	if err := runID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Runs.Read(ctx, runID.String())
+}
+
+// ReadWithOptions validates the IDs and calls Runs.ReadWithOptions.
+func (s TypedRuns) ReadWithOptions(ctx context.Context, runID RunID, options RunReadOptions) (*Run, error) {
+	if err := runID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Runs.ReadWithOptions(ctx, runID.String(), options)
+}
+
+// Apply validates the IDs and calls Runs.Apply.
+func (s TypedRuns) Apply(ctx context.Context, runID RunID, options RunApplyOptions) error {
+	if err := runID.Validate(); err != nil {
+		return err
+	}
+	return s.Runs.Apply(ctx, runID.String(), options)
+}
+
+// Cancel validates the IDs and calls Runs.Cancel.
+func (s TypedRuns) Cancel(ctx context.Context, runID RunID, options RunCancelOptions) error {
+	if err := runID.Validate(); err != nil {
+		return err
+	}
+	return s.Runs.Cancel(ctx, runID.String(), options)
+}
+
+// ForceCancel validates the IDs and calls Runs.ForceCancel.
+func (s TypedRuns) ForceCancel(ctx context.Context, runID RunID, options RunForceCancelOptions) error {
+	if err := runID.Validate(); err != nil {
+		return err
+	}
+	return s.Runs.ForceCancel(ctx, runID.String(), options)
+}
+
+// Discard validates the IDs and calls Runs.Discard.
+func (s TypedRuns) Discard(ctx context.Context, runID RunID, options RunDiscardOptions) error {
+	if err := runID.Validate(); err != nil {
+		return err
+	}
+	return s.Runs.Discard(ctx, runID.String(), options)
+}
+
+// TypedRunTriggers wraps RunTriggers to take typed IDs.
+// Methods without IDs are promoted from RunTriggers.
+type TypedRunTriggers struct {
+	RunTriggers
+}
+
+// List validates the IDs and calls RunTriggers.List.
+func (s TypedRunTriggers) List(ctx context.Context, workspaceID WorkspaceID, options RunTriggerListOptions) (*RunTriggerList, error) {
+	if err := workspaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.RunTriggers.List(ctx, workspaceID.String(), options)
+}
+
+// Create validates the IDs and calls RunTriggers.Create.
+func (s TypedRunTriggers) Create(ctx context.Context, workspaceID WorkspaceID, options RunTriggerCreateOptions) (*RunTrigger, error) {
+	if err := workspaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.RunTriggers.Create(ctx, workspaceID.String(), options)
+}
+
+// Read validates the IDs and calls RunTriggers.Read.
+func (s TypedRunTriggers) Read(ctx context.Context, runTriggerID RunTriggerID) (*RunTrigger, error) {
+	if err := runTriggerID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.RunTriggers.Read(ctx, runTriggerID.String())
+}
+
+// Delete validates the IDs and calls RunTriggers.Delete.
+func (s TypedRunTriggers) Delete(ctx context.Context, runTriggerID RunTriggerID) error {
+	if err := runTriggerID.Validate(); err != nil {
+		return err
+	}
+	return s.RunTriggers.Delete(ctx, runTriggerID.String())
+}
+
+// TypedSSHKeys wraps SSHKeys to take typed IDs.
+// Methods without IDs are promoted from SSHKeys.
+type TypedSSHKeys struct {
+	SSHKeys
+}
+
+// Read validates the IDs and calls SSHKeys.Read.
+func (s TypedSSHKeys) Read(ctx context.Context, sshKeyID SSHKeyID) (*SSHKey, error) {
+	if err := sshKeyID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.SSHKeys.Read(ctx, sshKeyID.String())
+}
+
+// Update validates the IDs and calls SSHKeys.Update.
+func (s TypedSSHKeys) Update(ctx context.Context, sshKeyID SSHKeyID, options SSHKeyUpdateOptions) (*SSHKey, error) {
+	if err := sshKeyID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.SSHKeys.Update(ctx, sshKeyID.String(), options)
+}
+
+// Delete validates the IDs and calls SSHKeys.Delete.
+func (s TypedSSHKeys) Delete(ctx context.Context, sshKeyID SSHKeyID) error {
+	if err := sshKeyID.Validate(); err != nil {
+		return err
+	}
+	return s.SSHKeys.Delete(ctx, sshKeyID.String())
+}
+
+// TypedStateVersions wraps StateVersions to take typed IDs.
+// Methods without IDs are promoted from StateVersions.
+type TypedStateVersions struct {
+	StateVersions
+}
+
+// Create validates the IDs and calls StateVersions.Create.
+func (s TypedStateVersions) Create(ctx context.Context, workspaceID WorkspaceID, options StateVersionCreateOptions) (*StateVersion, error) {
+	if err := workspaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.StateVersions.Create(ctx, workspaceID.String(), options)
+}
+
+// Read validates the IDs and calls StateVersions.Read.
+func (s TypedStateVersions) Read(ctx context.Context, svID StateVersionID) (*StateVersion, error) {
+	if err := svID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.StateVersions.Read(ctx, svID.String())
+}
+
+// ReadWithOptions validates the IDs and calls StateVersions.ReadWithOptions.
+func (s TypedStateVersions) ReadWithOptions(ctx context.Context, svID StateVersionID, options StateVersionReadOptions) (*StateVersion, error) {
+	if err := svID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.StateVersions.ReadWithOptions(ctx, svID.String(), options)
+}
+
+// Current validates the IDs and calls StateVersions.Current.
+func (s TypedStateVersions) Current(ctx context.Context, workspaceID WorkspaceID) (*StateVersion, error) {
+	if err := workspaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.StateVersions.Current(ctx, workspaceID.String())
+}
+
+// CurrentWithOptions validates the IDs and calls StateVersions.CurrentWithOptions.
+func (s TypedStateVersions) CurrentWithOptions(ctx context.Context, workspaceID WorkspaceID, options StateVersionReadOptions) (*StateVersion, error) {
+	if err := workspaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.StateVersions.CurrentWithOptions(ctx, workspaceID.String(), options)
+}
+
+// TypedTeams wraps Teams to take typed IDs.
+// Methods without IDs are promoted from Teams.
+type TypedTeams struct {
+	Teams
+}
+
+// Read validates the IDs and calls Teams.Read.
+func (s TypedTeams) Read(ctx context.Context, teamID TeamID) (*Team, error) {
+	if err := teamID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Teams.Read(ctx, teamID.String())
+}
+
+// Update validates the IDs and calls Teams.Update.
+func (s TypedTeams) Update(ctx context.Context, teamID TeamID, options TeamUpdateOptions) (*Team, error) {
+	if err := teamID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Teams.Update(ctx, teamID.String(), options)
+}
+
+// Delete validates the IDs and calls Teams.Delete.
+func (s TypedTeams) Delete(ctx context.Context, teamID TeamID) error {
+	if err := teamID.Validate(); err != nil {
+		return err
+	}
+	return s.Teams.Delete(ctx, teamID.String())
+}
+
+// TypedTeamAccesses wraps TeamAccesses to take typed IDs.
+// Methods without IDs are promoted from TeamAccesses.
+type TypedTeamAccesses struct {
+	TeamAccesses
+}
+
+// Read validates the IDs and calls TeamAccesses.Read.
+func (s TypedTeamAccesses) Read(ctx context.Context, teamAccessID TeamAccessID) (*TeamAccess, error) {
+	if err := teamAccessID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.TeamAccesses.Read(ctx, teamAccessID.String())
+}
+
+// Update validates the IDs and calls TeamAccesses.Update.
+func (s TypedTeamAccesses) Update(ctx context.Context, teamAccessID TeamAccessID, options TeamAccessUpdateOptions) (*TeamAccess, error) {
+	if err := teamAccessID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.TeamAccesses.Update(ctx, teamAccessID.String(), options)
+}
+
+// Remove validates the IDs and calls TeamAccesses.Remove.
+func (s TypedTeamAccesses) Remove(ctx context.Context, teamAccessID TeamAccessID) error {
+	if err := teamAccessID.Validate(); err != nil {
+		return err
+	}
+	return s.TeamAccesses.Remove(ctx, teamAccessID.String())
+}
+
+// TypedTeamMembers wraps TeamMembers to take typed IDs.
+// Methods without IDs are promoted from TeamMembers.
+type TypedTeamMembers struct {
+	TeamMembers
+}
+
+// List validates the IDs and calls TeamMembers.List.
+func (s TypedTeamMembers) List(ctx context.Context, teamID TeamID) ([]*User, error) {
+	if err := teamID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.TeamMembers.List(ctx, teamID.String())
+}
+
+// ListUsers validates the IDs and calls TeamMembers.ListUsers.
+func (s TypedTeamMembers) ListUsers(ctx context.Context, teamID TeamID) ([]*User, error) {
+	if err := teamID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.TeamMembers.ListUsers(ctx, teamID.String())
+}
+
+// ListOrganizationMemberships validates the IDs and calls TeamMembers.ListOrganizationMemberships.
+func (s TypedTeamMembers) ListOrganizationMemberships(ctx context.Context, teamID TeamID) ([]*OrganizationMembership, error) {
+	if err := teamID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.TeamMembers.ListOrganizationMemberships(ctx, teamID.String())
+}
+
+// Add validates the IDs and calls TeamMembers.Add.
+func (s TypedTeamMembers) Add(ctx context.Context, teamID TeamID, options TeamMemberAddOptions) error {
+	if err := teamID.Validate(); err != nil {
+		return err
+	}
+	return s.TeamMembers.Add(ctx, teamID.String(), options)
+}
+
+// Remove validates the IDs and calls TeamMembers.Remove.
+func (s TypedTeamMembers) Remove(ctx context.Context, teamID TeamID, options TeamMemberRemoveOptions) error {
+	if err := teamID.Validate(); err != nil {
+		return err
+	}
+	return s.TeamMembers.Remove(ctx, teamID.String(), options)
+}
+
+// TypedTeamTokens wraps TeamTokens to take typed IDs.
+// Methods without IDs are promoted from TeamTokens.
+type TypedTeamTokens struct {
+	TeamTokens
+}
+
+// Generate validates the IDs and calls TeamTokens.Generate.
+func (s TypedTeamTokens) Generate(ctx context.Context, teamID TeamID) (*TeamToken, error) {
+	if err := teamID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.TeamTokens.Generate(ctx, teamID.String())
+}
+
+// Read validates the IDs and calls TeamTokens.Read.
+func (s TypedTeamTokens) Read(ctx context.Context, teamID TeamID) (*TeamToken, error) {
+	if err := teamID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.TeamTokens.Read(ctx, teamID.String())
+}
+
+// Delete validates the IDs and calls TeamTokens.Delete.
+func (s TypedTeamTokens) Delete(ctx context.Context, teamID TeamID) error {
+	if err := teamID.Validate(); err != nil {
+		return err
+	}
+	return s.TeamTokens.Delete(ctx, teamID.String())
+}
+
+// TypedVariables wraps Variables to take typed IDs.
+// Methods without IDs are promoted from Variables.
+type TypedVariables struct {
+	Variables
+}
+
+// List validates the IDs and calls Variables.List.
+func (s TypedVariables) List(ctx context.Context, workspaceID WorkspaceID, options VariableListOptions) (*VariableList, error) {
+	if err := workspaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Variables.List(ctx, workspaceID.String(), options)
+}
+
+// Create validates the IDs and calls Variables.Create.
+func (s TypedVariables) Create(ctx context.Context, workspaceID WorkspaceID, options VariableCreateOptions) (*Variable, error) {
+	if err := workspaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Variables.Create(ctx, workspaceID.String(), options)
+}
+
+// Read validates the IDs and calls Variables.Read.
+func (s TypedVariables) Read(ctx context.Context, workspaceID WorkspaceID, variableID VariableID) (*Variable, error) {
+	if err := workspaceID.Validate(); err != nil {
+		return nil, err
+	}
+	if err := variableID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Variables.Read(ctx, workspaceID.String(), variableID.String())
+}
+
+// Update validates the IDs and calls Variables.Update.
+func (s TypedVariables) Update(ctx context.Context, workspaceID WorkspaceID, variableID VariableID, options VariableUpdateOptions) (*Variable, error) {
+	if err := workspaceID.Validate(); err != nil {
+		return nil, err
+	}
+	if err := variableID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Variables.Update(ctx, workspaceID.String(), variableID.String(), options)
+}
+
+// Delete validates the IDs and calls Variables.Delete.
+func (s TypedVariables) Delete(ctx context.Context, workspaceID WorkspaceID, variableID VariableID) error {
+	if err := workspaceID.Validate(); err != nil {
+		return err
+	}
+	if err := variableID.Validate(); err != nil {
+		return err
+	}
+	return s.Variables.Delete(ctx, workspaceID.String(), variableID.String())
+}
+
+// TypedWorkspaces wraps Workspaces to take typed IDs.
+// Methods without IDs are promoted from Workspaces.
+type TypedWorkspaces struct {
+	Workspaces
+}
+
+// ReadByID validates the IDs and calls Workspaces.ReadByID.
+func (s TypedWorkspaces) ReadByID(ctx context.Context, workspaceID WorkspaceID) (*Workspace, error) {
+	if err := workspaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Workspaces.ReadByID(ctx, workspaceID.String())
+}
+
+// ReadByIDWithOptions validates the IDs and calls Workspaces.ReadByIDWithOptions.
+func (s TypedWorkspaces) ReadByIDWithOptions(ctx context.Context, workspaceID WorkspaceID, options WorkspaceReadOptions) (*Workspace, error) {
+	if err := workspaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Workspaces.ReadByIDWithOptions(ctx, workspaceID.String(), options)
+}
+
+// UpdateByID validates the IDs and calls Workspaces.UpdateByID.
+func (s TypedWorkspaces) UpdateByID(ctx context.Context, workspaceID WorkspaceID, options WorkspaceUpdateOptions) (*Workspace, error) {
+	if err := workspaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Workspaces.UpdateByID(ctx, workspaceID.String(), options)
+}
+
+// DeleteByID validates the IDs and calls Workspaces.DeleteByID.
+func (s TypedWorkspaces) DeleteByID(ctx context.Context, workspaceID WorkspaceID) error {
+	if err := workspaceID.Validate(); err != nil {
+		return err
+	}
+	return s.Workspaces.DeleteByID(ctx, workspaceID.String())
+}
+
+// RemoveVCSConnectionByID validates the IDs and calls Workspaces.RemoveVCSConnectionByID.
+func (s TypedWorkspaces) RemoveVCSConnectionByID(ctx context.Context, workspaceID WorkspaceID) (*Workspace, error) {
+	if err := workspaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Workspaces.RemoveVCSConnectionByID(ctx, workspaceID.String())
+}
+
+// Lock validates the IDs and calls Workspaces.Lock.
+func (s TypedWorkspaces) Lock(ctx context.Context, workspaceID WorkspaceID, options WorkspaceLockOptions) (*Workspace, error) {
+	if err := workspaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Workspaces.Lock(ctx, workspaceID.String(), options)
+}
+
+// Unlock validates the IDs and calls Workspaces.Unlock.
+func (s TypedWorkspaces) Unlock(ctx context.Context, workspaceID WorkspaceID) (*Workspace, error) {
+	if err := workspaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Workspaces.Unlock(ctx, workspaceID.String())
+}
+
+// ForceUnlock validates the IDs and calls Workspaces.ForceUnlock.
+func (s TypedWorkspaces) ForceUnlock(ctx context.Context, workspaceID WorkspaceID) (*Workspace, error) {
+	if err := workspaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Workspaces.ForceUnlock(ctx, workspaceID.String())
+}
+
+// AssignSSHKey validates the IDs and calls Workspaces.AssignSSHKey.
+func (s TypedWorkspaces) AssignSSHKey(ctx context.Context, workspaceID WorkspaceID, options WorkspaceAssignSSHKeyOptions) (*Workspace, error) {
+	if err := workspaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Workspaces.AssignSSHKey(ctx, workspaceID.String(), options)
+}
+
+// UnassignSSHKey validates the IDs and calls Workspaces.UnassignSSHKey.
+func (s TypedWorkspaces) UnassignSSHKey(ctx context.Context, workspaceID WorkspaceID) (*Workspace, error) {
+	if err := workspaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Workspaces.UnassignSSHKey(ctx, workspaceID.String())
+}
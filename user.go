@@ -47,7 +47,7 @@ type TwoFactor struct {
 
 // ReadCurrent reads the details of the currently authenticated user.
 func (s *users) ReadCurrent(ctx context.Context) (*User, error) {
-	req, err := s.client.newRequest("GET", "account/details", nil)
+	req, err := s.client.newRequest("Users.ReadCurrent", "GET", "account/details", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -73,12 +73,24 @@ type UserUpdateOptions struct {
 	Email *string `jsonapi:"attr,email,omitempty"`
 }
 
+// Validate checks that the username and email are not empty when set.
+func (o UserUpdateOptions) Validate() error {
+	var v validator
+	v.check(o.Username == nil || *o.Username != "", "username", "invalid value for username")
+	v.check(o.Email == nil || *o.Email != "", "email", "invalid value for email")
+	return v.err()
+}
+
 // Update attributes of the currently authenticated user.
 func (s *users) Update(ctx context.Context, options UserUpdateOptions) (*User, error) {
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Make sure we don't send a user provided ID.
 	options.ID = ""
 
-	req, err := s.client.newRequest("PATCH", "account/update", &options)
+	req, err := s.client.newRequest("Users.Update", "PATCH", "account/update", &options)
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"regexp"
+	"strings"
 )
 
 // A regular expression used to validate common string ID patterns.
@@ -17,3 +18,91 @@ func validString(v *string) bool {
 func validStringID(v *string) bool {
 	return v != nil && reStringID.MatchString(*v)
 }
+
+// ValidationError is returned when options are invalid, before any request
+// is made. The create and update options have a Validate method, which the
+// services call before making a request and which can be called directly to
+// check options up front. Validate returns a *ValidationError listing every
+// invalid field, or nil if the options are valid. The ValidationError can be
+// retrieved from the error returned by a service using errors.As:
+//
+//	var verr *tfe.ValidationError
+//	if errors.As(err, &verr) {
+//		for _, f := range verr.Fields {
+//			fmt.Printf("%s: %s\n", f.Attribute, f.Reason)
+//		}
+//	}
+type ValidationError struct {
+	// The invalid fields, in the order they were validated.
+	Fields []*FieldError
+}
+
+// FieldError describes a single invalid field.
+type FieldError struct {
+	// The JSON:API name of the attribute or relationship, like "name" or
+	// "destination-type". Nested fields are separated by a dot, like
+	// "vcs-repo.identifier".
+	Attribute string
+
+	// Why the field is invalid, like "name is required".
+	Reason string
+}
+
+// Error implements the error interface.
+func (e *FieldError) Error() string {
+	return e.Reason
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	reasons := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		reasons[i] = f.Reason
+	}
+	return strings.Join(reasons, "; ")
+}
+
+// Field returns the error for the given attribute, or nil if it is valid.
+func (e *ValidationError) Field(attribute string) *FieldError {
+	for _, f := range e.Fields {
+		if f.Attribute == attribute {
+			return f
+		}
+	}
+	return nil
+}
+
+// validator collects the invalid fields of options.
+type validator struct {
+	fields []*FieldError
+}
+
+// check records the attribute as invalid for the given reason unless ok
+// is true. Only the first failed check of every attribute is recorded, so
+// checks depending on an earlier one, like a format check following a
+// presence check, can be made unconditionally.
+func (v *validator) check(ok bool, attribute, reason string) {
+	if ok || v.failed(attribute) {
+		return
+	}
+	v.fields = append(v.fields, &FieldError{Attribute: attribute, Reason: reason})
+}
+
+// failed reports whether a check of the attribute failed.
+func (v *validator) failed(attribute string) bool {
+	for _, f := range v.fields {
+		if f.Attribute == attribute {
+			return true
+		}
+	}
+	return false
+}
+
+// err returns a *ValidationError listing the invalid fields, or nil if all
+// fields are valid.
+func (v *validator) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return &ValidationError{Fields: v.fields}
+}
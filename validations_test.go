@@ -0,0 +1,71 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidationError(t *testing.T) {
+	t.Run("listing every invalid field", func(t *testing.T) {
+		err := NotificationConfigurationCreateOptions{
+			DestinationType: NotificationDestination(NotificationDestinationTypeSlack),
+		}.Validate()
+
+		var verr *ValidationError
+		require.True(t, errors.As(err, &verr))
+		assert.Equal(t, []*FieldError{
+			{Attribute: "enabled", Reason: "enabled is required"},
+			{Attribute: "name", Reason: "name is required"},
+			{Attribute: "url", Reason: "url is required"},
+		}, verr.Fields)
+		assert.EqualError(t, err, "enabled is required; name is required; url is required")
+		assert.Equal(t, "name is required", verr.Field("name").Reason)
+		assert.Nil(t, verr.Field("destination-type"))
+	})
+
+	t.Run("reporting only the first error of a field", func(t *testing.T) {
+		err := OrganizationCreateOptions{}.Validate()
+		assert.EqualError(t, err, "name is required; email is required")
+
+		err = OrganizationCreateOptions{Name: String(badIdentifier), Email: String("a@b.c")}.Validate()
+		assert.EqualError(t, err, "invalid value for name")
+	})
+
+	t.Run("with nested fields", func(t *testing.T) {
+		err := RegistryModuleCreateWithVCSConnectionOptions{
+			VCSRepo: &RegistryModuleVCSRepoOptions{Identifier: String("org/repo")},
+		}.Validate()
+
+		var verr *ValidationError
+		require.True(t, errors.As(err, &verr))
+		require.Len(t, verr.Fields, 2)
+		assert.Equal(t, "vcs-repo.oauth-token-id", verr.Fields[0].Attribute)
+		assert.Equal(t, "vcs-repo.display-identifier", verr.Fields[1].Attribute)
+	})
+
+	t.Run("with valid options", func(t *testing.T) {
+		assert.NoError(t, WorkspaceCreateOptions{Name: String("my-workspace")}.Validate())
+		assert.NoError(t, WorkspaceUpdateOptions{}.Validate())
+		assert.NoError(t, VariableUpdateOptions{Key: String("key")}.Validate())
+	})
+
+	t.Run("before making requests", func(t *testing.T) {
+		client, done := testMiddlewareClient(t, func(w http.ResponseWriter, r *http.Request) {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL)
+		})
+		defer done()
+
+		_, err := client.Workspaces.UpdateByID(context.Background(), "ws-123", WorkspaceUpdateOptions{
+			Name: String(badIdentifier),
+		})
+
+		var verr *ValidationError
+		require.True(t, errors.As(err, &verr))
+		assert.Equal(t, "name", verr.Fields[0].Attribute)
+	})
+}
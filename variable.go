@@ -78,7 +78,7 @@ func (s *variables) List(ctx context.Context, workspaceID string, options Variab
 	}
 
 	u := fmt.Sprintf("workspaces/%s/vars", workspaceID)
-	req, err := s.client.newRequest("GET", u, &options)
+	req, err := s.client.newRequest("Variables.List", "GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -116,14 +116,12 @@ type VariableCreateOptions struct {
 	Sensitive *bool `jsonapi:"attr,sensitive,omitempty"`
 }
 
-func (o VariableCreateOptions) valid() error {
-	if !validString(o.Key) {
-		return errors.New("key is required")
-	}
-	if o.Category == nil {
-		return errors.New("category is required")
-	}
-	return nil
+// Validate checks that the key and category are set.
+func (o VariableCreateOptions) Validate() error {
+	var v validator
+	v.check(validString(o.Key), "key", "key is required")
+	v.check(o.Category != nil, "category", "category is required")
+	return v.err()
 }
 
 // Create is used to create a new variable.
@@ -131,7 +129,7 @@ func (s *variables) Create(ctx context.Context, workspaceID string, options Vari
 	if !validStringID(&workspaceID) {
 		return nil, errors.New("invalid value for workspace ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -139,7 +137,7 @@ func (s *variables) Create(ctx context.Context, workspaceID string, options Vari
 	options.ID = ""
 
 	u := fmt.Sprintf("workspaces/%s/vars", url.QueryEscape(workspaceID))
-	req, err := s.client.newRequest("POST", u, &options)
+	req, err := s.client.newRequest("Variables.Create", "POST", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -163,7 +161,7 @@ func (s *variables) Read(ctx context.Context, workspaceID string, variableID str
 	}
 
 	u := fmt.Sprintf("workspaces/%s/vars/%s", url.QueryEscape(workspaceID), url.QueryEscape(variableID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("Variables.Read", "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -198,6 +196,13 @@ type VariableUpdateOptions struct {
 	Sensitive *bool `jsonapi:"attr,sensitive,omitempty"`
 }
 
+// Validate checks that the key is not empty when set.
+func (o VariableUpdateOptions) Validate() error {
+	var v validator
+	v.check(o.Key == nil || *o.Key != "", "key", "invalid value for key")
+	return v.err()
+}
+
 // Update values of an existing variable.
 func (s *variables) Update(ctx context.Context, workspaceID string, variableID string, options VariableUpdateOptions) (*Variable, error) {
 	if !validStringID(&workspaceID) {
@@ -207,11 +212,15 @@ func (s *variables) Update(ctx context.Context, workspaceID string, variableID s
 		return nil, errors.New("invalid value for variable ID")
 	}
 
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Make sure we don't send a user provided ID.
 	options.ID = variableID
 
 	u := fmt.Sprintf("workspaces/%s/vars/%s", url.QueryEscape(workspaceID), url.QueryEscape(variableID))
-	req, err := s.client.newRequest("PATCH", u, &options)
+	req, err := s.client.newRequest("Variables.Update", "PATCH", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -235,7 +244,7 @@ func (s *variables) Delete(ctx context.Context, workspaceID string, variableID s
 	}
 
 	u := fmt.Sprintf("workspaces/%s/vars/%s", url.QueryEscape(workspaceID), url.QueryEscape(variableID))
-	req, err := s.client.newRequest("DELETE", u, nil)
+	req, err := s.client.newRequest("Variables.Delete", "DELETE", u, nil)
 	if err != nil {
 		return err
 	}
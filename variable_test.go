@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -209,7 +210,7 @@ func TestVariablesRead(t *testing.T) {
 	t.Run("when the variable does not exist", func(t *testing.T) {
 		v, err := client.Variables.Read(ctx, vTest.Workspace.ID, "nonexisting")
 		assert.Nil(t, v)
-		assert.Equal(t, ErrResourceNotFound, err)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("without a valid workspace ID", func(t *testing.T) {
@@ -309,7 +310,7 @@ func TestVariablesDelete(t *testing.T) {
 
 	t.Run("with non existing variable ID", func(t *testing.T) {
 		err := client.Variables.Delete(ctx, wTest.ID, "nonexisting")
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("with invalid workspace ID", func(t *testing.T) {
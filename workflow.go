@@ -0,0 +1,415 @@
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ErrPolicySoftFailed is returned by Client.RunWorkflow when policy checks
+// of the run soft failed and they were not overridden.
+var ErrPolicySoftFailed = errors.New("policy checks soft failed")
+
+// WorkflowOptions represents the options for running a workflow.
+type WorkflowOptions struct {
+	// The message to be associated with the run.
+	Message *string
+
+	// Create a destroy plan, which destroys all provisioned resources.
+	IsDestroy bool
+
+	// If non-empty, only plan the given resource addresses and the resources
+	// they depend on.
+	TargetAddrs []string
+
+	// Apply the run once it is planned. Without it, the workflow stops when
+	// the run waits for confirmation, unless the workspace applies runs
+	// automatically.
+	AutoApply bool
+
+	// An optional comment used when applying the run.
+	ApplyComment *string
+
+	// Override soft failed policy checks, which requires permission to do so.
+	// Without it, the workflow stops when the run waits for a policy
+	// override and returns ErrPolicySoftFailed, leaving the run waiting.
+	OverridePolicies bool
+
+	// LogWriter receives the plan and apply logs while they are streamed.
+	LogWriter io.Writer
+
+	// The intervals used to wait for the upload and the run. The included
+	// resources are set by the workflow.
+	Watch RunWatcherOptions
+}
+
+// WorkflowResult is the outcome of a workflow.
+type WorkflowResult struct {
+	// The configuration version created for the directory.
+	ConfigurationVersion *ConfigurationVersion
+
+	// The run as last read, including its plan, apply and cost estimate.
+	Run *Run
+
+	// The logs of the plan and the apply. The apply logs are empty when the
+	// run was not applied.
+	PlanLogs  string
+	ApplyLogs string
+
+	// The resource changes planned and applied by the run.
+	PlannedChanges ResourceCounts
+	AppliedChanges ResourceCounts
+
+	// The cost estimate of the run, if cost estimation is enabled.
+	CostEstimate *CostEstimate
+
+	// The policy checks of the run, with their results.
+	PolicyChecks []*PolicyCheck
+}
+
+// ResourceCounts holds the number of resources added, changed and destroyed
+// by a plan or an apply.
+type ResourceCounts struct {
+	Additions    int
+	Changes      int
+	Destructions int
+}
+
+// RunWorkflow uploads the Terraform configuration in the directory at path
+// to the workspace and runs it, the same way running terraform apply with
+// the remote backend does:
+//
+//	result, err := client.RunWorkflow(ctx, "ws-123", "./infra", tfe.WorkflowOptions{
+//		Message:   tfe.String("Deploy from CI"),
+//		AutoApply: true,
+//		LogWriter: os.Stdout,
+//	})
+//
+// The workflow creates a configuration version, uploads the directory and
+// waits until the upload is processed. It then creates a run, streams its
+// logs and waits until the run is finished or needs confirmation, which is
+// given when AutoApply is set. Once the run stops, the result is returned.
+// A result is also returned along with most errors, describing how far the
+// workflow got. When the run finishes without being applied or planned
+// successfully, the error wraps ErrRunFinished.
+func (c *Client) RunWorkflow(ctx context.Context, workspaceID, path string, options WorkflowOptions) (*WorkflowResult, error) {
+	if !validStringID(&workspaceID) {
+		return nil, errors.New("invalid value for workspace ID")
+	}
+
+	ws, err := c.Workspaces.ReadByID(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	options.Watch.Include = []RunIncludeOpt{RunIncludePlan, RunIncludeApply, RunIncludeCostEstimate}
+	w := &workflow{
+		client:  c,
+		options: options,
+		watcher: NewRunWatcher(c.Runs, options.Watch),
+		result:  &WorkflowResult{},
+	}
+
+	cv, err := w.upload(ctx, workspaceID, path)
+	if err != nil {
+		return w.result, err
+	}
+
+	r, err := c.Runs.Create(ctx, RunCreateOptions{
+		IsDestroy:            Bool(options.IsDestroy),
+		Message:              options.Message,
+		ConfigurationVersion: cv,
+		Workspace:            ws,
+		TargetAddrs:          options.TargetAddrs,
+	})
+	if err != nil {
+		return w.result, err
+	}
+	w.result.Run = r
+
+	return w.run(ctx, r.ID, ws.AutoApply)
+}
+
+// workflow holds the state of a single call to Client.RunWorkflow.
+type workflow struct {
+	client  *Client
+	options WorkflowOptions
+	watcher *RunWatcher
+	result  *WorkflowResult
+
+	planLogs  *logStream
+	applyLogs *logStream
+}
+
+// upload creates a configuration version, uploads the directory at path and
+// waits until the upload is processed.
+func (w *workflow) upload(ctx context.Context, workspaceID, path string) (*ConfigurationVersion, error) {
+	cv, err := w.client.ConfigurationVersions.Create(ctx, workspaceID, ConfigurationVersionCreateOptions{
+		AutoQueueRuns: Bool(false),
+	})
+	if err != nil {
+		return nil, err
+	}
+	w.result.ConfigurationVersion = cv
+
+	if err := w.client.ConfigurationVersions.Upload(ctx, cv.UploadURL, path); err != nil {
+		return nil, err
+	}
+
+	interval := w.watcher.options.MinInterval
+	for {
+		cv, err = w.client.ConfigurationVersions.Read(ctx, cv.ID)
+		if err != nil {
+			return nil, err
+		}
+		w.result.ConfigurationVersion = cv
+
+		switch cv.Status {
+		case ConfigurationUploaded:
+			return cv, nil
+		case ConfigurationErrored:
+			return nil, fmt.Errorf("configuration version %s errored: %s", cv.ID, cv.ErrorMessage)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		interval = interval * 3 / 2
+		if interval > w.watcher.options.MaxInterval {
+			interval = w.watcher.options.MaxInterval
+		}
+	}
+}
+
+// run waits for the run and handles its policy checks and confirmation
+// until it stops.
+func (w *workflow) run(ctx context.Context, runID string, workspaceAutoApply bool) (*WorkflowResult, error) {
+	// The statuses the workflow acts on. Runs waiting for a policy override
+	// also report a confirmable status, but can only be applied once the
+	// override is done. Confirmable runs of workspaces applying runs
+	// automatically do not wait for confirmation.
+	overridden := false
+	stop := func(s RunStatus) bool {
+		if s == RunPolicyOverride {
+			return !overridden
+		}
+		return !workspaceAutoApply && s.IsConfirmable()
+	}
+
+	for {
+		r, err := w.wait(ctx, runID, stop)
+		if err != nil {
+			return w.result, err
+		}
+
+		switch {
+		case r.Status == RunPolicyOverride:
+			if !w.options.OverridePolicies {
+				return w.finish(ctx, r, ErrPolicySoftFailed)
+			}
+			if err := w.override(ctx, runID); err != nil {
+				return w.result, err
+			}
+			// The run may still report the status for a while after the
+			// override.
+			overridden = true
+
+		// Runs that can not be applied, like plan-only runs, end with this
+		// status as their policy checks can not be overridden.
+		case r.Status == RunPolicySoftFailed:
+			return w.finish(ctx, r, ErrPolicySoftFailed)
+
+		case r.Status.IsConfirmable():
+			if !w.options.AutoApply {
+				return w.finish(ctx, r, nil)
+			}
+			if err := w.client.Runs.Apply(ctx, runID, RunApplyOptions{Comment: w.options.ApplyComment}); err != nil {
+				return w.result, err
+			}
+			stop = func(RunStatus) bool { return false }
+
+		case r.Status == RunApplied || r.Status == RunPlannedAndFinished:
+			return w.finish(ctx, r, nil)
+
+		default:
+			return w.finish(ctx, r, fmt.Errorf("%w with status %s", ErrRunFinished, r.Status))
+		}
+	}
+}
+
+// errWorkflowStop stops watching the run once it needs to be acted on.
+var errWorkflowStop = errors.New("stop watching")
+
+// wait watches the run until it is final or stop returns true for its
+// status, streaming the logs as the plan and apply start.
+func (w *workflow) wait(ctx context.Context, runID string, stop func(RunStatus) bool) (*Run, error) {
+	r, err := w.watcher.Watch(ctx, runID, func(e RunEvent) error {
+		w.result.Run = e.Run
+		w.streamLogs(ctx, e.Run)
+		if stop(e.To) {
+			return errWorkflowStop
+		}
+		return nil
+	})
+	if err == errWorkflowStop {
+		err = nil
+	}
+	if r != nil {
+		w.result.Run = r
+	}
+	return r, err
+}
+
+// streamLogs starts streaming the logs of the plan and the apply of the run
+// once they started. The apply logs are only streamed after all plan logs
+// were written, so both never interleave.
+func (w *workflow) streamLogs(ctx context.Context, r *Run) {
+	if w.planLogs == nil && r.Plan != nil && planStarted(r.Plan.Status) {
+		planID := r.Plan.ID
+		w.planLogs = newLogStream(w.options.LogWriter, func() (io.Reader, error) {
+			return w.client.Plans.Logs(ctx, planID)
+		})
+	}
+
+	if w.applyLogs == nil && w.planLogs != nil && r.Apply != nil && applyStarted(r.Apply.Status) {
+		w.planLogs.wait()
+		applyID := r.Apply.ID
+		w.applyLogs = newLogStream(w.options.LogWriter, func() (io.Reader, error) {
+			return w.client.Applies.Logs(ctx, applyID)
+		})
+	}
+}
+
+func planStarted(s PlanStatus) bool {
+	switch s {
+	case PlanRunning, PlanFinished, PlanErrored, PlanCanceled:
+		return true
+	default:
+		return false
+	}
+}
+
+func applyStarted(s ApplyStatus) bool {
+	switch s {
+	case ApplyRunning, ApplyFinished, ApplyErrored, ApplyCanceled:
+		return true
+	default:
+		return false
+	}
+}
+
+// override overrides the soft failed policy checks of the run.
+func (w *workflow) override(ctx context.Context, runID string) error {
+	pcl, err := w.client.PolicyChecks.List(ctx, runID, PolicyCheckListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, pc := range pcl.Items {
+		if pc.Status != PolicySoftFailed {
+			continue
+		}
+		if pc.Permissions == nil || !pc.Permissions.CanOverride {
+			return fmt.Errorf("%w: not permitted to override policy check %s", ErrPolicySoftFailed, pc.ID)
+		}
+		if _, err := w.client.PolicyChecks.Override(ctx, pc.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// finish completes the result once the run stopped and returns it along
+// with err.
+func (w *workflow) finish(ctx context.Context, r *Run, err error) (*WorkflowResult, error) {
+	w.streamLogs(ctx, r)
+	w.result.Run = r
+
+	if w.planLogs != nil {
+		logs, logErr := w.planLogs.wait()
+		w.result.PlanLogs = logs
+		if logErr != nil && err == nil {
+			err = logErr
+		}
+	}
+	if w.applyLogs != nil {
+		logs, logErr := w.applyLogs.wait()
+		w.result.ApplyLogs = logs
+		if logErr != nil && err == nil {
+			err = logErr
+		}
+	}
+
+	if r.Plan != nil {
+		w.result.PlannedChanges = ResourceCounts{
+			Additions:    r.Plan.ResourceAdditions,
+			Changes:      r.Plan.ResourceChanges,
+			Destructions: r.Plan.ResourceDestructions,
+		}
+	}
+	if r.Apply != nil {
+		w.result.AppliedChanges = ResourceCounts{
+			Additions:    r.Apply.ResourceAdditions,
+			Changes:      r.Apply.ResourceChanges,
+			Destructions: r.Apply.ResourceDestructions,
+		}
+	}
+	w.result.CostEstimate = r.CostEstimate
+
+	pcl, pcErr := w.client.PolicyChecks.List(ctx, r.ID, PolicyCheckListOptions{})
+	if pcErr != nil {
+		if err == nil {
+			err = pcErr
+		}
+	} else {
+		w.result.PolicyChecks = pcl.Items
+	}
+
+	return w.result, err
+}
+
+// logStream copies logs to a buffer, and to a writer if set, in the
+// background.
+type logStream struct {
+	buf  bytes.Buffer
+	err  error
+	done chan struct{}
+}
+
+func newLogStream(writer io.Writer, open func() (io.Reader, error)) *logStream {
+	s := &logStream{done: make(chan struct{})}
+
+	go func() {
+		defer close(s.done)
+
+		logs, err := open()
+		if err != nil {
+			s.err = err
+			return
+		}
+
+		var dst io.Writer = &s.buf
+		if writer != nil {
+			dst = io.MultiWriter(&s.buf, writer)
+		}
+		_, s.err = io.Copy(dst, logs)
+	}()
+
+	return s
+}
+
+// wait waits until all logs were copied and returns them.
+func (s *logStream) wait() (string, error) {
+	<-s.done
+	return s.buf.String(), s.err
+}
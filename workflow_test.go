@@ -0,0 +1,346 @@
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testWorkflowWorkspaces struct {
+	Workspaces
+	autoApply bool
+}
+
+func (s *testWorkflowWorkspaces) ReadByID(ctx context.Context, workspaceID string) (*Workspace, error) {
+	return &Workspace{ID: workspaceID, AutoApply: s.autoApply}, nil
+}
+
+type testWorkflowConfigurationVersions struct {
+	ConfigurationVersions
+	statuses []ConfigurationStatus
+	reads    int
+	uploaded string
+}
+
+func (s *testWorkflowConfigurationVersions) Create(ctx context.Context, workspaceID string, options ConfigurationVersionCreateOptions) (*ConfigurationVersion, error) {
+	return &ConfigurationVersion{ID: "cv-123", Status: ConfigurationPending, UploadURL: "https://example.com/upload"}, nil
+}
+
+func (s *testWorkflowConfigurationVersions) Upload(ctx context.Context, url, path string) error {
+	s.uploaded = path
+	return nil
+}
+
+func (s *testWorkflowConfigurationVersions) Read(ctx context.Context, cvID string) (*ConfigurationVersion, error) {
+	status := s.statuses[s.reads]
+	s.reads++
+	return &ConfigurationVersion{ID: cvID, Status: status, ErrorMessage: "invalid archive"}, nil
+}
+
+// testWorkflowRuns returns the given statuses, one per read, repeating the
+// last one. The plan and apply statuses follow the status of the run.
+type testWorkflowRuns struct {
+	Runs
+	statuses []RunStatus
+	reads    int
+	created  RunCreateOptions
+	applied  bool
+}
+
+func (s *testWorkflowRuns) Create(ctx context.Context, options RunCreateOptions) (*Run, error) {
+	s.created = options
+	return &Run{ID: "run-123", Status: RunPending}, nil
+}
+
+func (s *testWorkflowRuns) ReadWithOptions(ctx context.Context, runID string, options RunReadOptions) (*Run, error) {
+	i := s.reads
+	if i >= len(s.statuses) {
+		i = len(s.statuses) - 1
+	}
+	s.reads++
+
+	r := &Run{
+		ID:     runID,
+		Status: s.statuses[i],
+		Plan: &Plan{
+			ID:                "plan-123",
+			Status:            PlanFinished,
+			ResourceAdditions: 2,
+			ResourceChanges:   1,
+		},
+		Apply:        &Apply{ID: "apply-123", Status: ApplyPending},
+		CostEstimate: &CostEstimate{ID: "ce-123", DeltaMonthlyCost: "12.5"},
+	}
+	switch r.Status {
+	case RunPlanning:
+		r.Plan.Status = PlanRunning
+	case RunApplying:
+		r.Apply.Status = ApplyRunning
+	case RunApplied:
+		r.Apply.Status = ApplyFinished
+		r.Apply.ResourceAdditions = 2
+		r.Apply.ResourceChanges = 1
+	}
+
+	return r, nil
+}
+
+func (s *testWorkflowRuns) Apply(ctx context.Context, runID string, options RunApplyOptions) error {
+	s.applied = true
+	return nil
+}
+
+type testWorkflowPlans struct {
+	Plans
+}
+
+func (s *testWorkflowPlans) Logs(ctx context.Context, planID string) (io.Reader, error) {
+	return strings.NewReader("plan logs\n"), nil
+}
+
+type testWorkflowApplies struct {
+	Applies
+}
+
+func (s *testWorkflowApplies) Logs(ctx context.Context, applyID string) (io.Reader, error) {
+	return strings.NewReader("apply logs\n"), nil
+}
+
+type testWorkflowPolicyChecks struct {
+	PolicyChecks
+	checks     []*PolicyCheck
+	overridden []string
+}
+
+func (s *testWorkflowPolicyChecks) List(ctx context.Context, runID string, options PolicyCheckListOptions) (*PolicyCheckList, error) {
+	return &PolicyCheckList{Items: s.checks}, nil
+}
+
+func (s *testWorkflowPolicyChecks) Override(ctx context.Context, policyCheckID string) (*PolicyCheck, error) {
+	s.overridden = append(s.overridden, policyCheckID)
+	for _, pc := range s.checks {
+		if pc.ID == policyCheckID {
+			pc.Status = PolicyOverridden
+		}
+	}
+	return &PolicyCheck{ID: policyCheckID, Status: PolicyOverridden}, nil
+}
+
+type testWorkflow struct {
+	client       *Client
+	cvs          *testWorkflowConfigurationVersions
+	runs         *testWorkflowRuns
+	policyChecks *testWorkflowPolicyChecks
+}
+
+func newTestWorkflow(statuses ...RunStatus) *testWorkflow {
+	tw := &testWorkflow{
+		cvs: &testWorkflowConfigurationVersions{
+			statuses: []ConfigurationStatus{ConfigurationPending, ConfigurationUploaded},
+		},
+		runs: &testWorkflowRuns{statuses: statuses},
+		policyChecks: &testWorkflowPolicyChecks{
+			checks: []*PolicyCheck{{ID: "polchk-123", Status: PolicyPasses}},
+		},
+	}
+	tw.client = &Client{
+		Applies:               &testWorkflowApplies{},
+		ConfigurationVersions: tw.cvs,
+		Plans:                 &testWorkflowPlans{},
+		PolicyChecks:          tw.policyChecks,
+		Runs:                  tw.runs,
+		Workspaces:            &testWorkflowWorkspaces{},
+	}
+	return tw
+}
+
+func testWorkflowOptions(options WorkflowOptions) WorkflowOptions {
+	options.Watch = RunWatcherOptions{
+		MinInterval: time.Millisecond,
+		MaxInterval: 2 * time.Millisecond,
+	}
+	return options
+}
+
+func TestRunWorkflow(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("with auto apply", func(t *testing.T) {
+		tw := newTestWorkflow(RunPlanning, RunPlanned, RunApplying, RunApplied)
+
+		var logs bytes.Buffer
+		result, err := tw.client.RunWorkflow(ctx, "ws-123", "./infra", testWorkflowOptions(WorkflowOptions{
+			Message:     String("deploy"),
+			IsDestroy:   true,
+			TargetAddrs: []string{"null_resource.foo"},
+			AutoApply:   true,
+			LogWriter:   &logs,
+		}))
+		require.NoError(t, err)
+
+		assert.Equal(t, "./infra", tw.cvs.uploaded)
+		assert.Equal(t, ConfigurationUploaded, result.ConfigurationVersion.Status)
+		assert.Equal(t, "cv-123", tw.runs.created.ConfigurationVersion.ID)
+		assert.Equal(t, "ws-123", tw.runs.created.Workspace.ID)
+		assert.Equal(t, "deploy", *tw.runs.created.Message)
+		assert.True(t, *tw.runs.created.IsDestroy)
+		assert.Equal(t, []string{"null_resource.foo"}, tw.runs.created.TargetAddrs)
+
+		assert.True(t, tw.runs.applied)
+		assert.Equal(t, RunApplied, result.Run.Status)
+		assert.Equal(t, "plan logs\n", result.PlanLogs)
+		assert.Equal(t, "apply logs\n", result.ApplyLogs)
+		assert.Equal(t, "plan logs\napply logs\n", logs.String())
+		assert.Equal(t, ResourceCounts{Additions: 2, Changes: 1}, result.PlannedChanges)
+		assert.Equal(t, ResourceCounts{Additions: 2, Changes: 1}, result.AppliedChanges)
+		assert.Equal(t, "12.5", result.CostEstimate.DeltaMonthlyCost)
+		require.Len(t, result.PolicyChecks, 1)
+		assert.Equal(t, PolicyPasses, result.PolicyChecks[0].Status)
+	})
+
+	t.Run("without auto apply", func(t *testing.T) {
+		tw := newTestWorkflow(RunPlanning, RunPlanned, RunApplying, RunApplied)
+
+		result, err := tw.client.RunWorkflow(ctx, "ws-123", "./infra", testWorkflowOptions(WorkflowOptions{}))
+		require.NoError(t, err)
+
+		assert.False(t, tw.runs.applied)
+		assert.Equal(t, RunPlanned, result.Run.Status)
+		assert.Equal(t, "plan logs\n", result.PlanLogs)
+		assert.Empty(t, result.ApplyLogs)
+		assert.Equal(t, ResourceCounts{}, result.AppliedChanges)
+	})
+
+	t.Run("with a workspace applying automatically", func(t *testing.T) {
+		tw := newTestWorkflow(RunPlanning, RunPlanned, RunApplying, RunApplied)
+		tw.client.Workspaces = &testWorkflowWorkspaces{autoApply: true}
+
+		result, err := tw.client.RunWorkflow(ctx, "ws-123", "./infra", testWorkflowOptions(WorkflowOptions{}))
+		require.NoError(t, err)
+
+		assert.False(t, tw.runs.applied)
+		assert.Equal(t, RunApplied, result.Run.Status)
+		assert.Equal(t, "apply logs\n", result.ApplyLogs)
+	})
+
+	t.Run("with soft failed policy checks", func(t *testing.T) {
+		tw := newTestWorkflow(RunPlanning, RunPolicyChecking, RunPolicyOverride)
+		tw.policyChecks.checks = []*PolicyCheck{{ID: "polchk-123", Status: PolicySoftFailed}}
+
+		result, err := tw.client.RunWorkflow(ctx, "ws-123", "./infra", testWorkflowOptions(WorkflowOptions{
+			AutoApply: true,
+		}))
+		assert.True(t, errors.Is(err, ErrPolicySoftFailed))
+		assert.Equal(t, RunPolicyOverride, result.Run.Status)
+		assert.Empty(t, tw.policyChecks.overridden)
+		assert.False(t, tw.runs.applied)
+		require.Len(t, result.PolicyChecks, 1)
+	})
+
+	t.Run("overriding soft failed policy checks", func(t *testing.T) {
+		tw := newTestWorkflow(RunPlanning, RunPolicyChecking, RunPolicyOverride, RunPolicyOverride, RunPolicyChecked, RunApplying, RunApplied)
+		tw.policyChecks.checks = []*PolicyCheck{{
+			ID:          "polchk-123",
+			Permissions: &PolicyPermissions{CanOverride: true},
+			Status:      PolicySoftFailed,
+		}}
+
+		result, err := tw.client.RunWorkflow(ctx, "ws-123", "./infra", testWorkflowOptions(WorkflowOptions{
+			AutoApply:        true,
+			OverridePolicies: true,
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"polchk-123"}, tw.policyChecks.overridden)
+		assert.True(t, tw.runs.applied)
+		assert.Equal(t, RunApplied, result.Run.Status)
+		assert.Equal(t, PolicyOverridden, result.PolicyChecks[0].Status)
+	})
+
+	t.Run("overriding in a workspace applying automatically", func(t *testing.T) {
+		tw := newTestWorkflow(RunPlanning, RunPolicyChecking, RunPolicyOverride, RunApplying, RunApplied)
+		tw.client.Workspaces = &testWorkflowWorkspaces{autoApply: true}
+		tw.policyChecks.checks = []*PolicyCheck{{
+			ID:          "polchk-123",
+			Permissions: &PolicyPermissions{CanOverride: true},
+			Status:      PolicySoftFailed,
+		}}
+
+		result, err := tw.client.RunWorkflow(ctx, "ws-123", "./infra", testWorkflowOptions(WorkflowOptions{
+			OverridePolicies: true,
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"polchk-123"}, tw.policyChecks.overridden)
+		assert.False(t, tw.runs.applied)
+		assert.Equal(t, RunApplied, result.Run.Status)
+	})
+
+	t.Run("with soft failed policy checks of a plan-only run", func(t *testing.T) {
+		tw := newTestWorkflow(RunPlanning, RunPolicyChecking, RunPolicySoftFailed)
+		tw.policyChecks.checks = []*PolicyCheck{{
+			ID:          "polchk-123",
+			Permissions: &PolicyPermissions{CanOverride: true},
+			Status:      PolicySoftFailed,
+		}}
+
+		result, err := tw.client.RunWorkflow(ctx, "ws-123", "./infra", testWorkflowOptions(WorkflowOptions{
+			AutoApply:        true,
+			OverridePolicies: true,
+		}))
+		assert.True(t, errors.Is(err, ErrPolicySoftFailed))
+		assert.Equal(t, RunPolicySoftFailed, result.Run.Status)
+		assert.Empty(t, tw.policyChecks.overridden)
+		assert.False(t, tw.runs.applied)
+	})
+
+	t.Run("without permission to override", func(t *testing.T) {
+		tw := newTestWorkflow(RunPlanning, RunPolicyOverride)
+		tw.policyChecks.checks = []*PolicyCheck{{
+			ID:          "polchk-123",
+			Permissions: &PolicyPermissions{CanOverride: false},
+			Status:      PolicySoftFailed,
+		}}
+
+		_, err := tw.client.RunWorkflow(ctx, "ws-123", "./infra", testWorkflowOptions(WorkflowOptions{
+			OverridePolicies: true,
+		}))
+		assert.True(t, errors.Is(err, ErrPolicySoftFailed))
+		assert.Empty(t, tw.policyChecks.overridden)
+	})
+
+	t.Run("when the run errors", func(t *testing.T) {
+		tw := newTestWorkflow(RunPlanning, RunErrored)
+
+		result, err := tw.client.RunWorkflow(ctx, "ws-123", "./infra", testWorkflowOptions(WorkflowOptions{
+			AutoApply: true,
+		}))
+		assert.True(t, errors.Is(err, ErrRunFinished))
+		assert.EqualError(t, err, "run finished with status errored")
+		assert.Equal(t, RunErrored, result.Run.Status)
+		assert.Equal(t, "plan logs\n", result.PlanLogs)
+	})
+
+	t.Run("when the upload errors", func(t *testing.T) {
+		tw := newTestWorkflow(RunApplied)
+		tw.cvs.statuses = []ConfigurationStatus{ConfigurationErrored}
+
+		result, err := tw.client.RunWorkflow(ctx, "ws-123", "./infra", testWorkflowOptions(WorkflowOptions{}))
+		assert.EqualError(t, err, "configuration version cv-123 errored: invalid archive")
+		assert.Equal(t, ConfigurationErrored, result.ConfigurationVersion.Status)
+		assert.Nil(t, result.Run)
+	})
+
+	t.Run("with an invalid workspace ID", func(t *testing.T) {
+		tw := newTestWorkflow(RunApplied)
+
+		result, err := tw.client.RunWorkflow(ctx, badIdentifier, "./infra", WorkflowOptions{})
+		assert.EqualError(t, err, `invalid value for workspace ID`)
+		assert.Nil(t, result)
+	})
+}
@@ -25,9 +25,15 @@ type Workspaces interface {
 	// Read a workspace by its name.
 	Read(ctx context.Context, organization string, workspace string) (*Workspace, error)
 
+	// ReadWithOptions reads a workspace by its name with the given options.
+	ReadWithOptions(ctx context.Context, organization string, workspace string, options WorkspaceReadOptions) (*Workspace, error)
+
 	// ReadByID reads a workspace by its ID.
 	ReadByID(ctx context.Context, workspaceID string) (*Workspace, error)
 
+	// ReadByIDWithOptions reads a workspace by its ID with the given options.
+	ReadByIDWithOptions(ctx context.Context, workspaceID string, options WorkspaceReadOptions) (*Workspace, error)
+
 	// Update settings of an existing workspace.
 	Update(ctx context.Context, organization string, workspace string, options WorkspaceUpdateOptions) (*Workspace, error)
 
@@ -67,6 +73,33 @@ type workspaces struct {
 	client *Client
 }
 
+// WorkspaceIncludeOpt represents a related resource that can be included
+// when reading or listing workspaces.
+type WorkspaceIncludeOpt string
+
+// List all available workspace includes.
+const (
+	WorkspaceIncludeCurrentRun                     WorkspaceIncludeOpt = "current_run"
+	WorkspaceIncludeCurrentRunConfigurationVersion WorkspaceIncludeOpt = "current_run.configuration_version"
+	WorkspaceIncludeCurrentRunPlan                 WorkspaceIncludeOpt = "current_run.plan"
+	WorkspaceIncludeOrganization                   WorkspaceIncludeOpt = "organization"
+)
+
+// WorkspaceSortKey represents a key workspaces can be sorted by when listing
+// them.
+type WorkspaceSortKey string
+
+// List all available workspace sort keys.
+const (
+	WorkspaceSortCurrentRunCreatedAt WorkspaceSortKey = "current-run.created-at"
+	WorkspaceSortName                WorkspaceSortKey = "name"
+)
+
+// Desc returns the key for sorting in descending order.
+func (k WorkspaceSortKey) Desc() WorkspaceSortKey {
+	return "-" + k
+}
+
 // WorkspaceList represents a list of workspaces.
 type WorkspaceList struct {
 	*Pagination
@@ -133,6 +166,18 @@ type WorkspaceListOptions struct {
 
 	// A search string (partial workspace name) used to filter the results.
 	Search *string `url:"search[name],omitempty"`
+
+	// The related resources to include in the response.
+	Include []WorkspaceIncludeOpt `url:"include,omitempty,comma"`
+
+	// The attributes to return per resource type.
+	Fields Fields `url:"fields,omitempty"`
+
+	// The keys to sort the results by, in order of precedence.
+	Sort []WorkspaceSortKey `url:"sort,omitempty,comma"`
+
+	// Additional server-side filters.
+	Filter Filter `url:"filter,omitempty"`
 }
 
 // List all the workspaces within an organization.
@@ -142,7 +187,7 @@ func (s *workspaces) List(ctx context.Context, organization string, options Work
 	}
 
 	u := fmt.Sprintf("organizations/%s/workspaces", url.QueryEscape(organization))
-	req, err := s.client.newRequest("GET", u, &options)
+	req, err := s.client.newRequest("Workspaces.List", "GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -215,14 +260,12 @@ type VCSRepoOptions struct {
 	OAuthTokenID      *string `json:"oauth-token-id,omitempty"`
 }
 
-func (o WorkspaceCreateOptions) valid() error {
-	if !validString(o.Name) {
-		return errors.New("name is required")
-	}
-	if !validStringID(o.Name) {
-		return errors.New("invalid value for name")
-	}
-	return nil
+// Validate checks that the name is a valid workspace name.
+func (o WorkspaceCreateOptions) Validate() error {
+	var v validator
+	v.check(validString(o.Name), "name", "name is required")
+	v.check(validStringID(o.Name), "name", "invalid value for name")
+	return v.err()
 }
 
 // Create is used to create a new workspace.
@@ -230,7 +273,7 @@ func (s *workspaces) Create(ctx context.Context, organization string, options Wo
 	if !validStringID(&organization) {
 		return nil, errors.New("invalid value for organization")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -238,7 +281,7 @@ func (s *workspaces) Create(ctx context.Context, organization string, options Wo
 	options.ID = ""
 
 	u := fmt.Sprintf("organizations/%s/workspaces", url.QueryEscape(organization))
-	req, err := s.client.newRequest("POST", u, &options)
+	req, err := s.client.newRequest("Workspaces.Create", "POST", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -254,6 +297,23 @@ func (s *workspaces) Create(ctx context.Context, organization string, options Wo
 
 // Read a workspace by its name.
 func (s *workspaces) Read(ctx context.Context, organization, workspace string) (*Workspace, error) {
+	return s.readWithOptions(ctx, "Workspaces.Read", organization, workspace, WorkspaceReadOptions{})
+}
+
+// WorkspaceReadOptions represents the options for reading a workspace.
+type WorkspaceReadOptions struct {
+	// The related resources to include in the response.
+	Include []WorkspaceIncludeOpt `url:"include,omitempty,comma"`
+}
+
+// ReadWithOptions reads a workspace by its name with the given options.
+func (s *workspaces) ReadWithOptions(ctx context.Context, organization, workspace string, options WorkspaceReadOptions) (*Workspace, error) {
+	return s.readWithOptions(ctx, "Workspaces.ReadWithOptions", organization, workspace, options)
+}
+
+// readWithOptions implements ReadWithOptions, naming the request after the
+// given operation.
+func (s *workspaces) readWithOptions(ctx context.Context, operation, organization, workspace string, options WorkspaceReadOptions) (*Workspace, error) {
 	if !validStringID(&organization) {
 		return nil, errors.New("invalid value for organization")
 	}
@@ -266,7 +326,7 @@ func (s *workspaces) Read(ctx context.Context, organization, workspace string) (
 		url.QueryEscape(organization),
 		url.QueryEscape(workspace),
 	)
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest(operation, "GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -282,12 +342,23 @@ func (s *workspaces) Read(ctx context.Context, organization, workspace string) (
 
 // ReadByID reads a workspace by its ID.
 func (s *workspaces) ReadByID(ctx context.Context, workspaceID string) (*Workspace, error) {
+	return s.readByIDWithOptions(ctx, "Workspaces.ReadByID", workspaceID, WorkspaceReadOptions{})
+}
+
+// ReadByIDWithOptions reads a workspace by its ID with the given options.
+func (s *workspaces) ReadByIDWithOptions(ctx context.Context, workspaceID string, options WorkspaceReadOptions) (*Workspace, error) {
+	return s.readByIDWithOptions(ctx, "Workspaces.ReadByIDWithOptions", workspaceID, options)
+}
+
+// readByIDWithOptions implements ReadByIDWithOptions, naming the request after
+// the given operation.
+func (s *workspaces) readByIDWithOptions(ctx context.Context, operation, workspaceID string, options WorkspaceReadOptions) (*Workspace, error) {
 	if !validStringID(&workspaceID) {
 		return nil, errors.New("invalid value for workspace ID")
 	}
 
 	u := fmt.Sprintf("workspaces/%s", url.QueryEscape(workspaceID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest(operation, "GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -349,6 +420,13 @@ type WorkspaceUpdateOptions struct {
 	WorkingDirectory *string `jsonapi:"attr,working-directory,omitempty"`
 }
 
+// Validate checks that the name is a valid workspace name when set.
+func (o WorkspaceUpdateOptions) Validate() error {
+	var v validator
+	v.check(o.Name == nil || validStringID(o.Name), "name", "invalid value for name")
+	return v.err()
+}
+
 // Update settings of an existing workspace.
 func (s *workspaces) Update(ctx context.Context, organization, workspace string, options WorkspaceUpdateOptions) (*Workspace, error) {
 	if !validStringID(&organization) {
@@ -358,6 +436,10 @@ func (s *workspaces) Update(ctx context.Context, organization, workspace string,
 		return nil, errors.New("invalid value for workspace")
 	}
 
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Make sure we don't send a user provided ID.
 	options.ID = ""
 
@@ -366,7 +448,7 @@ func (s *workspaces) Update(ctx context.Context, organization, workspace string,
 		url.QueryEscape(organization),
 		url.QueryEscape(workspace),
 	)
-	req, err := s.client.newRequest("PATCH", u, &options)
+	req, err := s.client.newRequest("Workspaces.Update", "PATCH", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -386,11 +468,15 @@ func (s *workspaces) UpdateByID(ctx context.Context, workspaceID string, options
 		return nil, errors.New("invalid value for workspace ID")
 	}
 
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Make sure we don't send a user provided ID.
 	options.ID = ""
 
 	u := fmt.Sprintf("workspaces/%s", url.QueryEscape(workspaceID))
-	req, err := s.client.newRequest("PATCH", u, &options)
+	req, err := s.client.newRequest("Workspaces.UpdateByID", "PATCH", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -418,7 +504,7 @@ func (s *workspaces) Delete(ctx context.Context, organization, workspace string)
 		url.QueryEscape(organization),
 		url.QueryEscape(workspace),
 	)
-	req, err := s.client.newRequest("DELETE", u, nil)
+	req, err := s.client.newRequest("Workspaces.Delete", "DELETE", u, nil)
 	if err != nil {
 		return err
 	}
@@ -433,7 +519,7 @@ func (s *workspaces) DeleteByID(ctx context.Context, workspaceID string) error {
 	}
 
 	u := fmt.Sprintf("workspaces/%s", url.QueryEscape(workspaceID))
-	req, err := s.client.newRequest("DELETE", u, nil)
+	req, err := s.client.newRequest("Workspaces.DeleteByID", "DELETE", u, nil)
 	if err != nil {
 		return err
 	}
@@ -462,7 +548,7 @@ func (s *workspaces) RemoveVCSConnection(ctx context.Context, organization, work
 		url.QueryEscape(workspace),
 	)
 
-	req, err := s.client.newRequest("PATCH", u, &workspaceRemoveVCSConnectionOptions{})
+	req, err := s.client.newRequest("Workspaces.RemoveVCSConnection", "PATCH", u, &workspaceRemoveVCSConnectionOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -484,7 +570,7 @@ func (s *workspaces) RemoveVCSConnectionByID(ctx context.Context, workspaceID st
 
 	u := fmt.Sprintf("workspaces/%s", url.QueryEscape(workspaceID))
 
-	req, err := s.client.newRequest("PATCH", u, &workspaceRemoveVCSConnectionOptions{})
+	req, err := s.client.newRequest("Workspaces.RemoveVCSConnectionByID", "PATCH", u, &workspaceRemoveVCSConnectionOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -511,7 +597,7 @@ func (s *workspaces) Lock(ctx context.Context, workspaceID string, options Works
 	}
 
 	u := fmt.Sprintf("workspaces/%s/actions/lock", url.QueryEscape(workspaceID))
-	req, err := s.client.newRequest("POST", u, &options)
+	req, err := s.client.newRequest("Workspaces.Lock", "POST", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -532,7 +618,7 @@ func (s *workspaces) Unlock(ctx context.Context, workspaceID string) (*Workspace
 	}
 
 	u := fmt.Sprintf("workspaces/%s/actions/unlock", url.QueryEscape(workspaceID))
-	req, err := s.client.newRequest("POST", u, nil)
+	req, err := s.client.newRequest("Workspaces.Unlock", "POST", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -553,7 +639,7 @@ func (s *workspaces) ForceUnlock(ctx context.Context, workspaceID string) (*Work
 	}
 
 	u := fmt.Sprintf("workspaces/%s/actions/force-unlock", url.QueryEscape(workspaceID))
-	req, err := s.client.newRequest("POST", u, nil)
+	req, err := s.client.newRequest("Workspaces.ForceUnlock", "POST", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -577,14 +663,12 @@ type WorkspaceAssignSSHKeyOptions struct {
 	SSHKeyID *string `jsonapi:"attr,id"`
 }
 
-func (o WorkspaceAssignSSHKeyOptions) valid() error {
-	if !validString(o.SSHKeyID) {
-		return errors.New("SSH key ID is required")
-	}
-	if !validStringID(o.SSHKeyID) {
-		return errors.New("invalid value for SSH key ID")
-	}
-	return nil
+// Validate checks that the SSH key ID is valid.
+func (o WorkspaceAssignSSHKeyOptions) Validate() error {
+	var v validator
+	v.check(validString(o.SSHKeyID), "id", "SSH key ID is required")
+	v.check(validStringID(o.SSHKeyID), "id", "invalid value for SSH key ID")
+	return v.err()
 }
 
 // AssignSSHKey to a workspace.
@@ -592,7 +676,7 @@ func (s *workspaces) AssignSSHKey(ctx context.Context, workspaceID string, optio
 	if !validStringID(&workspaceID) {
 		return nil, errors.New("invalid value for workspace ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -600,7 +684,7 @@ func (s *workspaces) AssignSSHKey(ctx context.Context, workspaceID string, optio
 	options.ID = ""
 
 	u := fmt.Sprintf("workspaces/%s/relationships/ssh-key", url.QueryEscape(workspaceID))
-	req, err := s.client.newRequest("PATCH", u, &options)
+	req, err := s.client.newRequest("Workspaces.AssignSSHKey", "PATCH", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -631,7 +715,7 @@ func (s *workspaces) UnassignSSHKey(ctx context.Context, workspaceID string) (*W
 	}
 
 	u := fmt.Sprintf("workspaces/%s/relationships/ssh-key", url.QueryEscape(workspaceID))
-	req, err := s.client.newRequest("PATCH", u, &workspaceUnassignSSHKeyOptions{})
+	req, err := s.client.newRequest("Workspaces.UnassignSSHKey", "PATCH", u, &workspaceUnassignSSHKeyOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,9 @@ package tfe
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -245,6 +248,72 @@ func TestWorkspacesReadByID(t *testing.T) {
 	})
 }
 
+func TestWorkspacesReadWithOptions(t *testing.T) {
+	ctx := context.Background()
+
+	var paths []string
+	var query url.Values
+	client, done := testMiddlewareClient(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.Write([]byte(`{
+			"data": {
+				"id": "ws-123",
+				"type": "workspaces",
+				"attributes": {"name": "my-workspace"},
+				"relationships": {
+					"current-run": {"data": {"id": "run-123", "type": "runs"}},
+					"organization": {"data": {"id": "my-org", "type": "organizations"}}
+				}
+			},
+			"included": [
+				{
+					"id": "run-123",
+					"type": "runs",
+					"attributes": {"status": "planned"},
+					"relationships": {"plan": {"data": {"id": "plan-123", "type": "plans"}}}
+				},
+				{"id": "plan-123", "type": "plans", "attributes": {"status": "finished"}}
+			]
+		}`))
+	})
+	defer done()
+
+	options := WorkspaceReadOptions{
+		Include: []WorkspaceIncludeOpt{WorkspaceIncludeCurrentRun, WorkspaceIncludeCurrentRunPlan},
+	}
+
+	t.Run("by name", func(t *testing.T) {
+		w, err := client.Workspaces.ReadWithOptions(ctx, "my-org", "my-workspace", options)
+		require.NoError(t, err)
+		assert.Equal(t, "/api/v2/organizations/my-org/workspaces/my-workspace", paths[len(paths)-1])
+		assert.Equal(t, "current_run,current_run.plan", query.Get("include"))
+
+		require.NotNil(t, w.CurrentRun)
+		assert.Equal(t, RunPlanned, w.CurrentRun.Status)
+		require.NotNil(t, w.CurrentRun.Plan)
+		assert.Equal(t, PlanFinished, w.CurrentRun.Plan.Status)
+	})
+
+	t.Run("by ID", func(t *testing.T) {
+		w, err := client.Workspaces.ReadByIDWithOptions(ctx, "ws-123", options)
+		require.NoError(t, err)
+		assert.Equal(t, "/api/v2/workspaces/ws-123", paths[len(paths)-1])
+		assert.Equal(t, "current_run,current_run.plan", query.Get("include"))
+
+		require.NotNil(t, w.CurrentRun)
+		require.NotNil(t, w.CurrentRun.Plan)
+		assert.Equal(t, PlanFinished, w.CurrentRun.Plan.Status)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		w, err := client.Workspaces.ReadByIDWithOptions(ctx, badIdentifier, options)
+		assert.Nil(t, w)
+		assert.EqualError(t, err, "invalid value for workspace ID")
+	})
+}
+
 func TestWorkspacesUpdate(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -420,7 +489,7 @@ func TestWorkspacesDelete(t *testing.T) {
 
 		// Try loading the workspace - it should fail.
 		_, err = client.Workspaces.Read(ctx, orgTest.Name, wTest.Name)
-		assert.Equal(t, ErrResourceNotFound, err)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("when organization is invalid", func(t *testing.T) {
@@ -449,7 +518,7 @@ func TestWorkspacesDeleteByID(t *testing.T) {
 
 		// Try loading the workspace - it should fail.
 		_, err = client.Workspaces.ReadByID(ctx, wTest.ID)
-		assert.Equal(t, ErrResourceNotFound, err)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
 	})
 
 	t.Run("without a valid workspace ID", func(t *testing.T) {
@@ -507,7 +576,7 @@ func TestWorkspacesLock(t *testing.T) {
 
 	t.Run("when workspace is already locked", func(t *testing.T) {
 		_, err := client.Workspaces.Lock(ctx, wTest.ID, WorkspaceLockOptions{})
-		assert.Equal(t, ErrWorkspaceLocked, err)
+		assert.True(t, errors.Is(err, ErrWorkspaceLocked))
 	})
 
 	t.Run("without a valid workspace ID", func(t *testing.T) {
@@ -541,7 +610,7 @@ func TestWorkspacesUnlock(t *testing.T) {
 
 	t.Run("when workspace is already unlocked", func(t *testing.T) {
 		_, err := client.Workspaces.Unlock(ctx, wTest.ID)
-		assert.Equal(t, ErrWorkspaceNotLocked, err)
+		assert.True(t, errors.Is(err, ErrWorkspaceNotLocked))
 	})
 
 	t.Run("without a valid workspace ID", func(t *testing.T) {
@@ -575,7 +644,7 @@ func TestWorkspacesForceUnlock(t *testing.T) {
 
 	t.Run("when workspace is already unlocked", func(t *testing.T) {
 		_, err := client.Workspaces.ForceUnlock(ctx, wTest.ID)
-		assert.Equal(t, ErrWorkspaceNotLocked, err)
+		assert.True(t, errors.Is(err, ErrWorkspaceNotLocked))
 	})
 
 	t.Run("without a valid workspace ID", func(t *testing.T) {